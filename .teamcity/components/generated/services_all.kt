@@ -100,12 +100,14 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
     "firehose" to ServiceSpec("Kinesis Firehose"),
     "fis" to ServiceSpec("FIS (Fault Injection Simulator)"),
     "fms" to ServiceSpec("FMS (Firewall Manager)", regionOverride = "us-east-1"),
+    "frauddetector" to ServiceSpec("Fraud Detector"),
     "fsx" to ServiceSpec("FSx", vpcLock = true),
     "gamelift" to ServiceSpec("GameLift"),
     "glacier" to ServiceSpec("S3 Glacier"),
@@ -115,6 +117,7 @@ val services = mapOf(
     "greengrass" to ServiceSpec("IoT Greengrass"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
+    "health" to ServiceSpec("Health"),
     "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
     "identitystore" to ServiceSpec("SSO Identity Store"),
@@ -226,6 +229,8 @@ val services = mapOf(
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
+    "support" to ServiceSpec("Support"),
+    "supportapp" to ServiceSpec("Support App"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
     "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB", vpcLock = true, parallelismOverride = 3),
@@ -233,6 +238,8 @@ val services = mapOf(
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
     "transitgateway" to ServiceSpec("Transit Gateway", vpcLock = true, patternOverride = "TestAccTransitGateway", splitPackageRealPackage = "ec2"),
+    "translate" to ServiceSpec("Translate"),
+    "trustedadvisor" to ServiceSpec("Trusted Advisor"),
     "verifiedaccess" to ServiceSpec("Verified Access", vpcLock = true, patternOverride = "TestAccVerifiedAccess", splitPackageRealPackage = "ec2"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpc" to ServiceSpec("VPC (Virtual Private Cloud)", vpcLock = true, patternOverride = "TestAccVPC", splitPackageRealPackage = "ec2"),
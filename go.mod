@@ -7,10 +7,10 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.5
-	github.com/aws/aws-sdk-go-v2 v1.30.5
-	github.com/aws/aws-sdk-go-v2/config v1.27.33
-	github.com/aws/aws-sdk-go-v2/credentials v1.17.32
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.13
+	github.com/aws/aws-sdk-go-v2 v1.36.5
+	github.com/aws/aws-sdk-go-v2/config v1.29.17
+	github.com/aws/aws-sdk-go-v2/credentials v1.17.70
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.32
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.18
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.32.7
 	github.com/aws/aws-sdk-go-v2/service/account v1.19.6
@@ -18,27 +18,27 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.35.4
 	github.com/aws/aws-sdk-go-v2/service/amp v1.27.6
 	github.com/aws/aws-sdk-go-v2/service/amplify v1.24.3
-	github.com/aws/aws-sdk-go-v2/service/apigateway v1.25.8
+	github.com/aws/aws-sdk-go-v2/service/apigateway v1.31.1
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.22.8
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.32.2
 	github.com/aws/aws-sdk-go-v2/service/appfabric v1.9.6
 	github.com/aws/aws-sdk-go-v2/service/appflow v1.43.6
 	github.com/aws/aws-sdk-go-v2/service/appintegrations v1.28.3
-	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.31.4
+	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/applicationinsights v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/applicationsignals v1.4.0
 	github.com/aws/aws-sdk-go-v2/service/appmesh v1.27.7
 	github.com/aws/aws-sdk-go-v2/service/apprunner v1.30.6
 	github.com/aws/aws-sdk-go-v2/service/appstream v1.38.3
 	github.com/aws/aws-sdk-go-v2/service/appsync v1.36.0
-	github.com/aws/aws-sdk-go-v2/service/athena v1.44.7
+	github.com/aws/aws-sdk-go-v2/service/athena v1.51.0
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.35.6
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.43.7
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.22.7
-	github.com/aws/aws-sdk-go-v2/service/backup v1.37.2
-	github.com/aws/aws-sdk-go-v2/service/batch v1.44.3
+	github.com/aws/aws-sdk-go-v2/service/backup v1.42.1
+	github.com/aws/aws-sdk-go-v2/service/batch v1.53.0
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.5.6
-	github.com/aws/aws-sdk-go-v2/service/bedrock v1.16.2
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.22.0
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.19.0
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.5.2
@@ -53,7 +53,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.6.6
 	github.com/aws/aws-sdk-go-v2/service/cloudhsmv2 v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.24.6
-	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.42.7
+	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.48.4
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.40.7
 	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.39.0
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.30.6
@@ -67,7 +67,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/codestarconnections v1.27.6
 	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/cognitoidentity v1.25.8
-	github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider v1.43.4
+	github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider v1.53.0
 	github.com/aws/aws-sdk-go-v2/service/comprehend v1.33.6
 	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.37.7
 	github.com/aws/aws-sdk-go-v2/service/configservice v1.48.7
@@ -82,7 +82,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/databrew v1.31.6
 	github.com/aws/aws-sdk-go-v2/service/dataexchange v1.30.6
 	github.com/aws/aws-sdk-go-v2/service/datapipeline v1.23.6
-	github.com/aws/aws-sdk-go-v2/service/datasync v1.40.7
+	github.com/aws/aws-sdk-go-v2/service/datasync v1.43.0
 	github.com/aws/aws-sdk-go-v2/service/datazone v1.20.1
 	github.com/aws/aws-sdk-go-v2/service/dax v1.21.7
 	github.com/aws/aws-sdk-go-v2/service/detective v1.29.6
@@ -95,28 +95,30 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.11.6
 	github.com/aws/aws-sdk-go-v2/service/drs v1.28.6
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.34.9
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.177.3
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.225.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.33.0
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.45.4
 	github.com/aws/aws-sdk-go-v2/service/efs v1.31.6
 	github.com/aws/aws-sdk-go-v2/service/eks v1.48.4
-	github.com/aws/aws-sdk-go-v2/service/elasticache v1.40.9
+	github.com/aws/aws-sdk-go-v2/service/elasticache v1.44.2
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.26.7
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.36.0
 	github.com/aws/aws-sdk-go-v2/service/elasticsearchservice v1.30.7
 	github.com/aws/aws-sdk-go-v2/service/elastictranscoder v1.25.6
-	github.com/aws/aws-sdk-go-v2/service/emr v1.42.6
+	github.com/aws/aws-sdk-go-v2/service/emr v1.49.3
 	github.com/aws/aws-sdk-go-v2/service/emrcontainers v1.31.2
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.23.6
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.19.0
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.33.7
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.21.6
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.32.4
 	github.com/aws/aws-sdk-go-v2/service/fis v1.28.0
 	github.com/aws/aws-sdk-go-v2/service/fms v1.35.6
-	github.com/aws/aws-sdk-go-v2/service/fsx v1.47.5
+	github.com/aws/aws-sdk-go-v2/service/frauddetector v1.32.1
+	github.com/aws/aws-sdk-go-v2/service/fsx v1.55.1
 	github.com/aws/aws-sdk-go-v2/service/gamelift v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.27.3
@@ -125,6 +127,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/greengrass v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.29.7
 	github.com/aws/aws-sdk-go-v2/service/guardduty v1.46.0
+	github.com/aws/aws-sdk-go-v2/service/health v1.30.2
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/iam v1.35.2
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.25.6
@@ -162,17 +165,17 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.32.6
 	github.com/aws/aws-sdk-go-v2/service/mediapackagev2 v1.15.4
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.22.6
-	github.com/aws/aws-sdk-go-v2/service/memorydb v1.21.8
+	github.com/aws/aws-sdk-go-v2/service/memorydb v1.27.0
 	github.com/aws/aws-sdk-go-v2/service/mq v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.29.7
 	github.com/aws/aws-sdk-go-v2/service/neptune v1.33.7
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.11.3
-	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.41.3
+	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.48.0
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.29.6
 	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.5.6
 	github.com/aws/aws-sdk-go-v2/service/oam v1.13.9
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.39.6
-	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.14.3
+	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.17.5
 	github.com/aws/aws-sdk-go-v2/service/opsworks v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.31.2
 	github.com/aws/aws-sdk-go-v2/service/osis v1.12.6
@@ -209,7 +212,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.61.2
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.47.0
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.26.6
-	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.157.0
+	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.195.0
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.10.6
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.32.8
@@ -231,18 +234,22 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.32.6
 	github.com/aws/aws-sdk-go-v2/service/ssmsap v1.16.2
-	github.com/aws/aws-sdk-go-v2/service/sso v1.22.7
+	github.com/aws/aws-sdk-go-v2/service/sso v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/ssoadmin v1.27.7
 	github.com/aws/aws-sdk-go-v2/service/storagegateway v1.31.6
-	github.com/aws/aws-sdk-go-v2/service/sts v1.30.7
+	github.com/aws/aws-sdk-go-v2/service/sts v1.34.0
+	github.com/aws/aws-sdk-go-v2/service/support v1.27.2
+	github.com/aws/aws-sdk-go-v2/service/supportapp v1.12.0
 	github.com/aws/aws-sdk-go-v2/service/swf v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.26.8
 	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.3.1
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.27.6
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.50.6
+	github.com/aws/aws-sdk-go-v2/service/translate v1.25.1
+	github.com/aws/aws-sdk-go-v2/service/trustedadvisor v1.8.16
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.17.6
-	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.10.7
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.13.0
 	github.com/aws/aws-sdk-go-v2/service/waf v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.52.2
@@ -251,7 +258,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.46.3
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.21.7
 	github.com/aws/aws-sdk-go-v2/service/xray v1.27.7
-	github.com/aws/smithy-go v1.20.4
+	github.com/aws/smithy-go v1.22.4
 	github.com/beevik/etree v1.4.1
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -305,16 +312,16 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.4 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.36 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.36 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.19 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.18 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.19 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.17 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.26.7 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.30.3 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.6.0 // indirect
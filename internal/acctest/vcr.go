@@ -23,7 +23,6 @@ import (
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -121,7 +120,7 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureProvider = vcrProviderConfigureProvider(primary, primary.ConfigureProvider, t.Name())
 
 			return providerServerFactory(), nil
 		}
@@ -130,25 +129,25 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 	return output
 }
 
-// vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
-// This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
+// vcrProviderConfigureProvider returns a provider configuration function returning cached provider instance state.
+// This is necessary as the provider's configuration function is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		var diags diag.Diagnostics
-
+func vcrProviderConfigureProvider(provider *schema.Provider, configureProvider func(context.Context, schema.ConfigureProviderRequest, *schema.ConfigureProviderResponse), testName string) func(context.Context, schema.ConfigureProviderRequest, *schema.ConfigureProviderResponse) {
+	return func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
 		providerMetas.Lock()
 		meta, ok := providerMetas[testName]
 		defer providerMetas.Unlock()
 
 		if ok {
-			return meta, nil
+			response.Meta = meta
+			return
 		}
 
 		vcrMode, err := vcrMode()
 
 		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
+			response.Diagnostics = sdkdiag.AppendFromErr(response.Diagnostics, err)
+			return
 		}
 
 		// Cribbed from aws-sdk-go-base.
@@ -172,7 +171,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		})
 
 		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
+			response.Diagnostics = sdkdiag.AppendFromErr(response.Diagnostics, err)
+			return
 		}
 
 		// Remove sensitive HTTP headers.
@@ -256,8 +256,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		})
 
 		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
+		// As the HTTP client is used in the provider's configuration function
+		// we must do this setup before calling the configuration function.
 		httpClient.Transport = r
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
@@ -267,12 +267,20 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		meta.SetHTTPClient(ctx, httpClient)
 		provider.SetMeta(meta)
 
-		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
-			return nil, append(diags, ds...)
-		} else {
-			meta = v.(*conns.AWSClient)
+		configureProvider(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			response.Meta = nil
+			return
 		}
 
+		// A deferred configuration is incomplete, so don't cache it.
+		if response.Deferred != nil {
+			return
+		}
+
+		meta = response.Meta.(*conns.AWSClient)
+
 		// Don't retry requests if a recorded interaction isn't found.
 		// TODO Need to loop through all API clients to do this.
 		// TODO Use []*client.Client?
@@ -285,8 +293,6 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// })
 
 		providerMetas[testName] = meta
-
-		return meta, diags
 	}
 }
 
@@ -44,6 +44,7 @@ type AWSClient struct {
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	stateUpgradeDryRun        bool   // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -100,6 +101,11 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// StateUpgradeDryRun returns the state_upgrade_dry_run provider configuration value.
+func (c *AWSClient) StateUpgradeDryRun(context.Context) bool {
+	return c.stateUpgradeDryRun
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -102,12 +102,14 @@ import (
 	emr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/emr"
 	emrcontainers_sdkv2 "github.com/aws/aws-sdk-go-v2/service/emrcontainers"
 	emrserverless_sdkv2 "github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	entityresolution_sdkv2 "github.com/aws/aws-sdk-go-v2/service/entityresolution"
 	eventbridge_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	evidently_sdkv2 "github.com/aws/aws-sdk-go-v2/service/evidently"
 	finspace_sdkv2 "github.com/aws/aws-sdk-go-v2/service/finspace"
 	firehose_sdkv2 "github.com/aws/aws-sdk-go-v2/service/firehose"
 	fis_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fis"
 	fms_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fms"
+	frauddetector_sdkv2 "github.com/aws/aws-sdk-go-v2/service/frauddetector"
 	fsx_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fsx"
 	gamelift_sdkv2 "github.com/aws/aws-sdk-go-v2/service/gamelift"
 	glacier_sdkv2 "github.com/aws/aws-sdk-go-v2/service/glacier"
@@ -117,6 +119,7 @@ import (
 	greengrass_sdkv2 "github.com/aws/aws-sdk-go-v2/service/greengrass"
 	groundstation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/groundstation"
 	guardduty_sdkv2 "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	health_sdkv2 "github.com/aws/aws-sdk-go-v2/service/health"
 	healthlake_sdkv2 "github.com/aws/aws-sdk-go-v2/service/healthlake"
 	iam_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iam"
 	identitystore_sdkv2 "github.com/aws/aws-sdk-go-v2/service/identitystore"
@@ -227,12 +230,16 @@ import (
 	ssoadmin_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	storagegateway_sdkv2 "github.com/aws/aws-sdk-go-v2/service/storagegateway"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	support_sdkv2 "github.com/aws/aws-sdk-go-v2/service/support"
+	supportapp_sdkv2 "github.com/aws/aws-sdk-go-v2/service/supportapp"
 	swf_sdkv2 "github.com/aws/aws-sdk-go-v2/service/swf"
 	synthetics_sdkv2 "github.com/aws/aws-sdk-go-v2/service/synthetics"
 	timestreaminfluxdb_sdkv2 "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
 	timestreamwrite_sdkv2 "github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	transcribe_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transcribe"
 	transfer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transfer"
+	translate_sdkv2 "github.com/aws/aws-sdk-go-v2/service/translate"
+	trustedadvisor_sdkv2 "github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
 	verifiedpermissions_sdkv2 "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	vpclattice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	waf_sdkv2 "github.com/aws/aws-sdk-go-v2/service/waf"
@@ -637,6 +644,10 @@ func (c *AWSClient) ElasticsearchClient(ctx context.Context) *elasticsearchservi
 	return errs.Must(client[*elasticsearchservice_sdkv2.Client](ctx, c, names.Elasticsearch, make(map[string]any)))
 }
 
+func (c *AWSClient) EntityResolutionClient(ctx context.Context) *entityresolution_sdkv2.Client {
+	return errs.Must(client[*entityresolution_sdkv2.Client](ctx, c, names.EntityResolution, make(map[string]any)))
+}
+
 func (c *AWSClient) EventsClient(ctx context.Context) *eventbridge_sdkv2.Client {
 	return errs.Must(client[*eventbridge_sdkv2.Client](ctx, c, names.Events, make(map[string]any)))
 }
@@ -665,6 +676,10 @@ func (c *AWSClient) FirehoseClient(ctx context.Context) *firehose_sdkv2.Client {
 	return errs.Must(client[*firehose_sdkv2.Client](ctx, c, names.Firehose, make(map[string]any)))
 }
 
+func (c *AWSClient) FraudDetectorClient(ctx context.Context) *frauddetector_sdkv2.Client {
+	return errs.Must(client[*frauddetector_sdkv2.Client](ctx, c, names.FraudDetector, make(map[string]any)))
+}
+
 func (c *AWSClient) GameLiftClient(ctx context.Context) *gamelift_sdkv2.Client {
 	return errs.Must(client[*gamelift_sdkv2.Client](ctx, c, names.GameLift, make(map[string]any)))
 }
@@ -697,6 +712,10 @@ func (c *AWSClient) GuardDutyClient(ctx context.Context) *guardduty_sdkv2.Client
 	return errs.Must(client[*guardduty_sdkv2.Client](ctx, c, names.GuardDuty, make(map[string]any)))
 }
 
+func (c *AWSClient) HealthClient(ctx context.Context) *health_sdkv2.Client {
+	return errs.Must(client[*health_sdkv2.Client](ctx, c, names.Health, make(map[string]any)))
+}
+
 func (c *AWSClient) HealthLakeClient(ctx context.Context) *healthlake_sdkv2.Client {
 	return errs.Must(client[*healthlake_sdkv2.Client](ctx, c, names.HealthLake, make(map[string]any)))
 }
@@ -1153,6 +1172,14 @@ func (c *AWSClient) StorageGatewayClient(ctx context.Context) *storagegateway_sd
 	return errs.Must(client[*storagegateway_sdkv2.Client](ctx, c, names.StorageGateway, make(map[string]any)))
 }
 
+func (c *AWSClient) SupportClient(ctx context.Context) *support_sdkv2.Client {
+	return errs.Must(client[*support_sdkv2.Client](ctx, c, names.Support, make(map[string]any)))
+}
+
+func (c *AWSClient) SupportAppClient(ctx context.Context) *supportapp_sdkv2.Client {
+	return errs.Must(client[*supportapp_sdkv2.Client](ctx, c, names.SupportApp, make(map[string]any)))
+}
+
 func (c *AWSClient) SyntheticsClient(ctx context.Context) *synthetics_sdkv2.Client {
 	return errs.Must(client[*synthetics_sdkv2.Client](ctx, c, names.Synthetics, make(map[string]any)))
 }
@@ -1173,6 +1200,14 @@ func (c *AWSClient) TransferClient(ctx context.Context) *transfer_sdkv2.Client {
 	return errs.Must(client[*transfer_sdkv2.Client](ctx, c, names.Transfer, make(map[string]any)))
 }
 
+func (c *AWSClient) TranslateClient(ctx context.Context) *translate_sdkv2.Client {
+	return errs.Must(client[*translate_sdkv2.Client](ctx, c, names.Translate, make(map[string]any)))
+}
+
+func (c *AWSClient) TrustedAdvisorClient(ctx context.Context) *trustedadvisor_sdkv2.Client {
+	return errs.Must(client[*trustedadvisor_sdkv2.Client](ctx, c, names.TrustedAdvisor, make(map[string]any)))
+}
+
 func (c *AWSClient) VPCLatticeClient(ctx context.Context) *vpclattice_sdkv2.Client {
 	return errs.Must(client[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice, make(map[string]any)))
 }
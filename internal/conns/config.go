@@ -55,6 +55,7 @@ type Config struct {
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	StateUpgradeDryRun             bool
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
@@ -223,6 +224,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.stateUpgradeDryRun = c.StateUpgradeDryRun
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = azsSpreadFunction{}
+
+func NewAZsSpreadFunction() function.Function {
+	return &azsSpreadFunction{}
+}
+
+type azsSpreadFunction struct{}
+
+func (f azsSpreadFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azs_spread"
+}
+
+func (f azsSpreadFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "azs_spread Function",
+		MarkdownDescription: "Allocates one subnet CIDR block per Availability Zone within an IPv4 VPC CIDR block. Each " +
+			"subnet is the smallest valid AWS subnet size with at least the specified number of usable addresses, " +
+			"after the five addresses AWS reserves in every subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to allocate subnets in",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "hosts",
+				MarkdownDescription: "Minimum number of usable addresses in each subnet",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f azsSpreadFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var azs []string
+	var hosts int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &azs, &hosts))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets, err := spreadAZs(vpcCIDR, azs, hosts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, subnets)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// spreadAZs allocates consecutive, equally sized subnets within the VPC, one per Availability Zone
+func spreadAZs(vpcCIDR string, azs []string, hosts int64) (map[string]string, error) {
+	vpc, err := parseVPCCIDR(vpcCIDR)
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := prefixLengthForHosts(hosts)
+	if err != nil {
+		return nil, err
+	}
+	if bits < vpc.Bits() {
+		return nil, fmt.Errorf("%d hosts exceeds the usable addresses of VPC CIDR block (%s)", hosts, vpcCIDR)
+	}
+
+	subnets := make(map[string]string, len(azs))
+	for i, az := range azs {
+		if _, ok := subnets[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+
+		subnet, err := nthSubnet(vpc, bits, int64(i))
+		if err != nil {
+			return nil, err
+		}
+
+		subnets[az] = subnet.String()
+	}
+
+	return subnets, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAZsSpreadFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAZsSpreadFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 250),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/24"),
+					resource.TestCheckOutput("b", "10.0.1.0/24"),
+					resource.TestCheckOutput("c", "10.0.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+// A /28 has 16 addresses, 11 of which are usable.
+func TestAZsSpreadFunction_reservedAddresses(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAZsSpreadFunctionConfig("10.0.0.0/24", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/27"),
+					resource.TestCheckOutput("b", "10.0.0.32/27"),
+					resource.TestCheckOutput("c", "10.0.0.64/27"),
+				),
+			},
+		},
+	})
+}
+
+func TestAZsSpreadFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAZsSpreadFunctionConfig("10.0.0.0/24", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 100),
+				ExpectError: regexache.MustCompile(`not[\s\n]*enough[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func testAZsSpreadFunctionConfig(vpcCIDR, azs string, hosts int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::azs_spread(%[1]q, %[2]s, %[3]d)
+}
+
+output "a" {
+  value = local.subnets["us-west-2a"]
+}
+
+output "b" {
+  value = local.subnets["us-west-2b"]
+}
+
+output "c" {
+  value = local.subnets["us-west-2c"]
+}
+`, vpcCIDR, azs, hosts)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+const (
+	// IPv4 VPC and subnet size limits:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// minIPv4PrefixLength is the prefix length of the largest IPv4 VPC or subnet
+	minIPv4PrefixLength = 16
+	// maxIPv4PrefixLength is the prefix length of the smallest IPv4 VPC or subnet
+	maxIPv4PrefixLength = 28
+	// reservedIPv4AddressesPerSubnet is the number of addresses AWS reserves in each subnet
+	reservedIPv4AddressesPerSubnet = 5
+)
+
+// parseVPCCIDR parses an IPv4 VPC CIDR block and verifies that it is a valid VPC size
+func parseVPCCIDR(s string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("VPC CIDR block (%s) must be IPv4", s)
+	}
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("VPC CIDR block (%s) must not have host bits set", s)
+	}
+	if err := validateIPv4PrefixLength(prefix.Bits()); err != nil {
+		return netip.Prefix{}, fmt.Errorf("VPC CIDR block (%s): %w", s, err)
+	}
+
+	return prefix, nil
+}
+
+// validateIPv4PrefixLength verifies that an IPv4 prefix length is within AWS limits
+func validateIPv4PrefixLength(n int) error {
+	if n < minIPv4PrefixLength || n > maxIPv4PrefixLength {
+		return fmt.Errorf("prefix length must be between /%d and /%d, got /%d", minIPv4PrefixLength, maxIPv4PrefixLength, n)
+	}
+
+	return nil
+}
+
+// prefixLengthForHosts returns the prefix length of the smallest subnet with at least the
+// specified number of usable addresses, after the addresses that AWS reserves in each subnet
+func prefixLengthForHosts(hosts int64) (int, error) {
+	if hosts < 1 {
+		return 0, fmt.Errorf("number of hosts must be at least 1, got %d", hosts)
+	}
+
+	n := maxIPv4PrefixLength
+	for ; n >= minIPv4PrefixLength; n-- {
+		if int64(1)<<(32-n)-reservedIPv4AddressesPerSubnet >= hosts {
+			return n, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%d hosts exceeds the usable addresses of the largest subnet (/%d)", hosts, minIPv4PrefixLength)
+}
+
+// nthSubnet returns the nth subnet of the specified prefix length within a parent prefix
+func nthSubnet(parent netip.Prefix, bits int, n int64) (netip.Prefix, error) {
+	if bits < parent.Bits() {
+		return netip.Prefix{}, fmt.Errorf("prefix length /%d is larger than %s", bits, parent)
+	}
+	if n < 0 || n >= int64(1)<<(bits-parent.Bits()) {
+		return netip.Prefix{}, fmt.Errorf("not enough address space in %s for subnet %d of size /%d", parent, n, bits)
+	}
+
+	base := new(big.Int).SetBytes(parent.Addr().AsSlice())
+	offset := new(big.Int).Lsh(big.NewInt(n), uint(32-bits))
+	b := new(big.Int).Add(base, offset).FillBytes(make([]byte, 4))
+
+	return netip.PrefixFrom(netip.AddrFrom4([4]byte(b)), bits), nil
+}
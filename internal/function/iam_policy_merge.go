@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// iamPolicyVersion is the current IAM policy language version
+	iamPolicyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges the statements of one or more IAM policy documents into a single policy document. " +
+			"Statement IDs (Sids) must be unique across all documents.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy documents in JSON format",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicy is the subset of an IAM policy document preserved when merging.
+// Statements are kept as raw JSON so that their contents round-trip unchanged.
+type iamPolicy struct {
+	Version    string            `json:",omitempty"`
+	Statements []json.RawMessage `json:"Statement"`
+}
+
+// mergePolicies concatenates the statements of the specified policy documents
+func mergePolicies(policies []string) (string, error) {
+	merged := iamPolicy{
+		Version: iamPolicyVersion,
+	}
+	sids := make(map[string]struct{})
+
+	for i, policy := range policies {
+		statements, err := policyStatements(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		for _, statement := range statements {
+			var v struct {
+				Sid string
+			}
+
+			if err := json.Unmarshal(statement, &v); err != nil {
+				return "", fmt.Errorf("policy %d: statement must be an object: %w", i, err)
+			}
+
+			if v.Sid != "" {
+				if _, ok := sids[v.Sid]; ok {
+					return "", fmt.Errorf("policy %d: duplicate Sid (%s)", i, v.Sid)
+				}
+				sids[v.Sid] = struct{}{}
+			}
+
+			merged.Statements = append(merged.Statements, statement)
+		}
+	}
+
+	if merged.Statements == nil {
+		merged.Statements = []json.RawMessage{}
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// policyStatements returns the statements of a policy document.
+// A policy's Statement element may be either a single object or an array of objects.
+func policyStatements(policy string) ([]json.RawMessage, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	if len(doc.Statement) == 0 {
+		return nil, nil
+	}
+
+	var statements []json.RawMessage
+	if err := json.Unmarshal(doc.Statement, &statements); err == nil {
+		return statements, nil
+	}
+
+	return []json.RawMessage{doc.Statement}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_valid(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"ec2:*","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_duplicateSid(),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*policy[\s\n]*document`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig_valid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = {
+        Sid      = "S3"
+        Effect   = "Allow"
+        Action   = "s3:*"
+        Resource = "*"
+      }
+    }),
+    jsonencode({
+      Statement = [{
+        Effect   = "Deny"
+        Action   = "ec2:*"
+        Resource = "*"
+      }]
+    }),
+  )
+}
+`
+}
+
+func testIAMPolicyMergeFunctionConfig_duplicateSid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({ Statement = [{ Sid = "Example", Effect = "Allow", Action = "s3:*", Resource = "*" }] }),
+    jsonencode({ Statement = [{ Sid = "Example", Effect = "Deny", Action = "s3:*", Resource = "*" }] }),
+  )
+}
+`
+}
+
+func testIAMPolicyMergeFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge("invalid")
+}
+`
+}
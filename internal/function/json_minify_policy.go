@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = jsonMinifyPolicyFunction{}
+
+func NewJSONMinifyPolicyFunction() function.Function {
+	return &jsonMinifyPolicyFunction{}
+}
+
+type jsonMinifyPolicyFunction struct{}
+
+func (f jsonMinifyPolicyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_minify_policy"
+}
+
+func (f jsonMinifyPolicyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "json_minify_policy Function",
+		MarkdownDescription: "Removes insignificant whitespace from a JSON policy document. This function can be " +
+			"used to keep policies within service size quotas, which count whitespace characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "Policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f jsonMinifyPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := minifyPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// minifyPolicy compacts a JSON policy document, preserving element order
+func minifyPolicy(s string) (string, error) {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = nextAvailableCIDRFunction{}
+
+func NewNextAvailableCIDRFunction() function.Function {
+	return &nextAvailableCIDRFunction{}
+}
+
+type nextAvailableCIDRFunction struct{}
+
+func (f nextAvailableCIDRFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_available_cidr"
+}
+
+func (f nextAvailableCIDRFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "next_available_cidr Function",
+		MarkdownDescription: "Returns the lowest IPv4 CIDR block of the specified prefix length within a VPC CIDR block " +
+			"that does not overlap any existing subnet CIDR blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "existing_cidrs",
+				MarkdownDescription: "CIDR blocks of the existing subnets",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				MarkdownDescription: "Prefix length of the new subnet",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f nextAvailableCIDRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var existingCIDRs []string
+	var prefixLength int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &existingCIDRs, &prefixLength))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := nextAvailableCIDR(vpcCIDR, existingCIDRs, prefixLength)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// nextAvailableCIDR returns the first subnet of the specified prefix length within the VPC that
+// doesn't overlap any existing subnet
+func nextAvailableCIDR(vpcCIDR string, existingCIDRs []string, prefixLength int64) (string, error) {
+	vpc, err := parseVPCCIDR(vpcCIDR)
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateIPv4PrefixLength(int(prefixLength)); err != nil {
+		return "", err
+	}
+	if int(prefixLength) < vpc.Bits() {
+		return "", fmt.Errorf("prefix length /%d is larger than VPC CIDR block (%s)", prefixLength, vpcCIDR)
+	}
+
+	existing := make([]netip.Prefix, 0, len(existingCIDRs))
+	for _, v := range existingCIDRs {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return "", err
+		}
+
+		existing = append(existing, prefix.Masked())
+	}
+
+	n := int64(1) << (int(prefixLength) - vpc.Bits())
+	for i := int64(0); i < n; i++ {
+		candidate, err := nthSubnet(vpc, int(prefixLength), i)
+		if err != nil {
+			return "", err
+		}
+
+		if !overlapsAny(candidate, existing) {
+			return candidate.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no /%d CIDR block available in VPC CIDR block (%s)", prefixLength, vpcCIDR)
+}
+
+func overlapsAny(prefix netip.Prefix, prefixes []netip.Prefix) bool {
+	for _, v := range prefixes {
+		if prefix.Overlaps(v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestNextAvailableCIDRFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNextAvailableCIDRFunctionConfig("10.0.0.0/16", `["10.0.0.0/24", "10.0.1.0/24"]`, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.2.0/24"),
+				),
+			},
+			{
+				Config: testNextAvailableCIDRFunctionConfig("10.0.0.0/16", `["10.0.0.0/24"]`, 23),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.2.0/23"),
+				),
+			},
+		},
+	})
+}
+
+func TestNextAvailableCIDRFunction_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testNextAvailableCIDRFunctionConfig("10.0.0.0/28", `["10.0.0.0/28"]`, 28),
+				ExpectError: regexache.MustCompile(`no[\s\n]*/28[\s\n]*CIDR[\s\n]*block[\s\n]*available`),
+			},
+		},
+	})
+}
+
+func TestNextAvailableCIDRFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testNextAvailableCIDRFunctionConfig("10.0.0.0/16", `[]`, 29),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testNextAvailableCIDRFunctionConfig(vpcCIDR, existingCIDRs string, prefixLength int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::next_available_cidr(%[1]q, %[2]s, %[3]d)
+}
+`, vpcCIDR, existingCIDRs, prefixLength)
+}
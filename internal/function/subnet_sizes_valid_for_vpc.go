@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = subnetSizesValidForVPCFunction{}
+
+func NewSubnetSizesValidForVPCFunction() function.Function {
+	return &subnetSizesValidForVPCFunction{}
+}
+
+type subnetSizesValidForVPCFunction struct{}
+
+func (f subnetSizesValidForVPCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_sizes_valid_for_vpc"
+}
+
+func (f subnetSizesValidForVPCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_sizes_valid_for_vpc Function",
+		MarkdownDescription: "Checks whether subnets of the specified prefix lengths are valid AWS subnet sizes and can all " +
+			"be allocated within an IPv4 VPC CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				MarkdownDescription: "Prefix lengths of the subnets",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f subnetSizesValidForVPCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	vpc, err := parseVPCCIDR(vpcCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subnetSizesFit(vpc.Bits(), prefixLengths)))
+}
+
+// subnetSizesFit returns whether subnets of the specified prefix lengths fit in a VPC of the specified prefix length.
+// Subnet sizes are powers of two, so allocating the largest subnets first never leaves unusable gaps and
+// the subnets fit if and only if each is a valid size and their total size doesn't exceed the VPC's.
+func subnetSizesFit(vpcBits int, prefixLengths []int64) bool {
+	var total, size uint64 = 0, uint64(1) << (32 - vpcBits)
+
+	for _, v := range prefixLengths {
+		if validateIPv4PrefixLength(int(v)) != nil || int(v) < vpcBits {
+			return false
+		}
+
+		total += uint64(1) << (32 - v)
+	}
+
+	return total <= size
+}
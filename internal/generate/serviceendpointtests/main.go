@@ -99,7 +99,7 @@ func main() {
 
 		switch packageName {
 		// TODO: This case should be handled in service data
-		case "costoptimizationhub", "cur", "globalaccelerator", "route53domains":
+		case "costoptimizationhub", "cur", "globalaccelerator", "health", "route53domains", "supportapp":
 			td.OverrideRegionRegionalEndpoint = true
 
 		case "chatbot":
@@ -0,0 +1,624 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+var (
+	service           = flag.String("Service", "", "provider service package name, e.g. qbusiness")
+	sdkServicePackage = flag.String("AWSSDKServicePackage", "", "AWS SDK for Go v2 service package name. Defaults to the provider service package name.")
+	sdkDir            = flag.String("SDKDir", "", "directory containing the AWS SDK for Go v2 service package source. Defaults to the Go module cache location.")
+	humanFriendly     = flag.String("HumanFriendly", "", "human friendly service name. Defaults to the SDK service ID.")
+	providerNameUpper = flag.String("ProviderNameUpper", "", "correctly capitalized service name. Defaults to the SDK service ID without spaces.")
+	overwrite         = flag.Bool("Overwrite", false, "whether to overwrite existing scaffolded files")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tgo run internal/generate/servicescaffold/main.go -Service <service> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+const (
+	namesDataFilename = `names/data/names_data.hcl`
+	sdkModulePrefix   = `github.com/aws/aws-sdk-go-v2/service/`
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *service == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// The generator updates files relative to the repository root.
+	if _, err := os.Stat(namesDataFilename); err != nil {
+		g.Fatalf("must be run from the repository root: %s", err)
+	}
+
+	servicePackage := *service
+	goV2Package := servicePackage
+	if *sdkServicePackage != "" {
+		goV2Package = *sdkServicePackage
+	}
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	var record *data.ServiceRecord
+	for _, l := range serviceData {
+		if l.ProviderPackage() == servicePackage {
+			record = &l
+			break
+		}
+	}
+
+	if record != nil {
+		if !record.ClientSDKV2() {
+			g.Fatalf("service %q does not use the AWS SDK for Go v2", servicePackage)
+		}
+		goV2Package = record.GoV2Package()
+	}
+
+	dir := *sdkDir
+	if dir == "" {
+		dir, err = sdkPackageDir(goV2Package)
+
+		if err != nil {
+			g.Fatalf("locating AWS SDK for Go v2 package %q (add it with 'go get %s%s'): %s", goV2Package, sdkModulePrefix, goV2Package, err)
+		}
+	}
+
+	g.Infof("Reading AWS SDK for Go v2 service model from %s", dir)
+
+	model, err := loadServiceModel(dir, goV2Package)
+
+	if err != nil {
+		g.Fatalf("reading service model: %s", err)
+	}
+
+	td := TemplateData{
+		GoV2Package:       goV2Package,
+		ProviderPackage:   servicePackage,
+		SDKID:             model.ServiceID,
+		EndpointAPICall:   model.EndpointAPICall,
+		NotFoundException: model.NotFoundException,
+		Finders:           model.Finders,
+		TagsDirective:     model.Tagging.directive(),
+	}
+
+	if record == nil {
+		td.HumanFriendly = *humanFriendly
+		if td.HumanFriendly == "" {
+			td.HumanFriendly = model.ServiceID
+		}
+		td.ProviderNameUpper = *providerNameUpper
+		if td.ProviderNameUpper == "" {
+			td.ProviderNameUpper = strings.ReplaceAll(model.ServiceID, " ", "")
+		}
+
+		g.Infof("Adding service %q to %s", servicePackage, namesDataFilename)
+
+		if err := addNamesData(g, td); err != nil {
+			g.Fatalf("updating %s: %s", namesDataFilename, err)
+		}
+	}
+
+	serviceDir := filepath.Join("internal", "service", servicePackage)
+	if err := g.NewGoFileDestination(filepath.Join(serviceDir, "generate.go")).CreateDirectories(); err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	files := []struct {
+		filename string
+		body     string
+		skip     bool
+	}{
+		{filename: "generate.go", body: generateTmpl},
+		{filename: "find.go", body: findTmpl, skip: len(td.Finders) == 0},
+		{filename: "status.go", body: statusTmpl, skip: !td.HasStatus()},
+		{filename: "wait.go", body: waitTmpl, skip: !td.HasStatus()},
+	}
+
+	for _, f := range files {
+		filename := filepath.Join(serviceDir, f.filename)
+
+		if f.skip {
+			continue
+		}
+
+		if _, err := os.Stat(filename); err == nil && !*overwrite {
+			g.Warnf("Skipping existing %s", filename)
+			continue
+		}
+
+		g.Infof("Generating %s", filename)
+
+		d := g.NewGoFileDestination(filename)
+
+		if err := d.WriteTemplate(f.filename, f.body, td); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
+	g.Infof("Run 'make gen' and 'go mod tidy' to generate the service client, names constants and tagging code")
+}
+
+type TemplateData struct {
+	EndpointAPICall   string
+	GoV2Package       string
+	HumanFriendly     string
+	NotFoundException string
+	ProviderNameUpper string
+	ProviderPackage   string
+	SDKID             string
+	TagsDirective     string
+
+	Finders []Finder
+}
+
+func (td TemplateData) HasStatus() bool {
+	return slices.ContainsFunc(td.Finders, func(f Finder) bool {
+		return f.Status != nil
+	})
+}
+
+// Finder describes a read operation that returns a single resource by identifier.
+type Finder struct {
+	Resource    string // e.g. "Application"
+	Operation   string // e.g. "GetApplication"
+	InputIDElem string // e.g. "ApplicationId"
+	OutputElem  string // e.g. "Application", or empty if the operation output is the resource
+	ResultType  string // e.g. "awstypes.Application"
+	Status      *Status
+}
+
+// Status describes the lifecycle status field of a resource.
+type Status struct {
+	Elem     string   // e.g. "Status"
+	Pending  []string // e.g. "ApplicationStatusCreating"
+	Target   []string // e.g. "ApplicationStatusActive"
+	Deleting []string // e.g. "ApplicationStatusDeleting"
+}
+
+// Tagging describes the service's tagging API.
+type Tagging struct {
+	ListTags            bool
+	ListTagsInIDElem    string
+	ListTagsOutTagsElem string
+	ServiceTagsMap      bool
+	TagInIDElem         string
+	TagInTagsElem       string
+	TagType             string
+	UntagInTagsElem     string
+	UpdateTags          bool
+}
+
+// directive returns the internal/generate/tags go:generate arguments for the service.
+func (t *Tagging) directive() string {
+	if t == nil {
+		return ""
+	}
+
+	args := []string{"-AWSSDKVersion=2"}
+
+	if t.ServiceTagsMap {
+		args = append(args, "-ServiceTagsMap")
+	} else {
+		args = append(args, "-ServiceTagsSlice")
+		if t.TagType != "" && t.TagType != "Tag" {
+			args = append(args, "-TagType="+t.TagType)
+		}
+	}
+
+	if t.ListTags {
+		args = append(args, "-ListTags")
+		if t.ListTagsInIDElem != "ResourceArn" {
+			args = append(args, "-ListTagsInIDElem="+t.ListTagsInIDElem)
+		}
+		if t.ListTagsOutTagsElem != "Tags" {
+			args = append(args, "-ListTagsOutTagsElem="+t.ListTagsOutTagsElem)
+		}
+	}
+
+	if t.UpdateTags {
+		args = append(args, "-UpdateTags")
+		if t.TagInIDElem != "ResourceArn" {
+			args = append(args, "-TagInIDElem="+t.TagInIDElem)
+		}
+		if t.TagInTagsElem != "Tags" {
+			args = append(args, "-TagInTagsElem="+t.TagInTagsElem)
+		}
+		if t.UntagInTagsElem != "TagKeys" {
+			args = append(args, "-UntagInTagsElem="+t.UntagInTagsElem)
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+// sdkPackageDir returns the location of the AWS SDK for Go v2 service package source in the module cache.
+func sdkPackageDir(goV2Package string) (string, error) {
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModulePrefix+goV2Package).Output()
+
+	if err != nil {
+		return "", err
+	}
+
+	dir := strings.TrimSpace(string(output))
+
+	if dir == "" {
+		return "", fmt.Errorf("module source not downloaded")
+	}
+
+	return dir, nil
+}
+
+type serviceModel struct {
+	ServiceID         string
+	EndpointAPICall   string
+	NotFoundException string
+	Finders           []Finder
+	Tagging           *Tagging
+}
+
+// structs maps type names to struct definitions.
+type structs map[string]*ast.StructType
+
+// enums maps enum type names to the names of their constant values.
+type enums map[string]map[string]string
+
+// loadServiceModel derives a service model from the AWS SDK for Go v2 service package source.
+func loadServiceModel(dir, goV2Package string) (*serviceModel, error) {
+	fileSet := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	apiFiles, err := parser.ParseDir(fileSet, dir, filter, parser.ParseComments)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", dir, err)
+	}
+
+	typesFiles, err := parser.ParseDir(fileSet, filepath.Join(dir, "types"), filter, parser.ParseComments)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, "types"), err)
+	}
+
+	model := &serviceModel{}
+	apiStructs, apiConsts, operations := structs{}, map[string]string{}, []string{}
+	typeStructs, typeEnums := structs{}, enums{}
+
+	for _, pkg := range apiFiles {
+		for name, file := range pkg.Files {
+			if op, ok := strings.CutPrefix(filepath.Base(name), "api_op_"); ok {
+				operations = append(operations, strings.TrimSuffix(op, ".go"))
+			}
+			collectDecls(file, apiStructs, apiConsts, nil)
+		}
+	}
+
+	for _, pkg := range typesFiles {
+		for _, file := range pkg.Files {
+			collectDecls(file, typeStructs, nil, typeEnums)
+		}
+	}
+
+	slices.Sort(operations)
+
+	model.ServiceID = strings.Trim(apiConsts["ServiceID"], `"`)
+	if model.ServiceID == "" {
+		return nil, fmt.Errorf("no ServiceID found in %s", dir)
+	}
+
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if _, ok := typeStructs[v]; ok {
+			model.NotFoundException = v
+			break
+		}
+	}
+
+	for _, op := range operations {
+		input, output := apiStructs[op+"Input"], apiStructs[op+"Output"]
+
+		if input == nil || output == nil {
+			continue
+		}
+
+		// Use the first List operation without required parameters as the endpoint test API call.
+		if model.EndpointAPICall == "" && strings.HasPrefix(op, "List") && op != "ListTagsForResource" && len(requiredFields(input)) == 0 {
+			model.EndpointAPICall = op
+		}
+
+		if model.NotFoundException == "" {
+			continue
+		}
+
+		var resource string
+		if v, ok := strings.CutPrefix(op, "Get"); ok {
+			resource = v
+		} else if v, ok := strings.CutPrefix(op, "Describe"); ok {
+			resource = v
+		} else {
+			continue
+		}
+
+		required := requiredFields(input)
+		if len(required) != 1 || exprString(required[0].Type) != "*string" {
+			continue
+		}
+
+		finder := Finder{
+			Resource:    resource,
+			Operation:   op,
+			InputIDElem: required[0].Names[0].Name,
+		}
+
+		var elems []*ast.Field
+		for _, field := range output.Fields.List {
+			if len(field.Names) == 1 && strings.HasPrefix(exprString(field.Type), "*types.") {
+				elems = append(elems, field)
+			}
+		}
+
+		// Either the output wraps a single resource structure or the resource's attributes are returned directly.
+		if len(elems) == 1 {
+			outputType := strings.TrimPrefix(exprString(elems[0].Type), "*types.")
+			finder.OutputElem = elems[0].Names[0].Name
+			finder.ResultType = "awstypes." + outputType
+
+			if v, ok := typeStructs[outputType]; ok {
+				finder.Status = resourceStatus(v, typeEnums)
+			}
+		} else if slices.ContainsFunc(output.Fields.List, isARNField) {
+			finder.ResultType = goV2Package + "." + op + "Output"
+			finder.Status = resourceStatus(output, typeEnums)
+		} else {
+			continue
+		}
+
+		model.Finders = append(model.Finders, finder)
+	}
+
+	model.Tagging = resourceTagging(apiStructs)
+
+	return model, nil
+}
+
+func collectDecls(file *ast.File, structs structs, consts map[string]string, enums enums) {
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if v, ok := spec.Type.(*ast.StructType); ok {
+					structs[spec.Name.Name] = v
+				}
+			case *ast.ValueSpec:
+				if decl.Tok != token.CONST || len(spec.Names) != 1 || len(spec.Values) != 1 {
+					continue
+				}
+				lit, ok := spec.Values[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				if consts != nil {
+					consts[spec.Names[0].Name] = lit.Value
+				}
+				if enums != nil && spec.Type != nil {
+					typeName := exprString(spec.Type)
+					if enums[typeName] == nil {
+						enums[typeName] = make(map[string]string)
+					}
+					enums[typeName][spec.Names[0].Name] = strings.Trim(lit.Value, `"`)
+				}
+			}
+		}
+	}
+}
+
+// requiredFields returns the fields documented as required by the SDK.
+func requiredFields(s *ast.StructType) []*ast.Field {
+	var fields []*ast.Field
+
+	for _, field := range s.Fields.List {
+		if len(field.Names) == 1 && field.Doc != nil && strings.Contains(field.Doc.Text(), "This member is required.") {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// resourceStatus classifies the values of a resource's status enum.
+func resourceStatus(s *ast.StructType, enums enums) *Status {
+	for _, field := range s.Fields.List {
+		if len(field.Names) != 1 || !slices.Contains([]string{"Status", "State"}, field.Names[0].Name) {
+			continue
+		}
+
+		values, ok := enums[strings.TrimPrefix(exprString(field.Type), "types.")]
+		if !ok {
+			continue
+		}
+
+		status := &Status{Elem: field.Names[0].Name}
+
+		for name, value := range values {
+			switch value = strings.ToUpper(value); {
+			case strings.Contains(value, "FAIL"), strings.Contains(value, "UNSUCCESSFUL"):
+				continue
+			case strings.Contains(value, "DELET"):
+				status.Deleting = append(status.Deleting, name)
+			case strings.Contains(value, "CREATING"), strings.Contains(value, "PENDING"), strings.Contains(value, "IN_PROGRESS"):
+				status.Pending = append(status.Pending, name)
+			case slices.Contains([]string{"ACTIVE", "AVAILABLE", "CREATED", "ENABLED", "READY"}, value):
+				status.Target = append(status.Target, name)
+			}
+		}
+
+		if len(status.Pending) == 0 || len(status.Target) == 0 {
+			return nil
+		}
+
+		slices.Sort(status.Pending)
+		slices.Sort(status.Target)
+		slices.Sort(status.Deleting)
+
+		return status
+	}
+
+	return nil
+}
+
+// resourceTagging derives the tags generator arguments from the TagResource, UntagResource and ListTagsForResource operations.
+func resourceTagging(apiStructs structs) *Tagging {
+	tagIn, untagIn := apiStructs["TagResourceInput"], apiStructs["UntagResourceInput"]
+
+	if tagIn == nil || untagIn == nil {
+		return nil
+	}
+
+	t := &Tagging{UpdateTags: true}
+
+	for _, field := range requiredFields(tagIn) {
+		switch typ := exprString(field.Type); {
+		case typ == "*string":
+			t.TagInIDElem = field.Names[0].Name
+		case typ == "map[string]string":
+			t.ServiceTagsMap = true
+			t.TagInTagsElem = field.Names[0].Name
+		case strings.HasPrefix(typ, "[]types."):
+			t.TagType = strings.TrimPrefix(typ, "[]types.")
+			t.TagInTagsElem = field.Names[0].Name
+		}
+	}
+
+	for _, field := range requiredFields(untagIn) {
+		if exprString(field.Type) == "[]string" {
+			t.UntagInTagsElem = field.Names[0].Name
+		}
+	}
+
+	if t.TagInIDElem == "" || t.TagInTagsElem == "" || t.UntagInTagsElem == "" {
+		return nil
+	}
+
+	if listIn, listOut := apiStructs["ListTagsForResourceInput"], apiStructs["ListTagsForResourceOutput"]; listIn != nil && listOut != nil {
+		for _, field := range requiredFields(listIn) {
+			if exprString(field.Type) == "*string" {
+				t.ListTagsInIDElem = field.Names[0].Name
+			}
+		}
+
+		for _, field := range listOut.Fields.List {
+			if len(field.Names) == 1 && slices.Contains([]string{"map[string]string", "[]types." + t.TagType}, exprString(field.Type)) {
+				t.ListTagsOutTagsElem = field.Names[0].Name
+			}
+		}
+
+		t.ListTags = t.ListTagsInIDElem != "" && t.ListTagsOutTagsElem != ""
+	}
+
+	return t
+}
+
+func isARNField(field *ast.Field) bool {
+	return len(field.Names) == 1 && exprString(field.Type) == "*string" && strings.HasSuffix(strings.ToUpper(field.Names[0].Name), "ARN")
+}
+
+func exprString(expr ast.Expr) string {
+	return types.ExprString(expr)
+}
+
+// addNamesData inserts a block for the service into the names data, keeping the blocks in alphabetical order.
+func addNamesData(g *common.Generator, td TemplateData) error {
+	b, err := os.ReadFile(namesDataFilename)
+
+	if err != nil {
+		return err
+	}
+
+	lines := strings.SplitAfter(string(b), "\n")
+	insertAt := len(lines)
+
+	for i, line := range lines {
+		if name, ok := strings.CutPrefix(line, `service "`); ok {
+			if name, _, _ = strings.Cut(name, `"`); name > td.ProviderPackage {
+				insertAt = i
+				break
+			}
+		}
+	}
+
+	d := g.NewUnformattedFileDestination(namesDataFilename)
+
+	if err := d.WriteBytes([]byte(strings.Join(lines[:insertAt], ""))); err != nil {
+		return err
+	}
+
+	if err := d.WriteTemplate("namesdata", namesDataTmpl, td); err != nil {
+		return err
+	}
+
+	if err := d.WriteBytes([]byte(strings.Join(lines[insertAt:], ""))); err != nil {
+		return err
+	}
+
+	return d.Write()
+}
+
+//go:embed generate.go.gtpl
+var generateTmpl string
+
+//go:embed find.go.gtpl
+var findTmpl string
+
+//go:embed status.go.gtpl
+var statusTmpl string
+
+//go:embed wait.go.gtpl
+var waitTmpl string
+
+//go:embed names_data.hcl.gtpl
+var namesDataTmpl string
@@ -145,6 +145,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"state_upgrade_dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to report resources whose state requires conversion from a deprecated attribute layout\ninstead of converting it. State upgrades that require a conversion fail with an error listing the conversions.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
@@ -301,6 +305,13 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	v := p.Primary.Meta()
 	response.DataSourceData = v
 	response.ResourceData = v
+
+	// Mirror the primary provider: if the provider configuration contains unknown values, defer all resources and data sources.
+	if request.ClientCapabilities.DeferralAllowed && !request.Config.Raw.IsFullyKnown() {
+		response.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+	}
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -466,6 +477,11 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewAZsSpreadFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewJSONMinifyPolicyFunction,
+		tffunction.NewNextAvailableCIDRFunction,
+		tffunction.NewSubnetSizesValidForVPCFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -31,7 +31,7 @@ import (
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
-// The provider instance is fully configured once the `ConfigureContextFunc` has been called.
+// The provider instance is fully configured once the `ConfigureProvider` function has been called.
 func New(ctx context.Context) (*schema.Provider, error) {
 	provider := &schema.Provider{
 		// This schema must match exactly the Terraform Protocol v6 (Terraform Plugin Framework) provider's schema.
@@ -225,6 +225,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"state_upgrade_dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to report resources whose state requires conversion from a deprecated attribute layout\n" +
+					"instead of converting it. State upgrades that require a conversion fail with an error listing the conversions.",
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -261,8 +267,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
-	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+	provider.ConfigureProvider = func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
+		// If the provider configuration depends on values that are not yet known (e.g. an IAM role created in the same configuration)
+		// and Terraform supports deferred actions, defer all resources and data sources rather than configuring with partial values.
+		if request.DeferralAllowed && !request.ResourceData.GetRawConfig().IsWhollyKnown() {
+			tflog.Info(ctx, "Provider configuration contains unknown values, deferring all resources and data sources")
+
+			response.Meta = provider.Meta()
+			response.Deferred = &schema.Deferred{
+				Reason: schema.DeferredReasonProviderConfigUnknown,
+			}
+
+			return
+		}
+
+		response.Meta, response.Diagnostics = configure(ctx, provider, request.ResourceData)
 	}
 
 	var errs []error
@@ -451,7 +470,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureContextFunc,
+	// It will be overwritten by the result of the call to ConfigureProvider,
 	// but can be used pre-configuration by other (non-primary) provider servers.
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
@@ -491,6 +510,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		StateUpgradeDryRun:             d.Get("state_upgrade_dry_run").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
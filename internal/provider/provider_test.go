@@ -11,6 +11,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -34,6 +36,73 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureDeferred(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Provider configuration in which the region is not yet known.
+	configType := schema.InternalMap(p.Schema).CoreConfigSchema().ImpliedType()
+	attrs := make(map[string]cty.Value)
+	for k, v := range configType.AttributeTypes() {
+		switch {
+		case v.IsListType():
+			attrs[k] = cty.ListValEmpty(v.ElementType())
+		case v.IsSetType():
+			attrs[k] = cty.SetValEmpty(v.ElementType())
+		default:
+			attrs[k] = cty.NullVal(v)
+		}
+	}
+	attrs["region"] = cty.UnknownVal(cty.String)
+
+	config, err := msgpack.Marshal(cty.ObjectVal(attrs), configType)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := schema.NewGRPCProviderServer(p)
+
+	configureResponse, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		ClientCapabilities: &tfprotov5.ConfigureProviderClientCapabilities{
+			DeferralAllowed: true,
+		},
+		Config: &tfprotov5.DynamicValue{MsgPack: config},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range configureResponse.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	readResponse, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName: "aws_vpc",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if readResponse.Deferred == nil {
+		t.Fatal("expected deferred response, got none")
+	}
+
+	if got, want := readResponse.Deferred.Reason, tfprotov5.DeferredReasonProviderConfigUnknown; got != want {
+		t.Errorf("expected deferred reason %s, got %s", want, got)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
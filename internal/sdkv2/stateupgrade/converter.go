@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateupgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Converter rewrites a deprecated attribute layout in a Plugin SDK v2 resource's raw state.
+type Converter struct {
+	// Description is a short, human-readable summary of the conversion used in dry-run reports.
+	Description string
+	// Detect returns whether the raw state contains the deprecated layout.
+	Detect func(rawState map[string]any) bool
+	// Convert rewrites the raw state in place.
+	Convert func(ctx context.Context, rawState map[string]any) error
+}
+
+// Upgrade returns a StateUpgradeFunc that runs the specified converters, in order, against a resource's raw state.
+// It is used as the Upgrade function of the resource's StateUpgrader for the schema version being upgraded from.
+func Upgrade(typeName string, version int, converters ...Converter) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+		if rawState == nil {
+			rawState = map[string]any{}
+		}
+
+		if err := convert(ctx, typeName, version, rawState, meta, converters); err != nil {
+			return nil, err
+		}
+
+		return rawState, nil
+	}
+}
+
+// convert runs the specified converters, in order, against a resource's raw state.
+// If the provider is configured for a dry run and any converter is required, no conversions are made
+// and an error listing the required conversions is returned instead, so that no state is written.
+func convert(ctx context.Context, typeName string, version int, rawState map[string]any, meta any, converters []Converter) error {
+	var required []Converter
+	for _, converter := range converters {
+		if converter.Detect == nil || converter.Detect(rawState) {
+			required = append(required, converter)
+		}
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	if v, ok := meta.(dryRunner); ok && v.StateUpgradeDryRun(ctx) {
+		descriptions := make([]string, 0, len(required))
+		for _, converter := range required {
+			descriptions = append(descriptions, converter.Description)
+		}
+
+		return fmt.Errorf("%s state (schema version %d) requires upgrade (state_upgrade_dry_run is set): %s", typeName, version, strings.Join(descriptions, "; "))
+	}
+
+	for _, converter := range required {
+		tflog.Debug(ctx, "converting resource state", map[string]any{
+			"resource_type":  typeName,
+			"schema_version": version,
+			"conversion":     converter.Description,
+		})
+
+		if err := converter.Convert(ctx, rawState); err != nil {
+			return fmt.Errorf("converting %s state (schema version %d), %s: %w", typeName, version, converter.Description, err)
+		}
+	}
+
+	return nil
+}
+
+// dryRunner is implemented by provider instance data that can request a state upgrade dry run.
+type dryRunner interface {
+	StateUpgradeDryRun(context.Context) bool
+}
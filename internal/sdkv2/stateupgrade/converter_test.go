@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateupgrade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testMeta struct {
+	dryRun bool
+}
+
+func (m testMeta) StateUpgradeDryRun(context.Context) bool {
+	return m.dryRun
+}
+
+var testConverter = Converter{
+	Description: "rename old_block to new_block",
+	Detect: func(rawState map[string]any) bool {
+		_, ok := rawState["old_block"]
+		return ok
+	},
+	Convert: func(_ context.Context, rawState map[string]any) error {
+		rawState["new_block"] = rawState["old_block"]
+		delete(rawState, "old_block")
+
+		return nil
+	},
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		converters    []Converter
+		meta          any
+		rawState      map[string]any
+		want          map[string]any
+		expectedError bool
+	}{
+		"no converters": {
+			rawState: map[string]any{"old_block": []any{}},
+			want:     map[string]any{"old_block": []any{}},
+		},
+		"not detected": {
+			converters: []Converter{testConverter},
+			rawState:   map[string]any{"name": "test"},
+			want:       map[string]any{"name": "test"},
+		},
+		"converted": {
+			converters: []Converter{testConverter},
+			rawState:   map[string]any{"old_block": []any{"x"}},
+			want:       map[string]any{"new_block": []any{"x"}},
+		},
+		"converted no dry run": {
+			converters: []Converter{testConverter},
+			meta:       testMeta{},
+			rawState:   map[string]any{"old_block": []any{"x"}},
+			want:       map[string]any{"new_block": []any{"x"}},
+		},
+		"dry run": {
+			converters: []Converter{
+				testConverter,
+				{
+					Description: "set default",
+					Convert: func(_ context.Context, rawState map[string]any) error {
+						rawState["name"] = "default"
+
+						return nil
+					},
+				},
+			},
+			meta:          testMeta{dryRun: true},
+			rawState:      map[string]any{"old_block": []any{"x"}},
+			want:          map[string]any{"old_block": []any{"x"}},
+			expectedError: true,
+		},
+		"dry run not detected": {
+			converters: []Converter{testConverter},
+			meta:       testMeta{dryRun: true},
+			rawState:   map[string]any{"name": "test"},
+			want:       map[string]any{"name": "test"},
+		},
+		"convert error": {
+			converters: []Converter{{
+				Description: "fail",
+				Convert: func(context.Context, map[string]any) error {
+					return errors.New("failed")
+				},
+			}},
+			rawState:      map[string]any{},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := convert(context.Background(), "aws_test", 0, testCase.rawState, testCase.meta, testCase.converters)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("expected error: %t, got: %v", want, err)
+			}
+
+			if err != nil && testCase.want == nil {
+				return
+			}
+
+			if diff := cmp.Diff(testCase.rawState, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		meta          any
+		rawState      map[string]any
+		want          map[string]any
+		expectedError bool
+	}{
+		"nil state": {
+			want: map[string]any{},
+		},
+		"not detected": {
+			rawState: map[string]any{"name": "test"},
+			want:     map[string]any{"name": "test"},
+		},
+		"converted": {
+			rawState: map[string]any{"old_block": []any{"x"}},
+			want:     map[string]any{"new_block": []any{"x"}},
+		},
+		"converted no dry run": {
+			meta:     testMeta{},
+			rawState: map[string]any{"old_block": []any{"x"}},
+			want:     map[string]any{"new_block": []any{"x"}},
+		},
+		"dry run": {
+			meta:          testMeta{dryRun: true},
+			rawState:      map[string]any{"old_block": []any{"x"}},
+			expectedError: true,
+		},
+		"dry run not detected": {
+			meta:     testMeta{dryRun: true},
+			rawState: map[string]any{"name": "test"},
+			want:     map[string]any{"name": "test"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Upgrade("aws_test", 0, testConverter)(context.Background(), testCase.rawState, testCase.meta)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("expected error: %t, got: %v", want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -98,6 +100,10 @@ func resourceDomainName() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"domain_name_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"endpoint_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -114,7 +120,7 @@ func resourceDomainName() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(enum.Slice(types.EndpointTypeEdge, types.EndpointTypeRegional), false),
+								ValidateFunc: validation.StringInSlice(enum.Slice(types.EndpointTypeEdge, types.EndpointTypePrivate, types.EndpointTypeRegional), false),
 							},
 						},
 					},
@@ -143,6 +149,17 @@ func resourceDomainName() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			names.AttrPolicy: {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"regional_certificate_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -214,6 +231,15 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.OwnershipVerificationCertificateArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk(names.AttrPolicy); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Policy = aws.String(policy)
+	}
+
 	if v, ok := d.GetOk("regional_certificate_arn"); ok {
 		input.RegionalCertificateArn = aws.String(v.(string))
 	}
@@ -232,7 +258,7 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "creating API Gateway Domain Name (%s): %s", domainName, err)
 	}
 
-	d.SetId(aws.ToString(output.DomainName))
+	d.SetId(domainNameCreateResourceID(aws.ToString(output.DomainName), aws.ToString(output.DomainNameId)))
 
 	return append(diags, resourceDomainNameRead(ctx, d, meta)...)
 }
@@ -241,7 +267,12 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
 
-	domainName, err := findDomainByName(ctx, conn, d.Id())
+	domainName, domainNameID, err := domainNameParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findDomainNameByTwoPartKey(ctx, conn, domainName, domainNameID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] API Gateway Domain Name (%s) not found, removing from state", d.Id())
@@ -253,31 +284,48 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Domain Name (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, domainNameARN(meta.(*conns.AWSClient), d.Id()))
-	d.Set(names.AttrCertificateARN, domainName.CertificateArn)
-	d.Set("certificate_name", domainName.CertificateName)
-	if domainName.CertificateUploadDate != nil {
-		d.Set("certificate_upload_date", domainName.CertificateUploadDate.Format(time.RFC3339))
+	if v := aws.ToString(output.DomainNameArn); v != "" {
+		d.Set(names.AttrARN, v)
+	} else {
+		d.Set(names.AttrARN, domainNameARN(meta.(*conns.AWSClient), domainName))
+	}
+	d.Set(names.AttrCertificateARN, output.CertificateArn)
+	d.Set("certificate_name", output.CertificateName)
+	if output.CertificateUploadDate != nil {
+		d.Set("certificate_upload_date", output.CertificateUploadDate.Format(time.RFC3339))
 	} else {
 		d.Set("certificate_upload_date", nil)
 	}
-	d.Set("cloudfront_domain_name", domainName.DistributionDomainName)
+	d.Set("cloudfront_domain_name", output.DistributionDomainName)
 	d.Set("cloudfront_zone_id", meta.(*conns.AWSClient).CloudFrontDistributionHostedZoneID(ctx))
-	d.Set(names.AttrDomainName, domainName.DomainName)
-	if err := d.Set("endpoint_configuration", flattenEndpointConfiguration(domainName.EndpointConfiguration)); err != nil {
+	d.Set(names.AttrDomainName, output.DomainName)
+	d.Set("domain_name_id", output.DomainNameId)
+	if err := d.Set("endpoint_configuration", flattenEndpointConfiguration(output.EndpointConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting endpoint_configuration: %s", err)
 	}
-	if err = d.Set("mutual_tls_authentication", flattenMutualTLSAuthentication(domainName.MutualTlsAuthentication)); err != nil {
+	if err = d.Set("mutual_tls_authentication", flattenMutualTLSAuthentication(output.MutualTlsAuthentication)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting mutual_tls_authentication: %s", err)
 	}
-	d.Set("ownership_verification_certificate_arn", domainName.OwnershipVerificationCertificateArn)
-	d.Set("regional_certificate_arn", domainName.RegionalCertificateArn)
-	d.Set("regional_certificate_name", domainName.RegionalCertificateName)
-	d.Set("regional_domain_name", domainName.RegionalDomainName)
-	d.Set("regional_zone_id", domainName.RegionalHostedZoneId)
-	d.Set("security_policy", domainName.SecurityPolicy)
+	d.Set("ownership_verification_certificate_arn", output.OwnershipVerificationCertificateArn)
+
+	policy, err := flattenAPIPolicy(output.Policy)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get(names.AttrPolicy).(string), policy)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
-	setTagsOut(ctx, domainName.Tags)
+	d.Set(names.AttrPolicy, policyToSet)
+	d.Set("regional_certificate_arn", output.RegionalCertificateArn)
+	d.Set("regional_certificate_name", output.RegionalCertificateName)
+	d.Set("regional_domain_name", output.RegionalDomainName)
+	d.Set("regional_zone_id", output.RegionalHostedZoneId)
+	d.Set("security_policy", output.SecurityPolicy)
+
+	setTagsOut(ctx, output.Tags)
 
 	return diags
 }
@@ -286,6 +334,11 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
 
+	domainName, domainNameID, err := domainNameParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		var operations []types.PatchOperation
 
@@ -356,6 +409,16 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 			})
 		}
 
+		if d.HasChange(names.AttrPolicy) {
+			policy, _ := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string)) // validation covers error
+
+			operations = append(operations, types.PatchOperation{
+				Op:    types.OpReplace,
+				Path:  aws.String("/policy"),
+				Value: aws.String(policy),
+			})
+		}
+
 		if d.HasChange("regional_certificate_arn") {
 			operations = append(operations, types.PatchOperation{
 				Op:    types.OpReplace,
@@ -380,16 +443,21 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 			})
 		}
 
-		_, err := conn.UpdateDomainName(ctx, &apigateway.UpdateDomainNameInput{
-			DomainName:      aws.String(d.Id()),
+		input := &apigateway.UpdateDomainNameInput{
+			DomainName:      aws.String(domainName),
 			PatchOperations: operations,
-		})
+		}
+		if domainNameID != "" {
+			input.DomainNameId = aws.String(domainNameID)
+		}
+
+		_, err := conn.UpdateDomainName(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating API Gateway Domain Name (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDomainNameUpdated(ctx, conn, d.Id()); err != nil {
+		if _, err := waitDomainNameUpdated(ctx, conn, domainName, domainNameID); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for API Gateway Domain Name (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -401,10 +469,20 @@ func resourceDomainNameDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
 
+	domainName, domainNameID, err := domainNameParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &apigateway.DeleteDomainNameInput{
+		DomainName: aws.String(domainName),
+	}
+	if domainNameID != "" {
+		input.DomainNameId = aws.String(domainNameID)
+	}
+
 	log.Printf("[DEBUG] Deleting API Gateway Domain Name: %s", d.Id())
-	_, err := conn.DeleteDomainName(ctx, &apigateway.DeleteDomainNameInput{
-		DomainName: aws.String(d.Id()),
-	})
+	_, err = conn.DeleteDomainName(ctx, input)
 
 	if errs.IsA[*types.NotFoundException](err) {
 		return diags
@@ -417,10 +495,46 @@ func resourceDomainNameDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// Private custom domain names are identified by both the domain name and the domain name ID.
+const domainNameResourceIDSeparator = "/"
+
+func domainNameCreateResourceID(domainName, domainNameID string) string {
+	if domainNameID == "" {
+		return domainName
+	}
+
+	parts := []string{domainName, domainNameID}
+	id := strings.Join(parts, domainNameResourceIDSeparator)
+
+	return id
+}
+
+func domainNameParseResourceID(id string) (string, string, error) {
+	switch parts := strings.Split(id, domainNameResourceIDSeparator); len(parts) {
+	case 1:
+		if domainName := parts[0]; domainName != "" {
+			return domainName, "", nil
+		}
+	case 2:
+		if domainName, domainNameID := parts[0], parts[1]; domainName != "" && domainNameID != "" {
+			return domainName, domainNameID, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-NAME or DOMAIN-NAME%[2]sDOMAIN-NAME-ID", id, domainNameResourceIDSeparator)
+}
+
 func findDomainByName(ctx context.Context, conn *apigateway.Client, domainName string) (*apigateway.GetDomainNameOutput, error) {
+	return findDomainNameByTwoPartKey(ctx, conn, domainName, "")
+}
+
+func findDomainNameByTwoPartKey(ctx context.Context, conn *apigateway.Client, domainName, domainNameID string) (*apigateway.GetDomainNameOutput, error) {
 	input := &apigateway.GetDomainNameInput{
 		DomainName: aws.String(domainName),
 	}
+	if domainNameID != "" {
+		input.DomainNameId = aws.String(domainNameID)
+	}
 
 	output, err := conn.GetDomainName(ctx, input)
 
@@ -442,9 +556,9 @@ func findDomainByName(ctx context.Context, conn *apigateway.Client, domainName s
 	return output, nil
 }
 
-func statusDomainName(ctx context.Context, conn *apigateway.Client, domainName string) retry.StateRefreshFunc {
+func statusDomainName(ctx context.Context, conn *apigateway.Client, domainName, domainNameID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findDomainByName(ctx, conn, domainName)
+		output, err := findDomainNameByTwoPartKey(ctx, conn, domainName, domainNameID)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -457,14 +571,14 @@ func statusDomainName(ctx context.Context, conn *apigateway.Client, domainName s
 	}
 }
 
-func waitDomainNameUpdated(ctx context.Context, conn *apigateway.Client, domainName string) (*types.DomainName, error) {
+func waitDomainNameUpdated(ctx context.Context, conn *apigateway.Client, domainName, domainNameID string) (*types.DomainName, error) {
 	const (
 		timeout = 15 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.DomainNameStatusUpdating),
 		Target:     enum.Slice(types.DomainNameStatusAvailable),
-		Refresh:    statusDomainName(ctx, conn, domainName),
+		Refresh:    statusDomainName(ctx, conn, domainName, domainNameID),
 		Timeout:    timeout,
 		Delay:      1 * time.Minute,
 		MinTimeout: 10 * time.Second,
@@ -5,30 +5,31 @@ package apigateway
 
 // Exports for use in tests only.
 var (
-	ResourceAccount              = resourceAccount
-	ResourceAPIKey               = resourceAPIKey
-	ResourceAuthorizer           = resourceAuthorizer
-	ResourceBasePathMapping      = resourceBasePathMapping
-	ResourceClientCertificate    = resourceClientCertificate
-	ResourceDeployment           = resourceDeployment
-	ResourceDocumentationPart    = resourceDocumentationPart
-	ResourceDocumentationVersion = resourceDocumentationVersion
-	ResourceDomainName           = resourceDomainName
-	ResourceGatewayResponse      = resourceGatewayResponse
-	ResourceIntegration          = resourceIntegration
-	ResourceIntegrationResponse  = resourceIntegrationResponse
-	ResourceMethod               = resourceMethod
-	ResourceMethodResponse       = resourceMethodResponse
-	ResourceMethodSettings       = resourceMethodSettings
-	ResourceModel                = resourceModel
-	ResourceRequestValidator     = resourceRequestValidator
-	ResourceResource             = resourceResource
-	ResourceRestAPI              = resourceRestAPI
-	ResourceRestAPIPolicy        = resourceRestAPIPolicy
-	ResourceStage                = resourceStage
-	ResourceUsagePlan            = resourceUsagePlan
-	ResourceUsagePlanKey         = resourceUsagePlanKey
-	ResourceVPCLink              = resourceVPCLink
+	ResourceAccount                     = resourceAccount
+	ResourceAPIKey                      = resourceAPIKey
+	ResourceAuthorizer                  = resourceAuthorizer
+	ResourceBasePathMapping             = resourceBasePathMapping
+	ResourceClientCertificate           = resourceClientCertificate
+	ResourceDeployment                  = resourceDeployment
+	ResourceDocumentationPart           = resourceDocumentationPart
+	ResourceDocumentationVersion        = resourceDocumentationVersion
+	ResourceDomainName                  = resourceDomainName
+	ResourceDomainNameAccessAssociation = resourceDomainNameAccessAssociation
+	ResourceGatewayResponse             = resourceGatewayResponse
+	ResourceIntegration                 = resourceIntegration
+	ResourceIntegrationResponse         = resourceIntegrationResponse
+	ResourceMethod                      = resourceMethod
+	ResourceMethodResponse              = resourceMethodResponse
+	ResourceMethodSettings              = resourceMethodSettings
+	ResourceModel                       = resourceModel
+	ResourceRequestValidator            = resourceRequestValidator
+	ResourceResource                    = resourceResource
+	ResourceRestAPI                     = resourceRestAPI
+	ResourceRestAPIPolicy               = resourceRestAPIPolicy
+	ResourceStage                       = resourceStage
+	ResourceUsagePlan                   = resourceUsagePlan
+	ResourceUsagePlanKey                = resourceUsagePlanKey
+	ResourceVPCLink                     = resourceVPCLink
 
 	DefaultAuthorizerTTL                 = defaultAuthorizerTTL
 	FindAPIKeyByID                       = findAPIKeyByID
@@ -39,6 +40,8 @@ var (
 	FindDocumentationPartByTwoPartKey    = findDocumentationPartByTwoPartKey
 	FindDocumentationVersionByTwoPartKey = findDocumentationVersionByTwoPartKey
 	FindDomainByName                     = findDomainByName
+	FindDomainNameAccessAssociationByARN = findDomainNameAccessAssociationByARN
+	FindDomainNameByTwoPartKey           = findDomainNameByTwoPartKey
 	FindGatewayResponseByTwoPartKey      = findGatewayResponseByTwoPartKey
 	FindIntegrationByThreePartKey        = findIntegrationByThreePartKey
 	FindIntegrationResponseByFourPartKey = findIntegrationResponseByFourPartKey
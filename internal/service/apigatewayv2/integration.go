@@ -5,6 +5,7 @@ package apigatewayv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -38,6 +39,8 @@ func resourceIntegration() *schema.Resource {
 			StateContext: resourceIntegrationImport,
 		},
 
+		CustomizeDiff: resourceIntegrationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -143,8 +146,9 @@ func resourceIntegration() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"mappings": {
-							Type:     schema.TypeMap,
-							Required: true,
+							Type:         schema.TypeMap,
+							Required:     true,
+							ValidateFunc: validIntegrationResponseParameterMappings(),
 							// Length between [1-512].
 							Elem: &schema.Schema{Type: schema.TypeString},
 						},
@@ -509,6 +513,24 @@ func findIntegration(ctx context.Context, conn *apigatewayv2.Client, input *apig
 	return output, nil
 }
 
+func resourceIntegrationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("integration_subtype") {
+		return nil
+	}
+
+	// Validate request parameter mappings at plan time rather than waiting for the API to reject them.
+	serviceIntegration := d.Get("integration_subtype").(string) != ""
+	var validationErrs []error
+
+	for k := range d.Get("request_parameters").(map[string]interface{}) {
+		if err := validIntegrationRequestParameterKey(k, serviceIntegration); err != nil {
+			validationErrs = append(validationErrs, fmt.Errorf("request_parameters: %w", err))
+		}
+	}
+
+	return errors.Join(validationErrs...)
+}
+
 func expandTLSConfig(vConfig []interface{}) *awstypes.TlsConfigInput {
 	config := &awstypes.TlsConfigInput{}
 
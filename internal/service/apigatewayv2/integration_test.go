@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -530,6 +531,30 @@ func TestAccAPIGatewayV2Integration_vpcLinkHTTP(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2Integration_dataMappingValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_dataMappingHTTPInvalidRequestParameter(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"replace:header.header1" is not a valid request parameter mapping`),
+			},
+			{
+				Config:      testAccIntegrationConfig_dataMappingHTTPInvalidResponseParameter(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"overwrite:path" is not a valid response parameter mapping`),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayV2Integration_serviceIntegration(t *testing.T) {
 	ctx := acctest.Context(t)
 	var apiId string
@@ -855,6 +880,42 @@ resource "aws_apigatewayv2_integration" "test" {
 `)
 }
 
+func testAccIntegrationConfig_dataMappingHTTPInvalidRequestParameter(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_apiHTTP(rName), `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  integration_type   = "HTTP_PROXY"
+  integration_method = "ANY"
+  integration_uri    = "http://www.example.com"
+
+  request_parameters = {
+    "replace:header.header1" = "$context.requestId"
+  }
+}
+`)
+}
+
+func testAccIntegrationConfig_dataMappingHTTPInvalidResponseParameter(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_apiHTTP(rName), `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  integration_type   = "HTTP_PROXY"
+  integration_method = "ANY"
+  integration_uri    = "http://www.example.com"
+
+  response_parameters {
+    status_code = "500"
+
+    mappings = {
+      "overwrite:path" = "/error"
+    }
+  }
+}
+`)
+}
+
 func testAccIntegrationConfig_typeHTTP(rName string) string {
 	return acctest.ConfigCompose(testAccIntegrationConfig_apiWebSocket(rName), `
 resource "aws_apigatewayv2_integration" "test" {
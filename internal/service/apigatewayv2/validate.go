@@ -4,6 +4,9 @@
 package apigatewayv2
 
 import (
+	"fmt"
+
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,3 +23,50 @@ func validHTTPMethod() schema.SchemaValidateFunc {
 		"PUT",
 	}, false)
 }
+
+var (
+	// HTTP API parameter mapping: "<action>:<destination>".
+	// https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-parameter-mapping.html.
+	httpAPIRequestParameterMappingRegexp  = regexache.MustCompile(`^((append|overwrite|remove):(header|querystring)\.\S+|overwrite:path)$`)
+	httpAPIResponseParameterMappingRegexp = regexache.MustCompile(`^((append|overwrite|remove):header\.\S+|overwrite:statuscode)$`)
+	// WebSocket API parameter mapping: "integration.request.<location>.<name>".
+	// https://docs.aws.amazon.com/apigateway/latest/developerguide/websocket-api-data-transformations.html.
+	webSocketAPIRequestParameterMappingRegexp = regexache.MustCompile(`^integration\.request\.(header|querystring|path)\.\S+$`)
+	// AWS service integration parameter: e.g. "QueueUrl".
+	// https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-develop-integrations-aws-services-reference.html.
+	serviceIntegrationRequestParameterRegexp = regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*$`)
+)
+
+func validIntegrationRequestParameterKey(key string, serviceIntegration bool) error {
+	if serviceIntegration {
+		if !serviceIntegrationRequestParameterRegexp.MatchString(key) {
+			return fmt.Errorf("%q is not a valid AWS service integration request parameter name", key)
+		}
+
+		return nil
+	}
+
+	if !httpAPIRequestParameterMappingRegexp.MatchString(key) && !webSocketAPIRequestParameterMappingRegexp.MatchString(key) {
+		return fmt.Errorf("%q is not a valid request parameter mapping, expected <append|overwrite|remove>:<header|querystring>.<name>, overwrite:path or integration.request.<header|querystring|path>.<name>", key)
+	}
+
+	return nil
+}
+
+func validIntegrationResponseParameterMappings() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be map", k))
+			return
+		}
+
+		for key := range m {
+			if !httpAPIResponseParameterMappingRegexp.MatchString(key) {
+				errors = append(errors, fmt.Errorf("%s: %q is not a valid response parameter mapping, expected <append|overwrite|remove>:header.<name> or overwrite:statuscode", k, key))
+			}
+		}
+
+		return
+	}
+}
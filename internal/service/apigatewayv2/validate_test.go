@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"testing"
+)
+
+func TestValidIntegrationRequestParameterKey(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Key                string
+		ServiceIntegration bool
+		ErrCount           int
+	}{
+		{
+			Key:      "append:header.header1",
+			ErrCount: 0,
+		},
+		{
+			Key:      "overwrite:querystring.qs1",
+			ErrCount: 0,
+		},
+		{
+			Key:      "remove:header.x-userid",
+			ErrCount: 0,
+		},
+		{
+			Key:      "overwrite:path",
+			ErrCount: 0,
+		},
+		{
+			Key:      "integration.request.header.x-userid",
+			ErrCount: 0,
+		},
+		{
+			Key:      "integration.request.path.op",
+			ErrCount: 0,
+		},
+		{
+			Key:      "append:path",
+			ErrCount: 1,
+		},
+		{
+			Key:      "replace:header.header1",
+			ErrCount: 1,
+		},
+		{
+			Key:      "overwrite:body.field1",
+			ErrCount: 1,
+		},
+		{
+			Key:      "append:header.",
+			ErrCount: 1,
+		},
+		{
+			Key:      "integration.request.body.field1",
+			ErrCount: 1,
+		},
+		{
+			Key:      "QueueUrl",
+			ErrCount: 1,
+		},
+		{
+			Key:                "QueueUrl",
+			ServiceIntegration: true,
+			ErrCount:           0,
+		},
+		{
+			Key:                "append:header.header1",
+			ServiceIntegration: true,
+			ErrCount:           1,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validIntegrationRequestParameterKey(tc.Key, tc.ServiceIntegration)
+
+		var errCount int
+		if err != nil {
+			errCount = 1
+		}
+
+		if errCount != tc.ErrCount {
+			t.Errorf("%q (service integration: %t): expected %d errors, got %d: %v", tc.Key, tc.ServiceIntegration, tc.ErrCount, errCount, err)
+		}
+	}
+}
+
+func TestValidIntegrationResponseParameterMappings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Mappings map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Mappings: map[string]interface{}{
+				"append:header.header1": "$context.requestId",
+				"overwrite:statuscode":  "403",
+			},
+			ErrCount: 0,
+		},
+		{
+			Mappings: map[string]interface{}{
+				"remove:header.header1": "''",
+			},
+			ErrCount: 0,
+		},
+		{
+			Mappings: map[string]interface{}{
+				"append:querystring.qs1": "$context.requestId",
+				"overwrite:path":         "/",
+			},
+			ErrCount: 2,
+		},
+		{
+			Mappings: map[string]interface{}{
+				"append:statuscode": "403",
+			},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validIntegrationResponseParameterMappings()(tc.Mappings, "mappings")
+
+		if len(errors) != tc.ErrCount {
+			t.Errorf("%v: expected %d errors, got %d: %v", tc.Mappings, tc.ErrCount, len(errors), errors)
+		}
+	}
+}
@@ -560,6 +560,154 @@ resource "aws_appautoscaling_policy" "metric_math_test" {
 `, rName))
 }
 
+func TestAccAppAutoScalingPolicy_predictiveScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appautoscaling_policy.predictive_test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_predictiveScalingPredefined(rName, "ForecastOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.max_capacity_breach_behavior", "HonorMaxCapacity"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_metric_pair_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_metric_pair_specification.0.predefined_metric_type", "ECSServiceCPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.target_value", "40"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.mode", "ForecastOnly"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.scheduling_buffer_time", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_predictiveScalingPredefined(rName, "ForecastAndScale"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.mode", "ForecastAndScale"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppAutoScalingPolicy_predictiveScalingCustomized(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appautoscaling_policy.predictive_test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_predictiveScalingCustomized(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_load_metric_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_query.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_query.0.metric_stat.0.metric.0.dimension.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_query.0.metric_stat.0.stat", "Sum"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_scaling_metric_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_scaling_metric_specification.0.predefined_metric_type", "ECSServiceAverageCPUUtilization"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyConfig_predictiveScalingPredefined(rName, mode string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_basic(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_policy" "predictive_test" {
+  name               = "%[1]s-predictive"
+  policy_type        = "PredictiveScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  predictive_scaling_policy_configuration {
+    mode = %[2]q
+
+    metric_specification {
+      target_value = 40
+
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ECSServiceCPUUtilization"
+      }
+    }
+  }
+}
+`, rName, mode))
+}
+
+func testAccPolicyConfig_predictiveScalingCustomized(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_basic(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_policy" "predictive_test" {
+  name               = "%[1]s-predictive"
+  policy_type        = "PredictiveScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  predictive_scaling_policy_configuration {
+    metric_specification {
+      target_value = 40
+
+      customized_load_metric_specification {
+        metric_data_query {
+          id = "load_sum"
+
+          metric_stat {
+            stat = "Sum"
+
+            metric {
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/ECS"
+
+              dimension {
+                name  = "ClusterName"
+                value = aws_ecs_cluster.test.name
+              }
+              dimension {
+                name  = "ServiceName"
+                value = aws_ecs_service.test.name
+              }
+            }
+          }
+        }
+      }
+
+      predefined_scaling_metric_specification {
+        predefined_metric_type = "ECSServiceAverageCPUUtilization"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccCheckPolicyExists(ctx context.Context, n string, v *awstypes.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
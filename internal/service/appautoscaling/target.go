@@ -11,9 +11,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -103,7 +105,10 @@ func resourceTarget() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffComprehendEndpoint,
+		),
 	}
 }
 
@@ -319,6 +324,41 @@ func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.Cl
 	return err
 }
 
+// customizeDiffComprehendEndpoint ensures that an Amazon Comprehend custom model endpoint
+// is registered with the scalable dimension that corresponds to the endpoint's model type.
+func customizeDiffComprehendEndpoint(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("service_namespace").(string) != string(awstypes.ServiceNamespaceComprehend) {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrResourceID) || !d.NewValueKnown("scalable_dimension") {
+		return nil
+	}
+
+	resourceID := d.Get(names.AttrResourceID).(string)
+	parsedARN, err := arn.Parse(resourceID)
+
+	if err != nil {
+		return fmt.Errorf("%s (%s) must be the ARN of an Amazon Comprehend document classifier or entity recognizer endpoint: %w", names.AttrResourceID, resourceID, err)
+	}
+
+	var want awstypes.ScalableDimension
+	switch {
+	case strings.HasPrefix(parsedARN.Resource, "document-classifier-endpoint/"):
+		want = awstypes.ScalableDimensionComprehendDocClassifierEndpointInferenceUnits
+	case strings.HasPrefix(parsedARN.Resource, "entity-recognizer-endpoint/"):
+		want = awstypes.ScalableDimensionComprehendEntityRecognizerEndpointInferenceUnits
+	default:
+		return fmt.Errorf("%s (%s) must be the ARN of an Amazon Comprehend document classifier or entity recognizer endpoint", names.AttrResourceID, resourceID)
+	}
+
+	if got := d.Get("scalable_dimension").(string); got != string(want) {
+		return fmt.Errorf("scalable_dimension (%s) must be %q for Amazon Comprehend endpoint %s", got, want, resourceID)
+	}
+
+	return nil
+}
+
 func expandSuspendedState(tfList []interface{}) *awstypes.SuspendedState {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
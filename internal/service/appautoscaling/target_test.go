@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -297,6 +298,27 @@ func TestAccAppAutoScalingTarget_suspendedState_maintainsExisting(t *testing.T)
 	})
 }
 
+func TestAccAppAutoScalingTarget_comprehendEndpointInvalidDimension(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetConfig_comprehendEndpoint("document-classifier-endpoint", "comprehend:entity-recognizer-endpoint:DesiredInferenceUnits"),
+				ExpectError: regexache.MustCompile(`scalable_dimension \(comprehend:entity-recognizer-endpoint:DesiredInferenceUnits\) must be "comprehend:document-classifier-endpoint:DesiredInferenceUnits"`),
+			},
+			{
+				Config:      testAccTargetConfig_comprehendEndpoint("document-classifier", "comprehend:document-classifier-endpoint:DesiredInferenceUnits"),
+				ExpectError: regexache.MustCompile(`must be the ARN of an Amazon Comprehend document classifier or entity recognizer endpoint`),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
@@ -784,6 +806,22 @@ resource "aws_appautoscaling_target" "test" {
 `, rName)
 }
 
+func testAccTargetConfig_comprehendEndpoint(resourceType, scalableDimension string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "comprehend"
+  resource_id        = "arn:${data.aws_partition.current.partition}:comprehend:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s/example"
+  scalable_dimension = %[2]q
+  min_capacity       = 1
+  max_capacity       = 2
+}
+`, resourceType, scalableDimension)
+}
+
 func testAccTargetConfig_suspendedState(rName string, dsis, dsos, sss bool) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -5,6 +5,7 @@ package appflow
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"strings"
@@ -726,8 +727,10 @@ func resourceFlow() *schema.Resource {
 				},
 			},
 			"flow_status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(types.FlowStatusActive, types.FlowStatusSuspended), false),
 			},
 			"kms_arn": {
 				Type:         schema.TypeString,
@@ -962,6 +965,34 @@ func resourceFlow() *schema.Resource {
 													Required:     true,
 													ValidateFunc: validation.All(validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"), validation.StringLenBetween(1, 512)),
 												},
+												"pagination_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_page_size": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10000),
+															},
+														},
+													},
+												},
+												"parallelism_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_parallelism": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1350,6 +1381,12 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.ToString(output.FlowArn))
 
+	if v, ok := d.GetOk("flow_status"); ok && types.FlowStatus(v.(string)) != output.FlowStatus {
+		if err := updateFlowStatus(ctx, conn, name, types.FlowStatus(v.(string))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceFlowRead(ctx, d, meta)...)
 }
 
@@ -1420,7 +1457,7 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	conn := meta.(*conns.AWSClient).AppFlowClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("flow_status", names.AttrTags, names.AttrTagsAll) {
 		input := &appflow.UpdateFlowInput{
 			DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
 			FlowName:                  aws.String(d.Get(names.AttrName).(string)),
@@ -1445,9 +1482,54 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("flow_status") {
+		if v, ok := d.GetOk("flow_status"); ok {
+			if err := updateFlowStatus(ctx, conn, d.Get(names.AttrName).(string), types.FlowStatus(v.(string))); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceFlowRead(ctx, d, meta)...)
 }
 
+// updateFlowStatus activates or deactivates a schedule-triggered or event-triggered flow.
+func updateFlowStatus(ctx context.Context, conn *appflow.Client, name string, status types.FlowStatus) error {
+	switch status {
+	case types.FlowStatusActive:
+		input := &appflow.StartFlowInput{
+			FlowName: aws.String(name),
+		}
+
+		_, err := conn.StartFlow(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("starting AppFlow Flow (%s): %w", name, err)
+		}
+
+		if _, err := waitFlowActive(ctx, conn, name); err != nil {
+			return fmt.Errorf("waiting for AppFlow Flow (%s) start: %w", name, err)
+		}
+
+	case types.FlowStatusSuspended:
+		input := &appflow.StopFlowInput{
+			FlowName: aws.String(name),
+		}
+
+		_, err := conn.StopFlow(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("stopping AppFlow Flow (%s): %w", name, err)
+		}
+
+		if _, err := waitFlowSuspended(ctx, conn, name); err != nil {
+			return fmt.Errorf("waiting for AppFlow Flow (%s) stop: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1521,6 +1603,46 @@ func statusFlow(ctx context.Context, conn *appflow.Client, name string) retry.St
 	}
 }
 
+func waitFlowActive(ctx context.Context, conn *appflow.Client, name string) (*appflow.DescribeFlowOutput, error) {
+	const (
+		timeout = 2 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FlowStatusDraft, types.FlowStatusSuspended),
+		Target:  enum.Slice(types.FlowStatusActive),
+		Refresh: statusFlow(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appflow.DescribeFlowOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowSuspended(ctx context.Context, conn *appflow.Client, name string) (*appflow.DescribeFlowOutput, error) {
+	const (
+		timeout = 2 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FlowStatusActive),
+		Target:  enum.Slice(types.FlowStatusSuspended),
+		Refresh: statusFlow(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appflow.DescribeFlowOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFlowDeleted(ctx context.Context, conn *appflow.Client, name string) (*types.FlowDefinition, error) {
 	const (
 		timeout = 2 * time.Minute
@@ -2356,6 +2478,42 @@ func expandSAPODataSourceProperties(tfMap map[string]interface{}) *types.SAPODat
 		a.ObjectPath = aws.String(v)
 	}
 
+	if v, ok := tfMap["pagination_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.PaginationConfig = expandSAPODataPaginationConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["parallelism_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.ParallelismConfig = expandSAPODataParallelismConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandSAPODataPaginationConfig(tfMap map[string]interface{}) *types.SAPODataPaginationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.SAPODataPaginationConfig{}
+
+	if v, ok := tfMap["max_page_size"].(int); ok && v != 0 {
+		a.MaxPageSize = aws.Int32(int32(v))
+	}
+
+	return a
+}
+
+func expandSAPODataParallelismConfig(tfMap map[string]interface{}) *types.SAPODataParallelismConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.SAPODataParallelismConfig{}
+
+	if v, ok := tfMap["max_parallelism"].(int); ok && v != 0 {
+		a.MaxParallelism = aws.Int32(int32(v))
+	}
+
 	return a
 }
 
@@ -3493,6 +3651,42 @@ func flattenSAPODataSourceProperties(sapoDataSourceProperties *types.SAPODataSou
 		m["object_path"] = aws.ToString(v)
 	}
 
+	if v := sapoDataSourceProperties.PaginationConfig; v != nil {
+		m["pagination_config"] = []interface{}{flattenSAPODataPaginationConfig(v)}
+	}
+
+	if v := sapoDataSourceProperties.ParallelismConfig; v != nil {
+		m["parallelism_config"] = []interface{}{flattenSAPODataParallelismConfig(v)}
+	}
+
+	return m
+}
+
+func flattenSAPODataPaginationConfig(paginationConfig *types.SAPODataPaginationConfig) map[string]interface{} {
+	if paginationConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := paginationConfig.MaxPageSize; v != nil {
+		m["max_page_size"] = aws.ToInt32(v)
+	}
+
+	return m
+}
+
+func flattenSAPODataParallelismConfig(parallelismConfig *types.SAPODataParallelismConfig) map[string]interface{} {
+	if parallelismConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := parallelismConfig.MaxParallelism; v != nil {
+		m["max_parallelism"] = aws.ToInt32(v)
+	}
+
 	return m
 }
 
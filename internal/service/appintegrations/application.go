@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appintegrations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appintegrations_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/appintegrations;appintegrations.GetApplicationOutput")
+func resourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_url_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+									"approved_origins": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 1000),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._ \-]+$`), "should be not be more than 255 alphanumeric, forward slashes, dots, underscores, spaces, or hyphen characters"),
+				),
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._\-]+$`), "should be not be more than 255 alphanumeric, forward slashes, dots, underscores, or hyphen characters"),
+				),
+			},
+			names.AttrPermissions: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 150,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"publications": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						names.AttrSchema: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 10240),
+						},
+					},
+				},
+			},
+			"subscriptions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &appintegrations.CreateApplicationInput{
+		ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+		ClientToken:             aws.String(id.UniqueId()),
+		Name:                    aws.String(name),
+		Namespace:               aws.String(d.Get(names.AttrNamespace).(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrPermissions); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("publications"); ok && v.(*schema.Set).Len() > 0 {
+		input.Publications = expandPublications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("subscriptions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Subscriptions = expandSubscriptions(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateApplication(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppIntegrations Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	output, err := findApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("application_source_config", flattenApplicationSourceConfig(output.ApplicationSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_source_config: %s", err)
+	}
+	d.Set(names.AttrARN, output.Arn)
+	if v := output.CreatedTime; v != nil {
+		d.Set(names.AttrCreatedTime, aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, output.Description)
+	if v := output.LastModifiedTime; v != nil {
+		d.Set("last_modified_time", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrNamespace, output.Namespace)
+	d.Set(names.AttrPermissions, output.Permissions)
+	if err := d.Set("publications", flattenPublications(output.Publications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting publications: %s", err)
+	}
+	if err := d.Set("subscriptions", flattenSubscriptions(output.Subscriptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting subscriptions: %s", err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// Always send the complete (possibly empty) lists so that removed entries are cleared.
+		input := &appintegrations.UpdateApplicationInput{
+			ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+			Arn:                     aws.String(d.Id()),
+			Name:                    aws.String(d.Get(names.AttrName).(string)),
+			Permissions:             flex.ExpandStringValueEmptySet(d.Get(names.AttrPermissions).(*schema.Set)),
+			Publications:            expandPublications(d.Get("publications").(*schema.Set).List()),
+			Subscriptions:           expandSubscriptions(d.Get("subscriptions").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateApplication(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppIntegrations Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Application: %s", d.Id())
+	_, err := conn.DeleteApplication(ctx, &appintegrations.DeleteApplicationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findApplicationByARN(ctx context.Context, conn *appintegrations.Client, arn string) (*appintegrations.GetApplicationOutput, error) {
+	input := &appintegrations.GetApplicationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandApplicationSourceConfig(tfList []interface{}) *awstypes.ApplicationSourceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ApplicationSourceConfig{}
+
+	if v, ok := tfMap["external_url_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		externalURLConfig := &awstypes.ExternalUrlConfig{
+			AccessUrl: aws.String(tfMap["access_url"].(string)),
+		}
+
+		if v, ok := tfMap["approved_origins"].(*schema.Set); ok && v.Len() > 0 {
+			externalURLConfig.ApprovedOrigins = flex.ExpandStringValueSet(v)
+		}
+
+		apiObject.ExternalUrlConfig = externalURLConfig
+	}
+
+	return apiObject
+}
+
+func expandPublications(tfList []interface{}) []awstypes.Publication {
+	apiObjects := make([]awstypes.Publication, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.Publication{
+			Event:  aws.String(tfMap["event"].(string)),
+			Schema: aws.String(tfMap[names.AttrSchema].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSubscriptions(tfList []interface{}) []awstypes.Subscription {
+	apiObjects := make([]awstypes.Subscription, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.Subscription{
+			Event: aws.String(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenApplicationSourceConfig(apiObject *awstypes.ApplicationSourceConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ExternalUrlConfig; v != nil {
+		tfMap["external_url_config"] = []interface{}{map[string]interface{}{
+			"access_url":       aws.ToString(v.AccessUrl),
+			"approved_origins": v.ApprovedOrigins,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPublications(apiObjects []awstypes.Publication) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"event":               aws.ToString(apiObject.Event),
+			names.AttrSchema:      aws.ToString(apiObject.Schema),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSubscriptions(apiObjects []awstypes.Subscription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"event":               aws.ToString(apiObject.Event),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappintegrations "github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppIntegrationsApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application appintegrations.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "application_source_config.0.external_url_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "application_source_config.0.external_url_config.0.access_url", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "application_source_config.0.external_url_config.0.approved_origins.#", acctest.Ct0),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "app-integrations", regexache.MustCompile(`application/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamespace, rName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "publications.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "subscriptions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application appintegrations.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappintegrations.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsApplication_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application appintegrations.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_full(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_source_config.0.external_url_config.0.approved_origins.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "application_source_config.0.external_url_config.0.approved_origins.*", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "User.Details.View"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "Contact.Details.View"),
+					resource.TestCheckResourceAttr(resourceName, "publications.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "publications.*", map[string]string{
+						"event":               "first.published",
+						names.AttrDescription: "first",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subscriptions.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriptions.*", map[string]string{
+						"event":               "first.subscribed",
+						names.AttrDescription: "first",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_full(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+					resource.TestCheckResourceAttr(resourceName, "publications.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "publications.*", map[string]string{
+						"event": "second.published",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subscriptions.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriptions.*", map[string]string{
+						"event": "second.subscribed",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsApplication_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application appintegrations.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appintegrations_application" {
+				continue
+			}
+
+			_, err := tfappintegrations.FindApplicationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppIntegrations Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *appintegrations.GetApplicationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+		output, err := tfappintegrations.FindApplicationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccApplicationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_application" "test" {
+  name      = %[1]q
+  namespace = %[1]q
+
+  application_source_config {
+    external_url_config {
+      access_url = "https://example.com"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccApplicationConfig_full(rName, suffix string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_application" "test" {
+  name        = %[1]q
+  namespace   = %[1]q
+  description = %[2]q
+  permissions = ["User.Details.View", "Contact.Details.View"]
+
+  application_source_config {
+    external_url_config {
+      access_url       = "https://example.com"
+      approved_origins = ["https://example.com"]
+    }
+  }
+
+  publications {
+    event       = "%[2]s.published"
+    description = %[2]q
+    schema = jsonencode({
+      type = "object"
+      properties = {
+        id = {
+          type = "string"
+        }
+      }
+    })
+  }
+
+  subscriptions {
+    event       = "%[2]s.subscribed"
+    description = %[2]q
+  }
+}
+`, rName, suffix)
+}
+
+func testAccApplicationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_application" "test" {
+  name      = %[1]q
+  namespace = %[1]q
+
+  application_source_config {
+    external_url_config {
+      access_url = "https://example.com"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccApplicationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_application" "test" {
+  name      = %[1]q
+  namespace = %[1]q
+
+  application_source_config {
+    external_url_config {
+      access_url = "https://example.com"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
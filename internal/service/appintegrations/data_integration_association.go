@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appintegrations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	dataIntegrationAssociationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_appintegrations_data_integration_association", name="Data Integration Association")
+func resourceDataIntegrationAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataIntegrationAssociationCreate,
+		ReadWithoutTimeout:   resourceDataIntegrationAssociationRead,
+		UpdateWithoutTimeout: resourceDataIntegrationAssociationUpdate,
+		DeleteWithoutTimeout: resourceDataIntegrationAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_association_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"data_integration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_integration_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"destination_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"execution_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ExecutionMode](),
+						},
+						"on_demand_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrStartTime: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"schedule_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"first_execution_from": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"object": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrScheduleExpression: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+		},
+	}
+}
+
+func resourceDataIntegrationAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	dataIntegrationID := d.Get("data_integration_identifier").(string)
+	input := &appintegrations.CreateDataIntegrationAssociationInput{
+		ClientToken:               aws.String(id.UniqueId()),
+		DataIntegrationIdentifier: aws.String(dataIntegrationID),
+	}
+
+	if v, ok := d.GetOk("client_association_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ClientAssociationMetadata = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		input.ClientId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_uri"); ok {
+		input.DestinationURI = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExecutionConfiguration = expandExecutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("object_configuration"); ok {
+		var objectConfiguration map[string]map[string][]string
+
+		if err := tfjson.DecodeFromString(v.(string), &objectConfiguration); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.ObjectConfiguration = objectConfiguration
+	}
+
+	output, err := conn.CreateDataIntegrationAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppIntegrations Data Integration Association (%s): %s", dataIntegrationID, err)
+	}
+
+	resourceID := errs.Must(flex.FlattenResourceId([]string{dataIntegrationID, aws.ToString(output.DataIntegrationAssociationId)}, dataIntegrationAssociationResourceIDPartCount, false))
+	d.SetId(resourceID)
+
+	return append(diags, resourceDataIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDataIntegrationAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataIntegrationAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	dataIntegrationID, associationID := parts[0], parts[1]
+	output, err := findDataIntegrationAssociationByTwoPartKey(ctx, conn, dataIntegrationID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Data Integration Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Data Integration Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.DataIntegrationAssociationArn)
+	d.Set("association_id", associationID)
+	d.Set("client_id", output.ClientId)
+	d.Set("data_integration_arn", output.DataIntegrationArn)
+	d.Set("data_integration_identifier", dataIntegrationID)
+	d.Set("destination_uri", output.DestinationURI)
+	if err := d.Set("execution_configuration", flattenExecutionConfiguration(output.ExecutionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting execution_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataIntegrationAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	if d.HasChange("execution_configuration") {
+		parts, err := flex.ExpandResourceId(d.Id(), dataIntegrationAssociationResourceIDPartCount, false)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &appintegrations.UpdateDataIntegrationAssociationInput{
+			DataIntegrationAssociationIdentifier: aws.String(parts[1]),
+			DataIntegrationIdentifier:            aws.String(parts[0]),
+		}
+
+		if v, ok := d.GetOk("execution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ExecutionConfiguration = expandExecutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err = conn.UpdateDataIntegrationAssociation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppIntegrations Data Integration Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDataIntegrationAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The AppIntegrations API has no operation to delete a data integration association.
+	// Associations are removed when the owning data integration is deleted.
+	log.Printf("[WARN] AppIntegrations Data Integration Association (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findDataIntegrationAssociationByTwoPartKey(ctx context.Context, conn *appintegrations.Client, dataIntegrationID, associationID string) (*awstypes.DataIntegrationAssociationSummary, error) {
+	input := &appintegrations.ListDataIntegrationAssociationsInput{
+		DataIntegrationIdentifier: aws.String(dataIntegrationID),
+	}
+
+	return findDataIntegrationAssociation(ctx, conn, input, func(v *awstypes.DataIntegrationAssociationSummary) bool {
+		return strings.HasSuffix(aws.ToString(v.DataIntegrationAssociationArn), "/"+associationID)
+	})
+}
+
+func findDataIntegrationAssociation(ctx context.Context, conn *appintegrations.Client, input *appintegrations.ListDataIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.DataIntegrationAssociationSummary]) (*awstypes.DataIntegrationAssociationSummary, error) {
+	output, err := findDataIntegrationAssociations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findDataIntegrationAssociations(ctx context.Context, conn *appintegrations.Client, input *appintegrations.ListDataIntegrationAssociationsInput, filter tfslices.Predicate[*awstypes.DataIntegrationAssociationSummary]) ([]awstypes.DataIntegrationAssociationSummary, error) {
+	var output []awstypes.DataIntegrationAssociationSummary
+
+	pages := appintegrations.NewListDataIntegrationAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DataIntegrationAssociations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func expandExecutionConfiguration(tfMap map[string]interface{}) *awstypes.ExecutionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ExecutionConfiguration{}
+
+	if v, ok := tfMap["execution_mode"].(string); ok && v != "" {
+		apiObject.ExecutionMode = awstypes.ExecutionMode(v)
+	}
+
+	if v, ok := tfMap["on_demand_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		onDemandConfiguration := &awstypes.OnDemandConfiguration{
+			StartTime: aws.String(tfMap[names.AttrStartTime].(string)),
+		}
+
+		if v, ok := tfMap["end_time"].(string); ok && v != "" {
+			onDemandConfiguration.EndTime = aws.String(v)
+		}
+
+		apiObject.OnDemandConfiguration = onDemandConfiguration
+	}
+
+	if v, ok := tfMap["schedule_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		scheduleConfiguration := &awstypes.ScheduleConfiguration{
+			ScheduleExpression: aws.String(tfMap[names.AttrScheduleExpression].(string)),
+		}
+
+		if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+			scheduleConfiguration.FirstExecutionFrom = aws.String(v)
+		}
+
+		if v, ok := tfMap["object"].(string); ok && v != "" {
+			scheduleConfiguration.Object = aws.String(v)
+		}
+
+		apiObject.ScheduleConfiguration = scheduleConfiguration
+	}
+
+	return apiObject
+}
+
+func flattenExecutionConfiguration(apiObject *awstypes.ExecutionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"execution_mode": apiObject.ExecutionMode,
+	}
+
+	if v := apiObject.OnDemandConfiguration; v != nil {
+		tfMap["on_demand_configuration"] = []interface{}{map[string]interface{}{
+			"end_time":          aws.ToString(v.EndTime),
+			names.AttrStartTime: aws.ToString(v.StartTime),
+		}}
+	}
+
+	if v := apiObject.ScheduleConfiguration; v != nil {
+		tfMap["schedule_configuration"] = []interface{}{map[string]interface{}{
+			"first_execution_from":       aws.ToString(v.FirstExecutionFrom),
+			"object":                     aws.ToString(v.Object),
+			names.AttrScheduleExpression: aws.ToString(v.ScheduleExpression),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappintegrations "github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppIntegrationsDataIntegrationAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_data_integration_association.test"
+	dataIntegrationResourceName := "aws_appintegrations_data_integration.test"
+
+	sourceURI := os.Getenv("DATA_INTEGRATION_SOURCE_URI")
+	if sourceURI == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIntegrationAssociationConfig_basic(rName, sourceURI, "rate(1 hour)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationAssociationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "app-integrations", regexache.MustCompile(`data-integration-association/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_integration_arn", dataIntegrationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "data_integration_identifier", dataIntegrationResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.0.execution_mode", "SCHEDULED"),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.0.schedule_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.0.schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_association_metadata", "object_configuration"},
+			},
+			{
+				Config: testAccDataIntegrationAssociationConfig_basic(rName, sourceURI, "rate(3 hours)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.0.schedule_configuration.0.schedule_expression", "rate(3 hours)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataIntegrationAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appintegrations_data_integration_association" {
+				continue
+			}
+
+			_, err := tfappintegrations.FindDataIntegrationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["data_integration_identifier"], rs.Primary.Attributes["association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppIntegrations Data Integration Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataIntegrationAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+		_, err := tfappintegrations.FindDataIntegrationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["data_integration_identifier"], rs.Primary.Attributes["association_id"])
+
+		return err
+	}
+}
+
+func testAccDataIntegrationAssociationConfig_basic(rName, sourceURI, scheduleExpression string) string {
+	return acctest.ConfigCompose(
+		testAccDataIntegrationBaseConfig(),
+		fmt.Sprintf(`
+resource "aws_appintegrations_data_integration" "test" {
+  name       = %[1]q
+  kms_key    = aws_kms_key.test.arn
+  source_uri = %[2]q
+
+  schedule_config {
+    first_execution_from = "1439788442681"
+    object               = "Account"
+    schedule_expression  = "rate(1 hour)"
+  }
+}
+
+resource "aws_appintegrations_data_integration_association" "test" {
+  data_integration_identifier = aws_appintegrations_data_integration.test.id
+
+  execution_configuration {
+    execution_mode = "SCHEDULED"
+
+    schedule_configuration {
+      first_execution_from = "1439788442681"
+      object               = "Account"
+      schedule_expression  = %[3]q
+    }
+  }
+}
+`, rName, sourceURI, scheduleExpression))
+}
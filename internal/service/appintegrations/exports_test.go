@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                = resourceApplication
+	ResourceDataIntegrationAssociation = resourceDataIntegrationAssociation
+
+	FindApplicationByARN                       = findApplicationByARN
+	FindDataIntegrationAssociationByTwoPartKey = findDataIntegrationAssociationByTwoPartKey
+)
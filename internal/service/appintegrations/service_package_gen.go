@@ -35,6 +35,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceApplication,
+			TypeName: "aws_appintegrations_application",
+			Name:     "Application",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceDataIntegration,
 			TypeName: "aws_appintegrations_data_integration",
@@ -43,6 +51,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceDataIntegrationAssociation,
+			TypeName: "aws_appintegrations_data_integration_association",
+			Name:     "Data Integration Association",
+		},
 		{
 			Factory:  ResourceEventIntegration,
 			TypeName: "aws_appintegrations_event_integration",
@@ -88,6 +88,56 @@ func resourceWorkGroup() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"identity_center_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_identity_center": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"identity_center_instance_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"managed_query_results_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									names.AttrEncryptionConfiguration: {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKMSKey: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -269,6 +319,10 @@ func resourceWorkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		if d.HasChange(names.AttrConfiguration) {
 			input.ConfigurationUpdates = expandWorkGroupConfigurationUpdates(d.Get(names.AttrConfiguration).([]interface{}))
+
+			if input.ConfigurationUpdates != nil && d.HasChange("configuration.0.managed_query_results_configuration") {
+				input.ConfigurationUpdates.ManagedQueryResultsConfigurationUpdates = expandWorkGroupManagedQueryResultsConfigurationUpdates(d.Get("configuration.0.managed_query_results_configuration").([]interface{}))
+			}
 		}
 
 		if d.HasChange(names.AttrDescription) {
@@ -360,6 +414,14 @@ func expandWorkGroupConfiguration(l []interface{}) *types.WorkGroupConfiguration
 		configuration.ExecutionRole = aws.String(v)
 	}
 
+	if v, ok := m["identity_center_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.IdentityCenterConfiguration = expandWorkGroupIdentityCenterConfiguration(v)
+	}
+
+	if v, ok := m["managed_query_results_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.ManagedQueryResultsConfiguration = expandWorkGroupManagedQueryResultsConfiguration(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"].(bool); ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v)
 	}
@@ -391,6 +453,84 @@ func expandWorkGroupEngineVersion(l []interface{}) *types.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupIdentityCenterConfiguration(l []interface{}) *types.IdentityCenterConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	identityCenterConfiguration := &types.IdentityCenterConfiguration{}
+
+	if v, ok := m["enable_identity_center"].(bool); ok {
+		identityCenterConfiguration.EnableIdentityCenter = aws.Bool(v)
+	}
+
+	if v, ok := m["identity_center_instance_arn"].(string); ok && v != "" {
+		identityCenterConfiguration.IdentityCenterInstanceArn = aws.String(v)
+	}
+
+	return identityCenterConfiguration
+}
+
+func expandWorkGroupManagedQueryResultsConfiguration(l []interface{}) *types.ManagedQueryResultsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	managedQueryResultsConfiguration := &types.ManagedQueryResultsConfiguration{}
+
+	if v, ok := m[names.AttrEnabled].(bool); ok {
+		managedQueryResultsConfiguration.Enabled = v
+	}
+
+	if v, ok := m[names.AttrEncryptionConfiguration].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		managedQueryResultsConfiguration.EncryptionConfiguration = expandWorkGroupManagedQueryResultsEncryptionConfiguration(v)
+	}
+
+	return managedQueryResultsConfiguration
+}
+
+func expandWorkGroupManagedQueryResultsConfigurationUpdates(l []interface{}) *types.ManagedQueryResultsConfigurationUpdates {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	managedQueryResultsConfigurationUpdates := &types.ManagedQueryResultsConfigurationUpdates{}
+
+	if v, ok := m[names.AttrEnabled].(bool); ok {
+		managedQueryResultsConfigurationUpdates.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := m[names.AttrEncryptionConfiguration].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		managedQueryResultsConfigurationUpdates.EncryptionConfiguration = expandWorkGroupManagedQueryResultsEncryptionConfiguration(v)
+	} else {
+		managedQueryResultsConfigurationUpdates.RemoveEncryptionConfiguration = aws.Bool(true)
+	}
+
+	return managedQueryResultsConfigurationUpdates
+}
+
+func expandWorkGroupManagedQueryResultsEncryptionConfiguration(l []interface{}) *types.ManagedQueryResultsEncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryptionConfiguration := &types.ManagedQueryResultsEncryptionConfiguration{}
+
+	if v, ok := m[names.AttrKMSKey].(string); ok && v != "" {
+		encryptionConfiguration.KmsKey = aws.String(v)
+	}
+
+	return encryptionConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []interface{}) *types.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -523,13 +663,15 @@ func flattenWorkGroupConfiguration(configuration *types.WorkGroupConfiguration)
 	}
 
 	m := map[string]interface{}{
-		"bytes_scanned_cutoff_per_query":     aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.ToBool(configuration.EnforceWorkGroupConfiguration),
-		names.AttrEngineVersion:              flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"execution_role":                     aws.ToString(configuration.ExecutionRole),
-		"publish_cloudwatch_metrics_enabled": aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.ToBool(configuration.RequesterPaysEnabled),
+		"bytes_scanned_cutoff_per_query":      aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
+		"enforce_workgroup_configuration":     aws.ToBool(configuration.EnforceWorkGroupConfiguration),
+		names.AttrEngineVersion:               flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"execution_role":                      aws.ToString(configuration.ExecutionRole),
+		"identity_center_configuration":       flattenWorkGroupIdentityCenterConfiguration(configuration.IdentityCenterConfiguration),
+		"managed_query_results_configuration": flattenWorkGroupManagedQueryResultsConfiguration(configuration.ManagedQueryResultsConfiguration),
+		"publish_cloudwatch_metrics_enabled":  aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
+		"result_configuration":                flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":              aws.ToBool(configuration.RequesterPaysEnabled),
 	}
 
 	return []interface{}{m}
@@ -548,6 +690,37 @@ func flattenWorkGroupEngineVersion(engineVersion *types.EngineVersion) []interfa
 	return []interface{}{m}
 }
 
+func flattenWorkGroupIdentityCenterConfiguration(identityCenterConfiguration *types.IdentityCenterConfiguration) []interface{} {
+	if identityCenterConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_identity_center":       aws.ToBool(identityCenterConfiguration.EnableIdentityCenter),
+		"identity_center_instance_arn": aws.ToString(identityCenterConfiguration.IdentityCenterInstanceArn),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWorkGroupManagedQueryResultsConfiguration(managedQueryResultsConfiguration *types.ManagedQueryResultsConfiguration) []interface{} {
+	if managedQueryResultsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrEnabled: managedQueryResultsConfiguration.Enabled,
+	}
+
+	if v := managedQueryResultsConfiguration.EncryptionConfiguration; v != nil {
+		m[names.AttrEncryptionConfiguration] = []interface{}{map[string]interface{}{
+			names.AttrKMSKey: aws.ToString(v.KmsKey),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWorkGroupResultConfiguration(resultConfiguration *types.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
@@ -12,9 +12,11 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -90,7 +92,7 @@ func ResourcePlan() *schema.Resource {
 									"destination_vault_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: validVaultARN,
 									},
 									"lifecycle": {
 										Type:     schema.TypeList,
@@ -122,6 +124,27 @@ func ResourcePlan() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"index_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"EBS",
+												"S3",
+											}, false),
+										},
+									},
+								},
+							},
+						},
 						"lifecycle": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -157,6 +180,11 @@ func ResourcePlan() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"schedule_expression_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Etc/UTC",
+						},
 						"start_window": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -182,10 +210,46 @@ func ResourcePlan() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			planCopyActionsDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// planCopyActionsDiff verifies that copy action destination vaults, which may be in other
+// accounts or Regions, are in the same partition as the plan. Copies can't cross partitions.
+func planCopyActionsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	partition := meta.(*conns.AWSClient).Partition
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, v := range tfMap["copy_action"].(*schema.Set).List() {
+			destinationVaultARN := v.(map[string]interface{})["destination_vault_arn"].(string)
+
+			// Unknown values are planned as empty strings.
+			if destinationVaultARN == "" {
+				continue
+			}
+
+			if parsedARN, err := arn.Parse(destinationVaultARN); err == nil && parsedARN.Partition != partition {
+				return fmt.Errorf("rule %q: copy action destination vault (%s) is not in the %s partition", tfMap["rule_name"], destinationVaultARN, partition)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourcePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupClient(ctx)
@@ -335,6 +399,9 @@ func expandPlanRules(ctx context.Context, vRules *schema.Set) []awstypes.BackupR
 		if vSchedule, ok := mRule[names.AttrSchedule].(string); ok && vSchedule != "" {
 			rule.ScheduleExpression = aws.String(vSchedule)
 		}
+		if v, ok := mRule["schedule_expression_timezone"].(string); ok && v != "" {
+			rule.ScheduleExpressionTimezone = aws.String(v)
+		}
 		if vEnableContinuousBackup, ok := mRule["enable_continuous_backup"].(bool); ok {
 			rule.EnableContinuousBackup = aws.Bool(vEnableContinuousBackup)
 		}
@@ -353,6 +420,10 @@ func expandPlanRules(ctx context.Context, vRules *schema.Set) []awstypes.BackupR
 			rule.Lifecycle = expandPlanLifecycle(v[0].(map[string]interface{}))
 		}
 
+		if v, ok := mRule["index_action"].([]interface{}); ok && len(v) > 0 {
+			rule.IndexActions = expandPlanIndexActions(v)
+		}
+
 		if vCopyActions := expandPlanCopyActions(mRule["copy_action"].(*schema.Set).List()); len(vCopyActions) > 0 {
 			rule.CopyActions = vCopyActions
 		}
@@ -409,6 +480,27 @@ func expandPlanCopyActions(actionList []interface{}) []awstypes.CopyAction {
 	return actions
 }
 
+func expandPlanIndexActions(tfList []interface{}) []awstypes.IndexAction {
+	var apiObjects []awstypes.IndexAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.IndexAction{}
+
+		if v, ok := tfMap["resource_types"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandPlanLifecycle(tfMap map[string]interface{}) *awstypes.Lifecycle {
 	if tfMap == nil {
 		return nil
@@ -436,13 +528,14 @@ func flattenPlanRules(ctx context.Context, rules []awstypes.BackupRule) *schema.
 
 	for _, rule := range rules {
 		mRule := map[string]interface{}{
-			"rule_name":                aws.ToString(rule.RuleName),
-			"target_vault_name":        aws.ToString(rule.TargetBackupVaultName),
-			names.AttrSchedule:         aws.ToString(rule.ScheduleExpression),
-			"enable_continuous_backup": aws.ToBool(rule.EnableContinuousBackup),
-			"start_window":             int(aws.ToInt64(rule.StartWindowMinutes)),
-			"completion_window":        int(aws.ToInt64(rule.CompletionWindowMinutes)),
-			"recovery_point_tags":      KeyValueTags(ctx, rule.RecoveryPointTags).IgnoreAWS().Map(),
+			"rule_name":                    aws.ToString(rule.RuleName),
+			"target_vault_name":            aws.ToString(rule.TargetBackupVaultName),
+			names.AttrSchedule:             aws.ToString(rule.ScheduleExpression),
+			"schedule_expression_timezone": aws.ToString(rule.ScheduleExpressionTimezone),
+			"enable_continuous_backup":     aws.ToBool(rule.EnableContinuousBackup),
+			"start_window":                 int(aws.ToInt64(rule.StartWindowMinutes)),
+			"completion_window":            int(aws.ToInt64(rule.CompletionWindowMinutes)),
+			"recovery_point_tags":          KeyValueTags(ctx, rule.RecoveryPointTags).IgnoreAWS().Map(),
 		}
 
 		if lifecycle := rule.Lifecycle; lifecycle != nil {
@@ -450,6 +543,7 @@ func flattenPlanRules(ctx context.Context, rules []awstypes.BackupRule) *schema.
 		}
 
 		mRule["copy_action"] = flattenPlanCopyActions(rule.CopyActions)
+		mRule["index_action"] = flattenPlanIndexActions(rule.IndexActions)
 
 		vRules = append(vRules, mRule)
 	}
@@ -494,6 +588,22 @@ func flattenPlanCopyActions(copyActions []awstypes.CopyAction) []interface{} {
 	return tfList
 }
 
+func flattenPlanIndexActions(apiObjects []awstypes.IndexAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"resource_types": flex.FlattenStringValueList(apiObject.ResourceTypes),
+		})
+	}
+
+	return tfList
+}
+
 func flattenPlanCopyActionLifecycle(copyActionLifecycle *awstypes.Lifecycle) []interface{} {
 	if copyActionLifecycle == nil {
 		return []interface{}{}
@@ -522,6 +632,9 @@ func planHash(vRule interface{}) int {
 	if v, ok := mRule[names.AttrSchedule].(string); ok {
 		buf.WriteString(fmt.Sprintf("%s-", v))
 	}
+	if v, ok := mRule["schedule_expression_timezone"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
 	if v, ok := mRule["enable_continuous_backup"].(bool); ok {
 		buf.WriteString(fmt.Sprintf("%t-", v))
 	}
@@ -532,6 +645,20 @@ func planHash(vRule interface{}) int {
 		buf.WriteString(fmt.Sprintf("%d-", v))
 	}
 
+	if vIndexActions, ok := mRule["index_action"].([]interface{}); ok {
+		for _, a := range vIndexActions {
+			action, ok := a.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if vResourceTypes, ok := action["resource_types"].([]interface{}); ok {
+				for _, v := range vResourceTypes {
+					buf.WriteString(fmt.Sprintf("%s-", v))
+				}
+			}
+		}
+	}
+
 	if vRecoveryPointTags, ok := mRule["recovery_point_tags"].(map[string]interface{}); ok && len(vRecoveryPointTags) > 0 {
 		buf.WriteString(fmt.Sprintf("%d-", tftags.New(context.Background(), vRecoveryPointTags).Hash()))
 	}
@@ -545,6 +672,9 @@ func planHash(vRule interface{}) int {
 		if v, ok := mLifecycle["cold_storage_after"].(int); ok {
 			buf.WriteString(fmt.Sprintf("%d-", v))
 		}
+		if v, ok := mLifecycle["opt_in_to_archive_for_supported_resources"].(bool); ok {
+			buf.WriteString(fmt.Sprintf("%t-", v))
+		}
 	}
 
 	if vCopyActions, ok := mRule["copy_action"].(*schema.Set); ok && vCopyActions.Len() > 0 {
@@ -559,6 +689,9 @@ func planHash(vRule interface{}) int {
 					if v, ok := lifecycle["cold_storage_after"].(int); ok {
 						buf.WriteString(fmt.Sprintf("%d-", v))
 					}
+					if v, ok := lifecycle["opt_in_to_archive_for_supported_resources"].(bool); ok {
+						buf.WriteString(fmt.Sprintf("%t-", v))
+					}
 				}
 			}
 
@@ -79,6 +79,19 @@ func DataSourcePlan() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"index_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
 						"lifecycle": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -108,6 +121,10 @@ func DataSourcePlan() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"schedule_expression_timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"start_window": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -651,6 +651,119 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_scheduleExpressionTimezone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plan backup.GetBackupPlanOutput
+	resourceName := "aws_backup_plan.test"
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_scheduleExpressionTimezone(rName, "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":                    rName,
+						names.AttrSchedule:             "cron(0 12 * * ? *)",
+						"schedule_expression_timezone": "America/New_York",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_scheduleExpressionTimezone(rName, "Etc/UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"schedule_expression_timezone": "Etc/UTC",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupPlan_indexAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plan backup.GetBackupPlanOutput
+	resourceName := "aws_backup_plan.test"
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_indexAction(rName, "EBS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":                       rName,
+						"index_action.#":                  acctest.Ct1,
+						"index_action.0.resource_types.#": acctest.Ct1,
+						"index_action.0.resource_types.0": "EBS",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_indexAction(rName, "S3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"index_action.#":                  acctest.Ct1,
+						"index_action.0.resource_types.0": "S3",
+					}),
+				),
+			},
+			{
+				Config: testAccPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"index_action.#": acctest.Ct0,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupPlan_RuleCopyAction_crossPartition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanConfig_ruleCopyActionCrossPartition(rName),
+				ExpectError: regexache.MustCompile(`copy action destination vault .* is not in the`),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var plan backup.GetBackupPlanOutput
@@ -1208,3 +1321,73 @@ resource "aws_backup_plan" "test" {
 }
 `, rName)
 }
+
+func testAccPlanConfig_scheduleExpressionTimezone(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name                    = %[1]q
+    target_vault_name            = aws_backup_vault.test.name
+    schedule                     = "cron(0 12 * * ? *)"
+    schedule_expression_timezone = %[2]q
+  }
+}
+`, rName, timezone)
+}
+
+func testAccPlanConfig_indexAction(rName, resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name         = %[1]q
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = "cron(0 12 * * ? *)"
+
+    index_action {
+      resource_types = [%[2]q]
+    }
+  }
+}
+`, rName, resourceType)
+}
+
+func testAccPlanConfig_ruleCopyActionCrossPartition(rName string) string {
+	const (
+		commercialVaultARN = "arn:aws:backup:us-east-1:123456789012:backup-vault:Default"            // lintignore:AWSAT003,AWSAT005
+		govCloudVaultARN   = "arn:aws-us-gov:backup:us-gov-west-1:123456789012:backup-vault:Default" // lintignore:AWSAT003,AWSAT005
+	)
+
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name         = %[1]q
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = "cron(0 12 * * ? *)"
+
+    copy_action {
+      destination_vault_arn = data.aws_partition.current.partition == "aws" ? %[2]q : %[3]q
+    }
+  }
+}
+`, rName, govCloudVaultARN, commercialVaultARN)
+}
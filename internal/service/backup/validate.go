@@ -5,8 +5,10 @@ package backup
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 )
 
 func validReportPlanName(v interface{}, k string) (ws []string, errors []error) {
@@ -25,3 +27,18 @@ func validFrameworkName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// validVaultARN validates a backup vault ARN, e.g. arn:aws:backup:us-east-1:123456789012:backup-vault:Default.
+func validVaultARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	parsedARN, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "backup" || !strings.HasPrefix(parsedARN.Resource, "backup-vault:") || parsedARN.Region == "" || parsedARN.AccountID == "" {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a Backup vault ARN", k, value))
+	}
+	return
+}
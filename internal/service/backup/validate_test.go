@@ -59,3 +59,32 @@ func TestValidFrameworkName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidVaultARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:backup:us-east-1:123456789012:backup-vault:Default",             // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:backup:us-gov-west-1:123456789012:backup-vault:my-vault", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validVaultARN(v, "destination_vault_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Backup vault ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"Default",
+		"arn:aws:s3:::my-bucket", // lintignore:AWSAT005
+		"arn:aws:backup:us-east-1:123456789012:backup-plan:abcd", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:backup::123456789012:backup-vault:Default",      // lintignore:AWSAT005
+		"arn:aws:backup:us-east-1::backup-vault:Default",         // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validVaultARN(v, "destination_vault_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Backup vault ARN", v)
+		}
+	}
+}
@@ -1661,7 +1661,7 @@ func TestAccBatchComputeEnvironment_createUnmanagedWithComputeResources(t *testi
 
 func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 	ctx := acctest.Context(t)
-	var ce awstypes.ComputeEnvironmentDetail
+	var ce1, ce2 awstypes.ComputeEnvironmentDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_batch_compute_environment.test"
 	instanceProfileResourceName := "aws_iam_instance_profile.ecs_instance"
@@ -1687,7 +1687,7 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 			{
 				Config: testAccComputeenvironmentConfig_ec2PreUpdate(rName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
+					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce1),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", fmt.Sprintf("compute-environment/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name_prefix", ""),
@@ -1723,7 +1723,8 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 			{
 				Config: testAccComputeenvironmentConfig_ec2Update(rName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
+					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce2),
+					testAccCheckComputeEnvironmentNotRecreated(&ce1, &ce2),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", fmt.Sprintf("compute-environment/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name_prefix", ""),
@@ -1763,7 +1764,8 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 			{
 				Config: testAccComputeenvironmentConfig_ec2PreUpdate(rName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
+					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce1),
+					testAccCheckComputeEnvironmentNotRecreated(&ce2, &ce1),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", fmt.Sprintf("compute-environment/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_name_prefix", ""),
@@ -1872,6 +1874,17 @@ func testAccCheckComputeEnvironmentExists(ctx context.Context, n string, v *awst
 	}
 }
 
+func testAccCheckComputeEnvironmentNotRecreated(before, after *awstypes.ComputeEnvironmentDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// The underlying ECS cluster is replaced along with the compute environment.
+		if before, after := aws.ToString(before.EcsClusterArn), aws.ToString(after.EcsClusterArn); before != after {
+			return fmt.Errorf("Batch Compute Environment was recreated: ECS cluster %s != %s", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
 
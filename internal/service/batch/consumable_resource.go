@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_batch_consumable_resource", name="Consumable Resource")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/batch;batch.DescribeConsumableResourceOutput")
+func newConsumableResourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := consumableResourceResource{}
+
+	return &r, nil
+}
+
+type consumableResourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*consumableResourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_batch_consumable_resource"
+}
+
+func (r *consumableResourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"available_quantity": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"in_use_quantity": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z]{1}[0-9A-Za-z_-]{0,255}$`),
+						"must be up to 256 letters (uppercase and lowercase), numbers, underscores and dashes, and must start with an alphanumeric"),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(consumableResourceType_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"total_quantity": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *consumableResourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data consumableResourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BatchClient(ctx)
+
+	name := data.ConsumableResourceName.ValueString()
+	input := &batch.CreateConsumableResourceInput{
+		ConsumableResourceName: aws.String(name),
+		ResourceType:           fwflex.StringFromFramework(ctx, data.ResourceType),
+		Tags:                   getTagsIn(ctx),
+		TotalQuantity:          fwflex.Int64FromFramework(ctx, data.TotalQuantity),
+	}
+
+	output, err := conn.CreateConsumableResource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Batch Consumable Resource (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ConsumableResourceARN = fwflex.StringToFramework(ctx, output.ConsumableResourceArn)
+	data.setID()
+
+	consumableResource, err := findConsumableResourceByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Batch Consumable Resource (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AvailableQuantity = fwflex.Int64ToFramework(ctx, consumableResource.AvailableQuantity)
+	data.InUseQuantity = fwflex.Int64ToFramework(ctx, consumableResource.InUseQuantity)
+	data.ResourceType = fwflex.StringToFramework(ctx, consumableResource.ResourceType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *consumableResourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data consumableResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BatchClient(ctx)
+
+	output, err := findConsumableResourceByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Batch Consumable Resource (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AvailableQuantity = fwflex.Int64ToFramework(ctx, output.AvailableQuantity)
+	data.ConsumableResourceARN = fwflex.StringToFramework(ctx, output.ConsumableResourceArn)
+	data.ConsumableResourceName = fwflex.StringToFramework(ctx, output.ConsumableResourceName)
+	data.InUseQuantity = fwflex.Int64ToFramework(ctx, output.InUseQuantity)
+	data.ResourceType = fwflex.StringToFramework(ctx, output.ResourceType)
+	data.TotalQuantity = fwflex.Int64ToFramework(ctx, output.TotalQuantity)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *consumableResourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new consumableResourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BatchClient(ctx)
+
+	if !new.TotalQuantity.Equal(old.TotalQuantity) {
+		input := &batch.UpdateConsumableResourceInput{
+			ConsumableResource: fwflex.StringFromFramework(ctx, new.ID),
+			Operation:          aws.String(consumableResourceUpdateOperationSet),
+			Quantity:           fwflex.Int64FromFramework(ctx, new.TotalQuantity),
+		}
+
+		_, err := conn.UpdateConsumableResource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Batch Consumable Resource (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findConsumableResourceByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Batch Consumable Resource (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.AvailableQuantity = fwflex.Int64ToFramework(ctx, output.AvailableQuantity)
+	new.InUseQuantity = fwflex.Int64ToFramework(ctx, output.InUseQuantity)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *consumableResourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data consumableResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BatchClient(ctx)
+
+	_, err := conn.DeleteConsumableResource(ctx, &batch.DeleteConsumableResourceInput{
+		ConsumableResource: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "does not exist") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Batch Consumable Resource (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *consumableResourceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConsumableResourceByID(ctx context.Context, conn *batch.Client, id string) (*batch.DescribeConsumableResourceOutput, error) {
+	input := &batch.DescribeConsumableResourceInput{
+		ConsumableResource: aws.String(id),
+	}
+
+	output, err := conn.DescribeConsumableResource(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type consumableResourceResourceModel struct {
+	AvailableQuantity      types.Int64  `tfsdk:"available_quantity"`
+	ConsumableResourceARN  types.String `tfsdk:"arn"`
+	ConsumableResourceName types.String `tfsdk:"name"`
+	ID                     types.String `tfsdk:"id"`
+	InUseQuantity          types.Int64  `tfsdk:"in_use_quantity"`
+	ResourceType           types.String `tfsdk:"resource_type"`
+	Tags                   tftags.Map   `tfsdk:"tags"`
+	TagsAll                tftags.Map   `tfsdk:"tags_all"`
+	TotalQuantity          types.Int64  `tfsdk:"total_quantity"`
+}
+
+func (model *consumableResourceResourceModel) InitFromID() error {
+	model.ConsumableResourceARN = model.ID
+
+	return nil
+}
+
+func (model *consumableResourceResourceModel) setID() {
+	model.ID = model.ConsumableResourceARN
+}
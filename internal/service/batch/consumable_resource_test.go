@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBatchConsumableResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", regexache.MustCompile(`consumable-resource/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "available_quantity", "10"),
+					resource.TestCheckResourceAttr(resourceName, "in_use_quantity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "REPLENISHABLE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "total_quantity", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "available_quantity", "20"),
+					resource.TestCheckResourceAttr(resourceName, "total_quantity", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchConsumableResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbatch.ResourceConsumableResource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchConsumableResource_resourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_resourceType(rName, "NON_REPLENISHABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "NON_REPLENISHABLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConsumableResourceExists(ctx context.Context, n string, v *batch.DescribeConsumableResourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
+
+		output, err := tfbatch.FindConsumableResourceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConsumableResourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_batch_consumable_resource" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
+
+			_, err := tfbatch.FindConsumableResourceByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Batch Consumable Resource %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccConsumableResourceConfig_basic(rName string, totalQuantity int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  total_quantity = %[2]d
+}
+`, rName, totalQuantity)
+}
+
+func testAccConsumableResourceConfig_resourceType(rName, resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  resource_type  = %[2]q
+  total_quantity = 10
+}
+`, rName, resourceType)
+}
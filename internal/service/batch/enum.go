@@ -42,3 +42,19 @@ func dnsPolicy_Values() []string {
 		dnsPolicyClusterFirstWithHostNet,
 	}
 }
+
+const (
+	consumableResourceTypeNonReplenishable = "NON_REPLENISHABLE"
+	consumableResourceTypeReplenishable    = "REPLENISHABLE"
+)
+
+func consumableResourceType_Values() []string {
+	return []string{
+		consumableResourceTypeNonReplenishable,
+		consumableResourceTypeReplenishable,
+	}
+}
+
+const (
+	consumableResourceUpdateOperationSet = "SET"
+)
@@ -6,6 +6,7 @@ package batch
 // Exports for use in tests only.
 var (
 	ResourceComputeEnvironment = resourceComputeEnvironment
+	ResourceConsumableResource = newConsumableResourceResource
 	ResourceJobDefinition      = resourceJobDefinition
 	ResourceJobQueue           = newJobQueueResource
 	ResourceSchedulingPolicy   = resourceSchedulingPolicy
@@ -16,6 +17,7 @@ var (
 	ExpandEC2ConfigurationsUpdate           = expandEC2ConfigurationsUpdate
 	ExpandLaunchTemplateSpecificationUpdate = expandLaunchTemplateSpecificationUpdate
 	FindComputeEnvironmentDetailByName      = findComputeEnvironmentDetailByName
+	FindConsumableResourceByID              = findConsumableResourceByID
 	FindJobDefinitionByARN                  = findJobDefinitionByARN
 	FindJobQueueByID                        = findJobQueueByID
 	FindSchedulingPolicyByARN               = findSchedulingPolicyByARN
@@ -5,12 +5,15 @@ package batch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/batch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -90,7 +93,10 @@ func resourceSchedulingPolicy() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSchedulingPolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -163,6 +169,55 @@ func resourceSchedulingPolicyUpdate(ctx context.Context, d *schema.ResourceData,
 	return append(diags, resourceSchedulingPolicyRead(ctx, d, meta)...)
 }
 
+func resourceSchedulingPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("fair_share_policy.0.share_distribution")
+	if !ok {
+		return nil
+	}
+
+	var shareIdentifiers []string
+	for _, tfMapRaw := range v.(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		shareIdentifier, ok := tfMap["share_identifier"].(string)
+		if !ok || shareIdentifier == "" {
+			// Not yet known.
+			continue
+		}
+
+		for _, other := range shareIdentifiers {
+			if shareIdentifiersOverlap(shareIdentifier, other) {
+				return fmt.Errorf("fair_share_policy share_distribution share identifiers %q and %q overlap", other, shareIdentifier)
+			}
+		}
+
+		shareIdentifiers = append(shareIdentifiers, shareIdentifier)
+	}
+
+	return nil
+}
+
+// shareIdentifiersOverlap returns whether two fair share identifiers would match the same job.
+// A trailing asterisk makes the identifier a prefix match.
+func shareIdentifiersOverlap(a, b string) bool {
+	aPrefix, aWildcard := strings.CutSuffix(a, "*")
+	bPrefix, bWildcard := strings.CutSuffix(b, "*")
+
+	switch {
+	case aWildcard && bWildcard:
+		return strings.HasPrefix(aPrefix, bPrefix) || strings.HasPrefix(bPrefix, aPrefix)
+	case aWildcard:
+		return strings.HasPrefix(b, aPrefix)
+	case bWildcard:
+		return strings.HasPrefix(a, bPrefix)
+	default:
+		return a == b
+	}
+}
+
 func resourceSchedulingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BatchClient(ctx)
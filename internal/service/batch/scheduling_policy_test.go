@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -91,6 +92,28 @@ func TestAccBatchSchedulingPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccBatchSchedulingPolicy_overlappingShareIdentifiers(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulingPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchedulingPolicyConfig_shareIdentifiers(rName, "A1*", "A12"),
+				ExpectError: regexache.MustCompile(`share identifiers "[^"]+" and "[^"]+" overlap`),
+			},
+			{
+				Config:      testAccSchedulingPolicyConfig_shareIdentifiers(rName, "A*", "A1*"),
+				ExpectError: regexache.MustCompile(`share identifiers "[^"]+" and "[^"]+" overlap`),
+			},
+		},
+	})
+}
+
 func testAccCheckSchedulingPolicyExists(ctx context.Context, n string, v *awstypes.SchedulingPolicyDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -185,3 +208,26 @@ resource "aws_batch_scheduling_policy" "test" {
 }
 `, rName)
 }
+
+func testAccSchedulingPolicyConfig_shareIdentifiers(rName, shareIdentifier1, shareIdentifier2 string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fair_share_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+
+    share_distribution {
+      share_identifier = %[2]q
+      weight_factor    = 0.1
+    }
+
+    share_distribution {
+      share_identifier = %[3]q
+      weight_factor    = 0.2
+    }
+  }
+}
+`, rName, shareIdentifier1, shareIdentifier2)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Guardrail Version")
+func newGuardrailVersionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &guardrailVersionDataSource{}, nil
+}
+
+type guardrailVersionDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *guardrailVersionDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_guardrail_version"
+}
+
+func (d *guardrailVersionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blocked_input_messaging": schema.StringAttribute{
+				Computed: true,
+			},
+			"blocked_outputs_messaging": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"guardrail_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GuardrailStatus](),
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *guardrailVersionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data guardrailVersionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockClient(ctx)
+
+	guardrailARN, version := data.GuardrailARN.ValueString(), data.Version.ValueString()
+	output, err := findGuardrailByID(ctx, conn, guardrailARN, version)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Guardrail (%s) version (%s)", guardrailARN, version), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(errs.Must(intflex.FlattenResourceId([]string{guardrailARN, version}, guardrailVersionResourceIDPartCount, false)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type guardrailVersionDataSourceModel struct {
+	BlockedInputMessaging   types.String                                 `tfsdk:"blocked_input_messaging"`
+	BlockedOutputsMessaging types.String                                 `tfsdk:"blocked_outputs_messaging"`
+	CreatedAt               timetypes.RFC3339                            `tfsdk:"created_at"`
+	Description             types.String                                 `tfsdk:"description"`
+	GuardrailARN            fwtypes.ARN                                  `tfsdk:"guardrail_arn"`
+	ID                      types.String                                 `tfsdk:"id"`
+	KMSKeyARN               types.String                                 `tfsdk:"kms_key_arn"`
+	Name                    types.String                                 `tfsdk:"name"`
+	Status                  fwtypes.StringEnum[awstypes.GuardrailStatus] `tfsdk:"status"`
+	Version                 types.String                                 `tfsdk:"version"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockGuardrailVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_bedrock_guardrail_version.test"
+	resourceName := "aws_bedrock_guardrail_version.test"
+	guardrailResourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailVersionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "blocked_input_messaging", guardrailResourceName, "blocked_input_messaging"),
+					resource.TestCheckResourceAttrPair(datasourceName, "blocked_outputs_messaging", guardrailResourceName, "blocked_outputs_messaging"),
+					resource.TestCheckResourceAttrSet(datasourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(datasourceName, "guardrail_arn", resourceName, "guardrail_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrName, guardrailResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrVersion, resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailVersionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailVersionConfig_basic(rName, false), `
+data "aws_bedrock_guardrail_version" "test" {
+  guardrail_arn = aws_bedrock_guardrail_version.test.guardrail_arn
+  version       = aws_bedrock_guardrail_version.test.version
+}
+`)
+}
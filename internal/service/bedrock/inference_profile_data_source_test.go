@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockInferenceProfileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_bedrock_inference_profile.test"
+	resourceName := "aws_bedrock_inference_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInferenceProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceProfileDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrCreatedAt, resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(datasourceName, "inference_profile_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "inference_profile_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(datasourceName, "inference_profile_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "models.#", resourceName, "models.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "models.0.model_arn", resourceName, "models.0.model_arn"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrType, "APPLICATION"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInferenceProfileDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInferenceProfileConfig_basic(rName), `
+data "aws_bedrock_inference_profile" "test" {
+  inference_profile_id = aws_bedrock_inference_profile.test.id
+}
+`)
+}
@@ -32,6 +32,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newFoundationModelsDataSource,
 			Name:    "Foundation Models",
 		},
+		{
+			Factory: newGuardrailVersionDataSource,
+			Name:    "Guardrail Version",
+		},
+		{
+			Factory: newInferenceProfileDataSource,
+			Name:    "Inference Profile",
+		},
 	}
 }
 
@@ -44,6 +52,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "job_arn",
 			},
 		},
+		{
+			Factory: newGuardrailVersionResource,
+			Name:    "Guardrail Version",
+		},
+		{
+			Factory: newInferenceProfileResource,
+			Name:    "Inference Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newModelInvocationLoggingConfigurationResource,
 			Name:    "Model Invocation Logging Configuration",
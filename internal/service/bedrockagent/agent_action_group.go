@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,6 +39,8 @@ import (
 func newAgentActionGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &agentActionGroupResource{}
 
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
 	r.SetDefaultDeleteTimeout(120 * time.Minute)
 
 	return r, nil
@@ -45,7 +48,6 @@ func newAgentActionGroupResource(context.Context) (resource.ResourceWithConfigur
 
 type agentActionGroupResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -91,6 +93,11 @@ func (r *agentActionGroupResource) Schema(ctx context.Context, request resource.
 				CustomType: fwtypes.StringEnumType[awstypes.ActionGroupSignature](),
 				Optional:   true,
 			},
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 			"skip_resource_in_use_check": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -98,6 +105,11 @@ func (r *agentActionGroupResource) Schema(ctx context.Context, request resource.
 			},
 		},
 		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 			"action_group_executor": schema.ListNestedBlock{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[actionGroupExecutorModel](ctx),
 				Validators: []validator.List{
@@ -254,6 +266,14 @@ func (r *agentActionGroupResource) Create(ctx context.Context, request resource.
 	data.ActionGroupState = fwtypes.StringEnumValue(output.AgentActionGroup.ActionGroupState)
 	data.setID()
 
+	if data.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
+
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -328,6 +348,14 @@ func (r *agentActionGroupResource) Update(ctx context.Context, request resource.
 
 			return
 		}
+
+		if new.PrepareAgent.ValueBool() {
+			if _, err := prepareAgent(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError("preparing Agent", err.Error())
+
+				return
+			}
+		}
 	}
 
 	output, err := findAgentActionGroupByThreePartKey(ctx, conn, new.ActionGroupID.ValueString(), new.AgentID.ValueString(), new.AgentVersion.ValueString())
@@ -367,6 +395,26 @@ func (r *agentActionGroupResource) Delete(ctx context.Context, request resource.
 
 		return
 	}
+
+	if data.PrepareAgent.ValueBool() {
+		_, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts))
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *agentActionGroupResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_agent to default value on import.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_agent"), true)...)
 }
 
 func findAgentActionGroupByThreePartKey(ctx context.Context, conn *bedrockagent.Client, actionGroupID, agentID, agentVersion string) (*awstypes.AgentActionGroup, error) {
@@ -408,7 +456,9 @@ type agentActionGroupResourceModel struct {
 	FunctionSchema             fwtypes.ListNestedObjectValueOf[functionSchemaModel]      `tfsdk:"function_schema"`
 	ID                         types.String                                              `tfsdk:"id"`
 	ParentActionGroupSignature fwtypes.StringEnum[awstypes.ActionGroupSignature]         `tfsdk:"parent_action_group_signature"`
+	PrepareAgent               types.Bool                                                `tfsdk:"prepare_agent"`
 	SkipResourceInUseCheck     types.Bool                                                `tfsdk:"skip_resource_in_use_check"`
+	Timeouts                   timeouts.Value                                            `tfsdk:"timeouts"`
 }
 
 const (
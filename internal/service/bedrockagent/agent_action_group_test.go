@@ -41,6 +41,7 @@ func TestAccBedrockAgentAgentActionGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Basic Agent Action"),
 					resource.TestCheckNoResourceAttr(resourceName, "parent_action_group_signature"),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "skip_resource_in_use_check", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "action_group_executor.#", acctest.Ct1),
 					resource.TestCheckNoResourceAttr(resourceName, "action_group_executor.0.custom_control"),
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -230,11 +231,28 @@ func (r *agentAliasResource) Update(ctx context.Context, request resource.Update
 			return
 		}
 
-		if _, err := waitAgentAliasUpdated(ctx, conn, new.AgentAliasID.ValueString(), new.AgentID.ValueString(), r.CreateTimeout(ctx, new.Timeouts)); err != nil {
+		alias, err := waitAgentAliasUpdated(ctx, conn, new.AgentAliasID.ValueString(), new.AgentID.ValueString(), r.CreateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) update", new.ID.ValueString()), err.Error())
 
 			return
 		}
+
+		// Without a routing configuration a new version is created from the agent's DRAFT.
+		if new.RoutingConfiguration.IsUnknown() {
+			if _, err := waitAgentVersioned(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) version", new.AgentID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, alias, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
@@ -266,9 +284,69 @@ func (r *agentAliasResource) Delete(ctx context.Context, request resource.Delete
 }
 
 func (r *agentAliasResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the routing configuration is not configured, the alias routes to the version created from the agent's DRAFT
+	// when the alias was last created or updated. Update the alias in place if the agent has since been re-prepared.
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var config, state agentAliasResourceModel
+		response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if config.RoutingConfiguration.IsNull() {
+			prepared, err := agentPreparedSinceAliasUpdated(ctx, r.Meta().BedrockAgentClient(ctx), state.AgentAliasID.ValueString(), state.AgentID.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Alias (%s)", state.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			if prepared {
+				response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("routing_configuration"), fwtypes.NewListNestedObjectValueOfUnknown[agentAliasRoutingConfigurationListItemModel](ctx))...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
+// agentPreparedSinceAliasUpdated returns whether the agent has been prepared since the alias was last updated.
+func agentPreparedSinceAliasUpdated(ctx context.Context, conn *bedrockagent.Client, agentAliasID, agentID string) (bool, error) {
+	alias, err := findAgentAliasByTwoPartKey(ctx, conn, agentAliasID, agentID)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	agent, err := findAgentByID(ctx, conn, agentID)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if agent.PreparedAt == nil || alias.UpdatedAt == nil {
+		return false, nil
+	}
+
+	return agent.PreparedAt.After(aws.ToTime(alias.UpdatedAt)), nil
+}
+
 func findAgentAliasByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, agentAliasID, agentID string) (*awstypes.AgentAlias, error) {
 	input := &bedrockagent.GetAgentAliasInput{
 		AgentAliasId: aws.String(agentAliasID),
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -177,6 +178,52 @@ func TestAccBedrockAgentAgentAlias_routingUpdate(t *testing.T) {
 	})
 }
 
+func TestAccBedrockAgentAgentAlias_agentPrepared(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_alias.test"
+	var v awstypes.AgentAlias
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentAliasConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.agent_version", acctest.Ct1),
+				),
+			},
+			{
+				// Adding the action group re-prepares the agent, which the alias detects on the next plan.
+				Config: testAccAgentAliasConfig_actionGroup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.agent_version", acctest.Ct1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAgentAliasConfig_actionGroup(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.agent_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBedrockAgentAgentAlias_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -337,6 +384,10 @@ resource "aws_bedrockagent_agent_alias" "test" {
 `, rName)
 }
 
+func testAccAgentAliasConfig_actionGroup(rName string) string {
+	return acctest.ConfigCompose(testAccAgentActionGroupConfig_basic(rName), testAccAgentAliasConfig_alias(rName))
+}
+
 func testAccAgentAliasConfig_routing(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_bedrockagent_agent_alias" "test" {
@@ -13,8 +13,10 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -37,13 +39,13 @@ func newAgentKnowledgeBaseAssociationResource(context.Context) (resource.Resourc
 
 	r.SetDefaultCreateTimeout(5 * time.Minute)
 	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
 
 	return r, nil
 }
 
 type agentKnowledgeBaseAssociationResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -88,11 +90,17 @@ func (r *agentKnowledgeBaseAssociationResource) Schema(ctx context.Context, requ
 				Required:   true,
 				CustomType: fwtypes.StringEnumType[awstypes.KnowledgeBaseState](),
 			},
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
+				Delete: true,
 			}),
 		},
 	}
@@ -123,11 +131,12 @@ func (r *agentKnowledgeBaseAssociationResource) Create(ctx context.Context, requ
 	// Set values for unknowns.
 	data.setID()
 
-	_, err = prepareAgent(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
-	if err != nil {
-		response.Diagnostics.AddError("preparing Agent", err.Error())
+	if data.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
 
-		return
+			return
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
@@ -197,11 +206,13 @@ func (r *agentKnowledgeBaseAssociationResource) Update(ctx context.Context, requ
 
 		return
 	}
-	_, err = prepareAgent(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
-	if err != nil {
-		response.Diagnostics.AddError("preparing Agent", err.Error())
 
-		return
+	if new.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
+
+			return
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
@@ -231,6 +242,26 @@ func (r *agentKnowledgeBaseAssociationResource) Delete(ctx context.Context, requ
 
 		return
 	}
+
+	if data.PrepareAgent.ValueBool() {
+		_, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts))
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *agentKnowledgeBaseAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_agent to default value on import.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_agent"), true)...)
 }
 
 func findAgentKnowledgeBaseAssociationByThreePartKey(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion, knowledgeBaseID string) (*awstypes.AgentKnowledgeBase, error) {
@@ -267,6 +298,7 @@ type agentKnowledgeBaseAssociationResourceModel struct {
 	ID                 types.String                                    `tfsdk:"id"`
 	KnowledgeBaseID    types.String                                    `tfsdk:"knowledge_base_id"`
 	KnowledgeBaseState fwtypes.StringEnum[awstypes.KnowledgeBaseState] `tfsdk:"knowledge_base_state"`
+	PrepareAgent       types.Bool                                      `tfsdk:"prepare_agent"`
 	Timeouts           timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudtrail_dashboard", name="Dashboard")
+// @Tags(identifierAttribute="id")
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDashboardCreate,
+		ReadWithoutTimeout:   resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		DeleteWithoutTimeout: resourceDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"refresh_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrUnit: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.RefreshScheduleFrequencyUnit](),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.RefreshScheduleStatusEnabled,
+							ValidateDiagFunc: enum.Validate[types.RefreshScheduleStatus](),
+						},
+						"time_of_day": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]{2}:[0-9]{2}$`), "must be in the format HH:mm"),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"termination_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"widgets": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cloudtrail.CreateDashboardInput{
+		Name:                         aws.String(name),
+		TagsList:                     getTagsIn(ctx),
+		TerminationProtectionEnabled: aws.Bool(d.Get("termination_protection_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("refresh_schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RefreshSchedule = expandRefreshSchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("widgets"); ok {
+		widgets, err := expandRequestWidgets(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Widgets = widgets
+	}
+
+	output, err := conn.CreateDashboard(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudTrail Dashboard (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DashboardArn))
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findDashboardByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Dashboard (%s): %s", d.Id(), err)
+	}
+
+	name, err := dashboardNameFromARN(aws.ToString(output.DashboardArn))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrARN, output.DashboardArn)
+	d.Set(names.AttrName, name)
+	if output.RefreshSchedule != nil {
+		if err := d.Set("refresh_schedule", []interface{}{flattenRefreshSchedule(output.RefreshSchedule)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting refresh_schedule: %s", err)
+		}
+	} else {
+		d.Set("refresh_schedule", nil)
+	}
+	d.Set("termination_protection_enabled", output.TerminationProtectionEnabled)
+	if len(output.Widgets) > 0 {
+		widgets, err := flattenWidgets(output.Widgets)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("widgets", widgets)
+	} else {
+		d.Set("widgets", nil)
+	}
+
+	return diags
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// Widgets not included in the request are removed from the dashboard.
+		input := &cloudtrail.UpdateDashboardInput{
+			DashboardId:                  aws.String(d.Id()),
+			TerminationProtectionEnabled: aws.Bool(d.Get("termination_protection_enabled").(bool)),
+		}
+
+		if v, ok := d.GetOk("refresh_schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RefreshSchedule = expandRefreshSchedule(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("widgets"); ok {
+			widgets, err := expandRequestWidgets(v.(string))
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.Widgets = widgets
+		}
+
+		_, err := conn.UpdateDashboard(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudTrail Dashboard (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudTrail Dashboard: %s", d.Id())
+	_, err := conn.DeleteDashboard(ctx, &cloudtrail.DeleteDashboardInput{
+		DashboardId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudTrail Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDashboardByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetDashboardOutput, error) {
+	input := cloudtrail.GetDashboardInput{
+		DashboardId: aws.String(arn),
+	}
+
+	output, err := conn.GetDashboard(ctx, &input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// dashboardNameFromARN returns the dashboard name from an ARN of the form
+// arn:${Partition}:cloudtrail:${Region}:${Account}:dashboard/${DashboardName}.
+func dashboardNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(v.Resource, "dashboard/"), nil
+}
+
+// dashboardWidget is the JSON representation of a dashboard widget.
+// The service-generated query alias is not included.
+type dashboardWidget struct {
+	QueryParameters []string          `json:",omitempty"`
+	QueryStatement  *string           `json:",omitempty"`
+	ViewProperties  map[string]string `json:",omitempty"`
+}
+
+func expandRequestWidgets(s string) ([]types.RequestWidget, error) {
+	var widgets []dashboardWidget
+
+	if err := tfjson.DecodeFromString(s, &widgets); err != nil {
+		return nil, err
+	}
+
+	apiObjects := make([]types.RequestWidget, 0, len(widgets))
+
+	for _, widget := range widgets {
+		apiObjects = append(apiObjects, types.RequestWidget{
+			QueryParameters: widget.QueryParameters,
+			QueryStatement:  widget.QueryStatement,
+			ViewProperties:  widget.ViewProperties,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func flattenWidgets(apiObjects []types.Widget) (string, error) {
+	widgets := make([]dashboardWidget, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		widgets = append(widgets, dashboardWidget{
+			QueryParameters: apiObject.QueryParameters,
+			QueryStatement:  apiObject.QueryStatement,
+			ViewProperties:  apiObject.ViewProperties,
+		})
+	}
+
+	return tfjson.EncodeToString(widgets)
+}
+
+func expandRefreshSchedule(tfMap map[string]interface{}) *types.RefreshSchedule {
+	apiObject := &types.RefreshSchedule{}
+
+	if v, ok := tfMap["frequency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Frequency = expandRefreshScheduleFrequency(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = types.RefreshScheduleStatus(v)
+	}
+
+	if v, ok := tfMap["time_of_day"].(string); ok && v != "" {
+		apiObject.TimeOfDay = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRefreshScheduleFrequency(tfMap map[string]interface{}) *types.RefreshScheduleFrequency {
+	apiObject := &types.RefreshScheduleFrequency{}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = types.RefreshScheduleFrequencyUnit(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok {
+		apiObject.Value = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenRefreshSchedule(apiObject *types.RefreshSchedule) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrStatus: apiObject.Status,
+		"time_of_day":    aws.ToString(apiObject.TimeOfDay),
+	}
+
+	if v := apiObject.Frequency; v != nil {
+		tfMap["frequency"] = []interface{}{map[string]interface{}{
+			names.AttrUnit:  v.Unit,
+			names.AttrValue: aws.ToInt32(v.Value),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudtrail_event_data_store_federation", name="Event Data Store Federation")
+func resourceEventDataStoreFederation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventDataStoreFederationCreate,
+		ReadWithoutTimeout:   resourceEventDataStoreFederationRead,
+		DeleteWithoutTimeout: resourceEventDataStoreFederationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_data_store": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"federation_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"federation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEventDataStoreFederationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	arn := d.Get("event_data_store").(string)
+	input := &cloudtrail.EnableFederationInput{
+		EventDataStore:    aws.String(arn),
+		FederationRoleArn: aws.String(d.Get("federation_role_arn").(string)),
+	}
+
+	_, err := conn.EnableFederation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling CloudTrail Event Data Store (%s) federation: %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if _, err := waitEventDataStoreFederationEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store (%s) federation enable: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEventDataStoreFederationRead(ctx, d, meta)...)
+}
+
+func resourceEventDataStoreFederationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findEventDataStoreFederationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Event Data Store Federation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store Federation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("event_data_store", output.EventDataStoreArn)
+	d.Set("federation_role_arn", output.FederationRoleArn)
+	d.Set("federation_status", output.FederationStatus)
+
+	return diags
+}
+
+func resourceEventDataStoreFederationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudTrail Event Data Store Federation: %s", d.Id())
+	_, err := conn.DisableFederation(ctx, &cloudtrail.DisableFederationInput{
+		EventDataStore: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.EventDataStoreNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling CloudTrail Event Data Store (%s) federation: %s", d.Id(), err)
+	}
+
+	if _, err := waitEventDataStoreFederationDisabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store (%s) federation disable: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEventDataStoreFederationByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetEventDataStoreOutput, error) {
+	output, err := findEventDataStoreByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.FederationStatus; status == types.FederationStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+func statusEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEventDataStoreFederationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.FederationStatus), nil
+	}
+}
+
+func waitEventDataStoreFederationEnabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusEnabling),
+		Target:  enum.Slice(types.FederationStatusEnabled),
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEventDataStoreFederationDisabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusEnabled, types.FederationStatusDisabling),
+		Target:  []string{},
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
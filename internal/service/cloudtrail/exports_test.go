@@ -5,12 +5,16 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
+	ResourceDashboard                         = resourceDashboard
 	ResourceEventDataStore                    = resourceEventDataStore
+	ResourceEventDataStoreFederation          = resourceEventDataStoreFederation
 	ResourceOrganizationDelegatedAdminAccount = newOrganizationDelegatedAdminAccountResource
 	ResourceTrail                             = resourceTrail
 
-	FindEventDataStoreByARN    = findEventDataStoreByARN
-	FindTrailByARN             = findTrailByARN
-	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
-	ServicePrincipal           = servicePrincipal
+	FindDashboardByARN                = findDashboardByARN
+	FindEventDataStoreByARN           = findEventDataStoreByARN
+	FindEventDataStoreFederationByARN = findEventDataStoreFederationByARN
+	FindTrailByARN                    = findTrailByARN
+	ServiceAccountPerRegionMap        = serviceAccountPerRegionMap
+	ServicePrincipal                  = servicePrincipal
 )
@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -47,7 +49,10 @@ func resourceMetricStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffMetricStreamStatisticsConfiguration,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -446,6 +451,35 @@ func waitMetricStreamRunning(ctx context.Context, conn *cloudwatch.Client, name
 	return nil, err
 }
 
+// customizeDiffMetricStreamStatisticsConfiguration validates additional statistics against the output format.
+// OpenTelemetry output formats only support percentile statistics, see
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_MetricStreamStatisticsConfiguration.html.
+func customizeDiffMetricStreamStatisticsConfiguration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("output_format") || !d.NewValueKnown("statistics_configuration") {
+		return nil
+	}
+
+	switch outputFormat := types.MetricStreamOutputFormat(d.Get("output_format").(string)); outputFormat {
+	case types.MetricStreamOutputFormatOpenTelemetry07, types.MetricStreamOutputFormatOpenTelemetry10:
+		for _, tfMapRaw := range d.Get("statistics_configuration").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, v := range flex.ExpandStringValueSet(tfMap["additional_statistics"].(*schema.Set)) {
+				if !metricStreamPercentileStatisticRegexp.MatchString(v) {
+					return fmt.Errorf("additional statistic %q is not supported with output format %q, only percentile statistics (for example p99) are supported", v, outputFormat)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+var metricStreamPercentileStatisticRegexp = regexache.MustCompile(`^p(100|\d{1,2})(\.\d{0,10})?$`)
+
 func validateMetricStreamName(v interface{}, k string) (ws []string, errors []error) {
 	return validation.All(
 		validation.StringLenBetween(1, 255),
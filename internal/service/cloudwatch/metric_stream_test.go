@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -411,6 +412,123 @@ func TestAccCloudWatchMetricStream_additional_statistics(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_additionalStatisticsOutputFormat(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "tm99"),
+				ExpectError: regexache.MustCompile(`only percentile statistics`),
+			},
+			{
+				Config:      testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry0.7", "IQM"),
+				ExpectError: regexache.MustCompile(`only percentile statistics`),
+			},
+			{
+				Config: testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "p99"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "opentelemetry1.0"),
+					resource.TestCheckResourceAttr(resourceName, "statistics_configuration.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry0.7", "p99.9"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "opentelemetry0.7"),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, names.AttrJSON, "tm99"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricStream_updateFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamConfig_includeFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_includeFiltersWithMetricNames(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_excludeFilters(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccMetricStreamConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_includeLinkedAccountsMetrics(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
@@ -788,6 +906,30 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName, stat)
 }
 
+func testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, outputFormat, stat string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = %[2]q
+
+  statistics_configuration {
+    additional_statistics = [%[3]q]
+
+    include_metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+    }
+  }
+}
+`, rName, outputFormat, stat)
+}
+
 func testAccMetricStreamConfig_includeLinkedAccountsMetrics(rName string, include bool) string {
 	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudwatch_metric_stream" "test" {
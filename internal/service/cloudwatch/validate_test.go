@@ -74,3 +74,39 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestMetricStreamPercentileStatisticRegexp(t *testing.T) {
+	t.Parallel()
+
+	validStatistics := []string{
+		"p0.1",
+		"p1",
+		"p50",
+		"p99",
+		"p99.9",
+		"p99.1234567890",
+		"p100",
+		"p100.0",
+	}
+	for _, v := range validStatistics {
+		if !metricStreamPercentileStatisticRegexp.MatchString(v) {
+			t.Fatalf("%q should be a percentile statistic", v)
+		}
+	}
+
+	invalidStatistics := []string{
+		"",
+		"p",
+		"p101",
+		"p999",
+		"p99.12345678901",
+		"tm99",
+		"IQM",
+		"PR(:50)",
+	}
+	for _, v := range invalidStatistics {
+		if metricStreamPercentileStatisticRegexp.MatchString(v) {
+			t.Fatalf("%q should not be a percentile statistic", v)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Findings Reports")
+func newDataSourceFindingsReports(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFindingsReports{}, nil
+}
+
+const (
+	DSNameFindingsReports = "Findings Reports Data Source"
+)
+
+type dataSourceFindingsReports struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFindingsReports) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_codeguruprofiler_findings_reports"
+}
+
+func (d *dataSourceFindingsReports) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"daily_reports_only": schema.BoolAttribute{
+				Optional: true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"findings_report_summaries": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dsFindingsReportSummary](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dsFindingsReportSummary](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"profiling_group_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceFindingsReports) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().CodeGuruProfilerClient(ctx)
+
+	var data dataSourceFindingsReportsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &codeguruprofiler.ListFindingsReportsInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findFindingsReports(ctx, conn, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionReading, DSNameFindingsReports, data.ProfilingGroupName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data.FindingsReportSummaries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = flex.StringValueToFramework(ctx, data.ProfilingGroupName.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findFindingsReports(ctx context.Context, conn *codeguruprofiler.Client, in *codeguruprofiler.ListFindingsReportsInput) ([]awstypes.FindingsReportSummary, error) {
+	var out []awstypes.FindingsReportSummary
+
+	pages := codeguruprofiler.NewListFindingsReportsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.FindingsReportSummaries...)
+	}
+
+	return out, nil
+}
+
+type dataSourceFindingsReportsData struct {
+	DailyReportsOnly        types.Bool                                               `tfsdk:"daily_reports_only"`
+	EndTime                 timetypes.RFC3339                                        `tfsdk:"end_time"`
+	FindingsReportSummaries fwtypes.ListNestedObjectValueOf[dsFindingsReportSummary] `tfsdk:"findings_report_summaries"`
+	ID                      types.String                                             `tfsdk:"id"`
+	ProfilingGroupName      types.String                                             `tfsdk:"profiling_group_name"`
+	StartTime               timetypes.RFC3339                                        `tfsdk:"start_time"`
+}
+
+type dsFindingsReportSummary struct {
+	ID                    types.String      `tfsdk:"id"`
+	ProfileEndTime        timetypes.RFC3339 `tfsdk:"profile_end_time"`
+	ProfileStartTime      timetypes.RFC3339 `tfsdk:"profile_start_time"`
+	ProfilingGroupName    types.String      `tfsdk:"profiling_group_name"`
+	TotalNumberOfFindings types.Int64       `tfsdk:"total_number_of_findings"`
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeGuruProfilerFindingsReportsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codeguruprofiler_findings_reports.test"
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-24 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsReportsDataSourceConfig_basic(rName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "profiling_group_name", resourceName, names.AttrName),
+					// A newly created profiling group has no findings reports.
+					resource.TestCheckResourceAttr(dataSourceName, "findings_report_summaries.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsReportsDataSourceConfig_basic(rName, startTime, endTime string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = "Default"
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+
+data "aws_codeguruprofiler_findings_reports" "test" {
+  profiling_group_name = aws_codeguruprofiler_profiling_group.test.name
+  start_time           = %[2]q
+  end_time             = %[3]q
+}
+`, rName, startTime, endTime)
+}
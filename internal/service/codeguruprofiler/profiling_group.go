@@ -5,16 +5,22 @@ package codeguruprofiler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -60,9 +66,9 @@ func (r *resourceProfilingGroup) Schema(ctx context.Context, req resource.Schema
 				CustomType: computePlatform,
 				Optional:   true,
 				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.ComputePlatformDefault)),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
-					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			names.AttrID: framework.IDAttribute(),
@@ -90,6 +96,38 @@ func (r *resourceProfilingGroup) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"agent_permissions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[agentPermissions](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principals": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 50),
+							},
+						},
+					},
+				},
+			},
+			"notification_channel": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[notificationChannel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrURI: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -136,6 +174,32 @@ func (r *resourceProfilingGroup) Create(ctx context.Context, req resource.Create
 
 	state.ID = flex.StringToFramework(ctx, out.ProfilingGroup.Name)
 
+	// Set partial state so that a failure below leaves the profiling group in state, marked as tainted.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := plan.AgentPermissions; !v.IsNull() && len(v.Elements()) > 0 {
+		if err := putAgentPermissions(ctx, conn, state.ID.ValueString(), plan.AgentPermissions); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameProfilingGroup, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.NotificationChannels.IsNull() {
+		if err := updateNotificationChannels(ctx, conn, state.ID.ValueString(), fwtypes.NewSetNestedObjectValueOfNull[notificationChannel](ctx), plan.NotificationChannels); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameProfilingGroup, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -167,6 +231,34 @@ func (r *resourceProfilingGroup) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	principals, err := findAgentPermissionsPrincipalsByProfilingGroupName(ctx, conn, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flattenAgentPermissions(ctx, principals)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := findNotificationChannelsByProfilingGroupName(ctx, conn, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flattenNotificationChannels(ctx, channels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	setTagsOut(ctx, out.Tags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -210,6 +302,33 @@ func (r *resourceProfilingGroup) Update(ctx context.Context, req resource.Update
 		}
 	}
 
+	if !plan.AgentPermissions.Equal(state.AgentPermissions) {
+		var err error
+		if v := plan.AgentPermissions; v.IsNull() || len(v.Elements()) == 0 {
+			err = removeAgentPermissions(ctx, conn, state.ID.ValueString())
+		} else {
+			err = putAgentPermissions(ctx, conn, state.ID.ValueString(), plan.AgentPermissions)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameProfilingGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.NotificationChannels.Equal(state.NotificationChannels) {
+		if err := updateNotificationChannels(ctx, conn, state.ID.ValueString(), state.NotificationChannels, plan.NotificationChannels); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameProfilingGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -273,16 +392,300 @@ func findProfilingGroupByName(ctx context.Context, conn *codeguruprofiler.Client
 	return out.ProfilingGroup, nil
 }
 
+func findAgentPermissionsPrincipalsByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) ([]string, error) {
+	out, err := findPolicyByProfilingGroupName(ctx, conn, name)
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return agentPermissionsPrincipals(aws.ToString(out.Policy))
+}
+
+func findPolicyByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) (*codeguruprofiler.GetPolicyOutput, error) {
+	in := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.GetPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || aws.ToString(out.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findNotificationChannelsByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) ([]awstypes.Channel, error) {
+	in := &codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.GetNotificationConfiguration(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.NotificationConfiguration == nil {
+		return nil, nil
+	}
+
+	return out.NotificationConfiguration.Channels, nil
+}
+
+// agentPermissionsPrincipals returns the principals granted the agentPermissions action group in a resource-based policy.
+func agentPermissionsPrincipals(policy string) ([]string, error) {
+	var document struct {
+		Statement []struct {
+			Principal struct {
+				AWS any `json:"AWS"`
+			} `json:"Principal"`
+		} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, err
+	}
+
+	var principals []string
+	for _, statement := range document.Statement {
+		switch v := statement.Principal.AWS.(type) {
+		case string:
+			principals = append(principals, v)
+		case []any:
+			for _, v := range v {
+				if v, ok := v.(string); ok {
+					principals = append(principals, v)
+				}
+			}
+		}
+	}
+
+	return principals, nil
+}
+
+func putAgentPermissions(ctx context.Context, conn *codeguruprofiler.Client, name string, v fwtypes.ListNestedObjectValueOf[agentPermissions]) error {
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("reading agent_permissions: %v", diags)
+	}
+
+	in := &codeguruprofiler.PutPermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		Principals:         flex.ExpandFrameworkStringValueSet(ctx, data.Principals),
+		ProfilingGroupName: aws.String(name),
+	}
+
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) policy: %w", name, err)
+	default:
+		in.RevisionId = policy.RevisionId
+	}
+
+	if _, err := conn.PutPermission(ctx, in); err != nil {
+		return fmt.Errorf("putting CodeGuru Profiler Profiling Group (%s) agent permissions: %w", name, err)
+	}
+
+	return nil
+}
+
+func removeAgentPermissions(ctx context.Context, conn *codeguruprofiler.Client, name string) error {
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) policy: %w", name, err)
+	}
+
+	_, err = conn.RemovePermission(ctx, &codeguruprofiler.RemovePermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         policy.RevisionId,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing CodeGuru Profiler Profiling Group (%s) agent permissions: %w", name, err)
+	}
+
+	return nil
+}
+
+func updateNotificationChannels(ctx context.Context, conn *codeguruprofiler.Client, name string, o, n fwtypes.SetNestedObjectValueOf[notificationChannel]) error {
+	oldURIs, newURIs := notificationChannelURIs(ctx, o), notificationChannelURIs(ctx, n)
+
+	var remove []string
+	for uri := range oldURIs {
+		if _, ok := newURIs[uri]; !ok {
+			remove = append(remove, uri)
+		}
+	}
+
+	if len(remove) > 0 {
+		// Channels can only be removed by ID, which is assigned by the service.
+		channels, err := findNotificationChannelsByProfilingGroupName(ctx, conn, name)
+		if err != nil {
+			return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) notification configuration: %w", name, err)
+		}
+
+		for _, uri := range remove {
+			for _, channel := range channels {
+				if aws.ToString(channel.Uri) != uri {
+					continue
+				}
+
+				_, err := conn.RemoveNotificationChannel(ctx, &codeguruprofiler.RemoveNotificationChannelInput{
+					ChannelId:          channel.Id,
+					ProfilingGroupName: aws.String(name),
+				})
+
+				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+					continue
+				}
+
+				if err != nil {
+					return fmt.Errorf("removing CodeGuru Profiler Profiling Group (%s) notification channel (%s): %w", name, uri, err)
+				}
+			}
+		}
+	}
+
+	var add []awstypes.Channel
+	for uri := range newURIs {
+		if _, ok := oldURIs[uri]; !ok {
+			add = append(add, awstypes.Channel{
+				EventPublishers: []awstypes.EventPublisher{awstypes.EventPublisherAnomalyDetection},
+				Uri:             aws.String(uri),
+			})
+		}
+	}
+
+	if len(add) > 0 {
+		_, err := conn.AddNotificationChannels(ctx, &codeguruprofiler.AddNotificationChannelsInput{
+			Channels:           add,
+			ProfilingGroupName: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding CodeGuru Profiler Profiling Group (%s) notification channels: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func notificationChannelURIs(ctx context.Context, v fwtypes.SetNestedObjectValueOf[notificationChannel]) map[string]struct{} {
+	uris := make(map[string]struct{})
+
+	if v.IsNull() || v.IsUnknown() {
+		return uris
+	}
+
+	channels, _ := v.ToSlice(ctx)
+	for _, channel := range channels {
+		uris[channel.URI.ValueString()] = struct{}{}
+	}
+
+	return uris
+}
+
 type resourceProfilingGroupData struct {
 	ARN                      types.String                                              `tfsdk:"arn"`
 	AgentOrchestrationConfig fwtypes.ListNestedObjectValueOf[agentOrchestrationConfig] `tfsdk:"agent_orchestration_config"`
+	AgentPermissions         fwtypes.ListNestedObjectValueOf[agentPermissions]         `tfsdk:"agent_permissions"`
 	ComputePlatform          fwtypes.StringEnum[awstypes.ComputePlatform]              `tfsdk:"compute_platform"`
 	ID                       types.String                                              `tfsdk:"id"`
 	Name                     types.String                                              `tfsdk:"name"`
+	NotificationChannels     fwtypes.SetNestedObjectValueOf[notificationChannel]       `tfsdk:"notification_channel"`
 	Tags                     tftags.Map                                                `tfsdk:"tags"`
 	TagsAll                  tftags.Map                                                `tfsdk:"tags_all"`
 }
 
+func (data *resourceProfilingGroupData) flattenAgentPermissions(ctx context.Context, principals []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(principals) == 0 {
+		data.AgentPermissions = fwtypes.NewListNestedObjectValueOfNull[agentPermissions](ctx)
+		return diags
+	}
+
+	elements := make([]attr.Value, 0, len(principals))
+	for _, principal := range principals {
+		elements = append(elements, types.StringValue(principal))
+	}
+
+	set, d := fwtypes.NewSetValueOf[types.String](ctx, elements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.AgentPermissions, d = fwtypes.NewListNestedObjectValueOfPtr(ctx, &agentPermissions{
+		Principals: set,
+	})
+	diags.Append(d...)
+
+	return diags
+}
+
+func (data *resourceProfilingGroupData) flattenNotificationChannels(ctx context.Context, channels []awstypes.Channel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(channels) == 0 {
+		data.NotificationChannels = fwtypes.NewSetNestedObjectValueOfNull[notificationChannel](ctx)
+		return diags
+	}
+
+	tfList := make([]*notificationChannel, 0, len(channels))
+	for _, channel := range channels {
+		tfList = append(tfList, &notificationChannel{
+			URI: fwtypes.ARNValue(aws.ToString(channel.Uri)),
+		})
+	}
+
+	var d diag.Diagnostics
+	data.NotificationChannels, d = fwtypes.NewSetNestedObjectValueOfSlice(ctx, tfList)
+	diags.Append(d...)
+
+	return diags
+}
+
 type agentOrchestrationConfig struct {
 	ProfilingEnabled types.Bool `tfsdk:"profiling_enabled"`
 }
+
+type agentPermissions struct {
+	Principals fwtypes.SetValueOf[types.String] `tfsdk:"principals"`
+}
+
+type notificationChannel struct {
+	URI fwtypes.ARN `tfsdk:"uri"`
+}
@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -182,6 +183,162 @@ func TestAccCodeGuruProfilerProfilingGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccCodeGuruProfilerProfilingGroup_agentPermissions(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_agentPermissions1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_agentPermissions2(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test.1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_agentPermissionsNone(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_notificationChannel(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_notificationChannel(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_notificationChannel(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_computePlatform(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_computePlatform(rName, string(awstypes.ComputePlatformAwsLambda)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", string(awstypes.ComputePlatformAwsLambda)),
+				),
+			},
+			{
+				// Omitting compute_platform reverts to the default, which requires replacement.
+				Config: testAccProfilingGroupConfig_noComputePlatform(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", string(awstypes.ComputePlatformDefault)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProfilingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
@@ -305,3 +462,137 @@ resource "aws_codeguruprofiler_profiling_group" "test" {
 }
 `, rName, key1, value1, key2, value2)
 }
+
+func testAccProfilingGroupConfig_agentPermissionsBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccProfilingGroupConfig_agentPermissions1(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_agentPermissionsBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  agent_permissions {
+    principals = [aws_iam_role.test[0].arn]
+  }
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_agentPermissions2(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_agentPermissionsBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  agent_permissions {
+    principals = aws_iam_role.test[*].arn
+  }
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_agentPermissionsNone(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_agentPermissionsBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_notificationChannel(rName string, topicIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_sns_topic_policy" "test" {
+  count = 2
+
+  arn = aws_sns_topic.test[count.index].arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "codeguru-profiler.amazonaws.com"
+      }
+      Action   = "sns:Publish"
+      Resource = aws_sns_topic.test[count.index].arn
+    }]
+  })
+}
+
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  notification_channel {
+    uri = aws_sns_topic.test[%[2]d].arn
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName, topicIndex)
+}
+
+func testAccProfilingGroupConfig_computePlatform(rName, computePlatform string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = %[2]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName, computePlatform)
+}
+
+func testAccProfilingGroupConfig_noComputePlatform(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName)
+}
@@ -16,6 +16,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceFindingsReports,
+			Name:    "Findings Reports",
+		},
 		{
 			Factory: newDataSourceProfilingGroup,
 			Name:    "Profiling Group",
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cognito_managed_login_branding", name="Managed Login Branding")
+func newManagedLoginBrandingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &managedLoginBrandingResource{}, nil
+}
+
+type managedLoginBrandingResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *managedLoginBrandingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cognito_managed_login_branding"
+}
+
+func (r *managedLoginBrandingResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClientID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"managed_login_branding_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+				Optional:   true,
+				Computed:   true,
+			},
+			"use_cognito_provided_values": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"asset": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[assetTypeModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(40),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bytes": schema.StringAttribute{
+							Optional: true,
+						},
+						"category": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AssetCategoryType](),
+							Required:   true,
+						},
+						"color_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ColorSchemeModeType](),
+							Required:   true,
+						},
+						"extension": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AssetExtensionType](),
+							Required:   true,
+						},
+						names.AttrResourceID: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *managedLoginBrandingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data managedLoginBrandingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+	input := &cognitoidentityprovider.CreateManagedLoginBrandingInput{
+		ClientId:                 aws.String(clientID),
+		UseCognitoProvidedValues: data.UseCognitoProvidedValues.ValueBool(),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	input.Assets = expandAssetTypes(ctx, data.Asset, &response.Diagnostics)
+	if !data.Settings.IsUnknown() {
+		settings, diags := data.Settings.ValueInterface()
+		response.Diagnostics.Append(diags...)
+		input.Settings = settings
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cognito Managed Login Branding (%s/%s)", userPoolID, clientID), err.Error())
+
+		return
+	}
+
+	mlb, err := findManagedLoginBrandingByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cognito Managed Login Branding (%s/%s)", userPoolID, clientID), err.Error())
+
+		return
+	}
+
+	data.ManagedLoginBrandingID = fwflex.StringToFramework(ctx, mlb.ManagedLoginBrandingId)
+	data.UseCognitoProvidedValues = types.BoolValue(mlb.UseCognitoProvidedValues)
+	if data.Settings.IsUnknown() {
+		data.Settings = flattenManagedLoginBrandingSettings(mlb.Settings, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *managedLoginBrandingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data managedLoginBrandingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+	mlb, err := findManagedLoginBrandingByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cognito Managed Login Branding (%s/%s)", userPoolID, clientID), err.Error())
+
+		return
+	}
+
+	data.Asset = flattenAssetTypes(ctx, mlb.Assets, &response.Diagnostics)
+	data.ManagedLoginBrandingID = fwflex.StringToFramework(ctx, mlb.ManagedLoginBrandingId)
+	data.Settings = flattenManagedLoginBrandingSettings(mlb.Settings, &response.Diagnostics)
+	data.UseCognitoProvidedValues = types.BoolValue(mlb.UseCognitoProvidedValues)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *managedLoginBrandingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new managedLoginBrandingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	userPoolID, clientID := new.UserPoolID.ValueString(), new.ClientID.ValueString()
+	input := &cognitoidentityprovider.UpdateManagedLoginBrandingInput{
+		ManagedLoginBrandingId:   fwflex.StringFromFramework(ctx, old.ManagedLoginBrandingID),
+		UseCognitoProvidedValues: new.UseCognitoProvidedValues.ValueBool(),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	input.Assets = expandAssetTypes(ctx, new.Asset, &response.Diagnostics)
+	if !new.Settings.IsUnknown() {
+		settings, diags := new.Settings.ValueInterface()
+		response.Diagnostics.Append(diags...)
+		input.Settings = settings
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cognito Managed Login Branding (%s/%s)", userPoolID, clientID), err.Error())
+
+		return
+	}
+
+	new.ManagedLoginBrandingID = fwflex.StringToFramework(ctx, output.ManagedLoginBranding.ManagedLoginBrandingId)
+	new.UseCognitoProvidedValues = types.BoolValue(output.ManagedLoginBranding.UseCognitoProvidedValues)
+	if new.Settings.IsUnknown() {
+		new.Settings = flattenManagedLoginBrandingSettings(output.ManagedLoginBranding.Settings, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *managedLoginBrandingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data managedLoginBrandingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+	_, err := conn.DeleteManagedLoginBranding(ctx, &cognitoidentityprovider.DeleteManagedLoginBrandingInput{
+		ManagedLoginBrandingId: fwflex.StringFromFramework(ctx, data.ManagedLoginBrandingID),
+		UserPoolId:             aws.String(userPoolID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cognito Managed Login Branding (%s/%s)", userPoolID, clientID), err.Error())
+
+		return
+	}
+}
+
+func (r *managedLoginBrandingResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	const (
+		managedLoginBrandingIDParts = 2
+	)
+	parts, err := intflex.ExpandResourceId(request.ID, managedLoginBrandingIDParts, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user_pool_id,client_id. Got: %q", request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrUserPoolID), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrClientID), parts[1])...)
+}
+
+func findManagedLoginBrandingByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID, clientID string) (*awstypes.ManagedLoginBrandingType, error) {
+	input := &cognitoidentityprovider.DescribeManagedLoginBrandingByClientInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.DescribeManagedLoginBrandingByClient(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ManagedLoginBranding == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ManagedLoginBranding, nil
+}
+
+func expandAssetTypes(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[assetTypeModel], diags *diag.Diagnostics) []awstypes.AssetType {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+
+	tfList, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.AssetType, 0, len(tfList))
+
+	for _, tfObject := range tfList {
+		apiObject := awstypes.AssetType{
+			Category:   tfObject.Category.ValueEnum(),
+			ColorMode:  tfObject.ColorMode.ValueEnum(),
+			Extension:  tfObject.Extension.ValueEnum(),
+			ResourceId: fwflex.StringFromFramework(ctx, tfObject.ResourceID),
+		}
+
+		if v := tfObject.Bytes.ValueString(); v != "" {
+			b, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				diags.AddAttributeError(path.Root("asset").AtName("bytes"), "decoding asset bytes", err.Error())
+				return nil
+			}
+
+			apiObject.Bytes = b
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssetTypes(ctx context.Context, apiObjects []awstypes.AssetType, diags *diag.Diagnostics) fwtypes.SetNestedObjectValueOf[assetTypeModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewSetNestedObjectValueOfNull[assetTypeModel](ctx)
+	}
+
+	tfList := make([]assetTypeModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfObject := assetTypeModel{
+			Category:   fwtypes.StringEnumValue(apiObject.Category),
+			ColorMode:  fwtypes.StringEnumValue(apiObject.ColorMode),
+			Extension:  fwtypes.StringEnumValue(apiObject.Extension),
+			ResourceID: fwflex.StringToFramework(ctx, apiObject.ResourceId),
+		}
+
+		if len(apiObject.Bytes) > 0 {
+			tfObject.Bytes = types.StringValue(base64.StdEncoding.EncodeToString(apiObject.Bytes))
+		} else {
+			tfObject.Bytes = types.StringNull()
+		}
+
+		tfList = append(tfList, tfObject)
+	}
+
+	v, d := fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, tfList)
+	diags.Append(d...)
+
+	return v
+}
+
+func flattenManagedLoginBrandingSettings(apiObject document.Interface, diags *diag.Diagnostics) fwtypes.SmithyJSON[document.Interface] {
+	if apiObject == nil {
+		return fwtypes.SmithyJSONNull[document.Interface]()
+	}
+
+	v, err := tfjson.SmithyDocumentToString(apiObject)
+	if err != nil {
+		diags.AddError("flattening settings", err.Error())
+		return fwtypes.SmithyJSONNull[document.Interface]()
+	}
+
+	return fwtypes.SmithyJSONValue(v, document.NewLazyDocument)
+}
+
+type managedLoginBrandingResourceModel struct {
+	Asset                    fwtypes.SetNestedObjectValueOf[assetTypeModel] `tfsdk:"asset"`
+	ClientID                 types.String                                   `tfsdk:"client_id"`
+	ManagedLoginBrandingID   types.String                                   `tfsdk:"managed_login_branding_id"`
+	Settings                 fwtypes.SmithyJSON[document.Interface]         `tfsdk:"settings"`
+	UseCognitoProvidedValues types.Bool                                     `tfsdk:"use_cognito_provided_values"`
+	UserPoolID               types.String                                   `tfsdk:"user_pool_id"`
+}
+
+type assetTypeModel struct {
+	Bytes      types.String                                     `tfsdk:"bytes"`
+	Category   fwtypes.StringEnum[awstypes.AssetCategoryType]   `tfsdk:"category"`
+	ColorMode  fwtypes.StringEnum[awstypes.ColorSchemeModeType] `tfsdk:"color_mode"`
+	Extension  fwtypes.StringEnum[awstypes.AssetExtensionType]  `tfsdk:"extension"`
+	ResourceID types.String                                     `tfsdk:"resource_id"`
+}
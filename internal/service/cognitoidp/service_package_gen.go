@@ -33,6 +33,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newManagedLoginBrandingResource,
+			Name:    "Managed Login Branding",
+		},
 		{
 			Factory: newManagedUserPoolClientResource,
 			Name:    "Managed User Pool Client",
@@ -559,6 +559,21 @@ func resourceUserPool() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"advanced_security_additional_flows": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_auth_mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.AdvancedSecurityEnabledModeType](),
+									},
+								},
+							},
+						},
 						"advanced_security_mode": {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -567,6 +582,12 @@ func resourceUserPool() *schema.Resource {
 					},
 				},
 			},
+			"user_pool_tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.UserPoolTierType](),
+			},
 			"username_attributes": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -750,14 +771,14 @@ func resourceUserPoolCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("user_pool_add_ons"); ok {
 		if v, ok := v.([]interface{})[0].(map[string]interface{}); ok && v != nil {
-			input.UserPoolAddOns = &awstypes.UserPoolAddOnsType{}
-
-			if v, ok := v["advanced_security_mode"]; ok && v.(string) != "" {
-				input.UserPoolAddOns.AdvancedSecurityMode = awstypes.AdvancedSecurityModeType(v.(string))
-			}
+			input.UserPoolAddOns = expandUserPoolAddOnsType(v)
 		}
 	}
 
+	if v, ok := d.GetOk("user_pool_tier"); ok {
+		input.UserPoolTier = awstypes.UserPoolTierType(v.(string))
+	}
+
 	if v, ok := d.GetOk("verification_message_template"); ok {
 		if v, ok := v.([]interface{})[0].(map[string]interface{}); ok && v != nil {
 			input.VerificationMessageTemplate = expandVerificationMessageTemplateType(v)
@@ -870,6 +891,7 @@ func resourceUserPoolRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("user_pool_add_ons", flattenUserPoolAddOnsType(userPool.UserPoolAddOns)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting user_pool_add_ons: %s", err)
 	}
+	d.Set("user_pool_tier", userPool.UserPoolTier)
 	d.Set("username_attributes", userPool.UsernameAttributes)
 	if err := d.Set("username_configuration", flattenUsernameConfigurationType(userPool.UsernameConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting username_configuration: %s", err)
@@ -956,6 +978,7 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		names.AttrTagsAll,
 		"user_attribute_update_settings",
 		"user_pool_add_ons",
+		"user_pool_tier",
 		"verification_message_template",
 	) {
 		input := &cognitoidentityprovider.UpdateUserPoolInput{
@@ -1062,14 +1085,14 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		if v, ok := d.GetOk("user_pool_add_ons"); ok {
 			if v, ok := v.([]interface{})[0].(map[string]interface{}); ok && v != nil {
-				input.UserPoolAddOns = &awstypes.UserPoolAddOnsType{}
-
-				if v, ok := v["advanced_security_mode"]; ok && v.(string) != "" {
-					input.UserPoolAddOns.AdvancedSecurityMode = awstypes.AdvancedSecurityModeType(v.(string))
-				}
+				input.UserPoolAddOns = expandUserPoolAddOnsType(v)
 			}
 		}
 
+		if v, ok := d.GetOk("user_pool_tier"); ok {
+			input.UserPoolTier = awstypes.UserPoolTierType(v.(string))
+		}
+
 		if v, ok := d.GetOk("verification_message_template"); ok {
 			if v, ok := v.([]interface{})[0].(map[string]interface{}); ok && v != nil {
 				if d.HasChange("email_verification_message") {
@@ -1632,9 +1655,33 @@ func flattenUserPoolAddOnsType(apiObject *awstypes.UserPoolAddOnsType) []interfa
 
 	tfMap["advanced_security_mode"] = apiObject.AdvancedSecurityMode
 
+	if v := apiObject.AdvancedSecurityAdditionalFlows; v != nil {
+		tfMap["advanced_security_additional_flows"] = []interface{}{map[string]interface{}{
+			"custom_auth_mode": v.CustomAuthMode,
+		}}
+	}
+
 	return []interface{}{tfMap}
 }
 
+func expandUserPoolAddOnsType(tfMap map[string]interface{}) *awstypes.UserPoolAddOnsType {
+	apiObject := &awstypes.UserPoolAddOnsType{}
+
+	if v, ok := tfMap["advanced_security_additional_flows"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AdvancedSecurityAdditionalFlows = &awstypes.AdvancedSecurityAdditionalFlowsType{}
+
+		if v, ok := v[0].(map[string]interface{})["custom_auth_mode"].(string); ok && v != "" {
+			apiObject.AdvancedSecurityAdditionalFlows.CustomAuthMode = awstypes.AdvancedSecurityEnabledModeType(v)
+		}
+	}
+
+	if v, ok := tfMap["advanced_security_mode"].(string); ok && v != "" {
+		apiObject.AdvancedSecurityMode = awstypes.AdvancedSecurityModeType(v)
+	}
+
+	return apiObject
+}
+
 func expandSchemaAttributeTypes(tfList []interface{}) []awstypes.SchemaAttributeType {
 	apiObjects := make([]awstypes.SchemaAttributeType, len(tfList))
 
@@ -52,6 +52,40 @@ func TestAccCognitoIDPUserPoolDomain_basic(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUserPoolDomain_managedLoginVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_pool_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolDomainConfig_managedLoginVersion(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolDomainExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_login_version", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserPoolDomainConfig_managedLoginVersion(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolDomainExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_login_version", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPUserPoolDomain_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -233,6 +267,20 @@ resource "aws_cognito_user_pool" "test" {
 `, rName)
 }
 
+func testAccUserPoolDomainConfig_managedLoginVersion(rName string, managedLoginVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool_domain" "test" {
+  domain                = %[1]q
+  managed_login_version = %[2]d
+  user_pool_id          = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+`, rName, managedLoginVersion)
+}
+
 func testAccUserPoolDomainConfig_custom(rootDomain string, domain string, poolName string) string {
 	return fmt.Sprintf(`
 data "aws_route53_zone" "test" {
@@ -0,0 +1,606 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_comprehend_flywheel", name="Flywheel")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/comprehend/types;awstypes;awstypes.FlywheelProperties")
+// @Testing(tagsTest=false)
+func newFlywheelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flywheelResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type flywheelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*flywheelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_comprehend_flywheel"
+}
+
+func (r *flywheelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active_model_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"data_lake_s3_uri": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^s3://[0-9a-z.-]{3,63}(/.*)?$`), "must be an S3 URI"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"model_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ModelType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z](-*[0-9A-Za-z])*$`), "must contain only alphanumeric characters and hyphens"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlywheelStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_security_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSecurityConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_lake_kms_key_id": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"model_kms_key_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"volume_kms_key_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrVPCConfig: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrSecurityGroupIDs: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeBetween(1, 5),
+										},
+									},
+									names.AttrSubnets: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeBetween(1, 16),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"task_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[taskConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrLanguageCode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.LanguageCode](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"document_classification_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[documentClassificationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("document_classification_config"),
+									path.MatchRelative().AtParent().AtName("entity_recognition_config"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"labels": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										PlanModifiers: []planmodifier.Set{
+											setplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.Set{
+											setvalidator.SizeAtMost(1000),
+										},
+									},
+									names.AttrMode: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DocumentClassifierMode](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"entity_recognition_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityRecognitionConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entity_types": schema.SetNestedBlock{
+										CustomType: fwtypes.NewSetNestedObjectTypeOf[entityTypesListItemModel](ctx),
+										Validators: []validator.Set{
+											setvalidator.IsRequired(),
+											setvalidator.SizeBetween(1, 25),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrType: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+														stringvalidator.RegexMatches(regexache.MustCompile(`^[^\n\r\t,]+$`), "must not contain newlines, carriage returns, tabs or commas"),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *flywheelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &comprehend.CreateFlywheelInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.FlywheelName = aws.String(name)
+	input.Tags = getTagsIn(ctx)
+
+	// The data access role may not yet be assumable by Comprehend.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidRequestException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateFlywheel(ctx, input)
+	}, "Unable to access")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Comprehend Flywheel (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, outputRaw.(*comprehend.CreateFlywheelOutput).FlywheelArn)
+	data.setID()
+
+	output, err := waitFlywheelCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	dataLakeS3URI := data.DataLakeS3URI
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.DataLakeS3URI = dataLakeS3URI
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flywheelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	output, err := findFlywheelByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Comprehend Flywheel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	dataLakeS3URI := data.DataLakeS3URI
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Comprehend appends its own folder structure to the configured data lake location.
+	if v := dataLakeS3URI.ValueString(); v != "" && strings.HasPrefix(aws.ToString(output.DataLakeS3Uri), v) {
+		data.DataLakeS3URI = dataLakeS3URI
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flywheelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flywheelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	if !new.ActiveModelARN.Equal(old.ActiveModelARN) ||
+		!new.DataAccessRoleARN.Equal(old.DataAccessRoleARN) ||
+		!new.DataSecurityConfig.Equal(old.DataSecurityConfig) {
+		input := &comprehend.UpdateFlywheelInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlywheelArn = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateFlywheel(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Comprehend Flywheel (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitFlywheelUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		dataLakeS3URI := new.DataLakeS3URI
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		new.DataLakeS3URI = dataLakeS3URI
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flywheelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	_, err := conn.DeleteFlywheel(ctx, &comprehend.DeleteFlywheelInput{
+		FlywheelArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Comprehend Flywheel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlywheelDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flywheelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFlywheelByARN(ctx context.Context, conn *comprehend.Client, arn string) (*awstypes.FlywheelProperties, error) {
+	input := &comprehend.DescribeFlywheelInput{
+		FlywheelArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlywheel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FlywheelProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FlywheelProperties, nil
+}
+
+func statusFlywheel(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlywheelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlywheelCreated(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlywheelStatusCreating),
+		Target:  enum.Slice(awstypes.FlywheelStatusActive),
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FlywheelProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlywheelUpdated(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlywheelStatusUpdating),
+		Target:  enum.Slice(awstypes.FlywheelStatusActive),
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FlywheelProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlywheelDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlywheelStatusActive, awstypes.FlywheelStatusDeleting, awstypes.FlywheelStatusFailed),
+		Target:  []string{},
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FlywheelProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flywheelResourceModel struct {
+	ActiveModelARN     fwtypes.ARN                                              `tfsdk:"active_model_arn"`
+	ARN                types.String                                             `tfsdk:"arn"`
+	DataAccessRoleARN  fwtypes.ARN                                              `tfsdk:"data_access_role_arn"`
+	DataLakeS3URI      types.String                                             `tfsdk:"data_lake_s3_uri"`
+	DataSecurityConfig fwtypes.ListNestedObjectValueOf[dataSecurityConfigModel] `tfsdk:"data_security_config"`
+	ID                 types.String                                             `tfsdk:"id"`
+	ModelType          fwtypes.StringEnum[awstypes.ModelType]                   `tfsdk:"model_type"`
+	Name               types.String                                             `tfsdk:"name"`
+	Status             fwtypes.StringEnum[awstypes.FlywheelStatus]              `tfsdk:"status"`
+	Tags               tftags.Map                                               `tfsdk:"tags"`
+	TagsAll            tftags.Map                                               `tfsdk:"tags_all"`
+	TaskConfig         fwtypes.ListNestedObjectValueOf[taskConfigModel]         `tfsdk:"task_config"`
+	Timeouts           timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *flywheelResourceModel) InitFromID() error {
+	arn, err := arn.Parse(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.ARN = data.ID
+	// Resource is of the form "flywheel/<name>".
+	_, name, found := strings.Cut(arn.Resource, "/")
+	if !found {
+		return fmt.Errorf("unexpected format for ARN resource (%s), expected flywheel/<name>", arn.Resource)
+	}
+	data.Name = types.StringValue(name)
+
+	return nil
+}
+
+func (data *flywheelResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type dataSecurityConfigModel struct {
+	DataLakeKMSKeyID types.String                                    `tfsdk:"data_lake_kms_key_id"`
+	ModelKMSKeyID    types.String                                    `tfsdk:"model_kms_key_id"`
+	VolumeKMSKeyID   types.String                                    `tfsdk:"volume_kms_key_id"`
+	VPCConfig        fwtypes.ListNestedObjectValueOf[vpcConfigModel] `tfsdk:"vpc_config"`
+}
+
+type vpcConfigModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	Subnets          fwtypes.SetValueOf[types.String] `tfsdk:"subnets"`
+}
+
+type taskConfigModel struct {
+	DocumentClassificationConfig fwtypes.ListNestedObjectValueOf[documentClassificationConfigModel] `tfsdk:"document_classification_config"`
+	EntityRecognitionConfig      fwtypes.ListNestedObjectValueOf[entityRecognitionConfigModel]      `tfsdk:"entity_recognition_config"`
+	LanguageCode                 fwtypes.StringEnum[awstypes.LanguageCode]                          `tfsdk:"language_code"`
+}
+
+type documentClassificationConfigModel struct {
+	Labels fwtypes.SetValueOf[types.String]                    `tfsdk:"labels"`
+	Mode   fwtypes.StringEnum[awstypes.DocumentClassifierMode] `tfsdk:"mode"`
+}
+
+type entityRecognitionConfigModel struct {
+	EntityTypes fwtypes.SetNestedObjectValueOf[entityTypesListItemModel] `tfsdk:"entity_types"`
+}
+
+type entityTypesListItemModel struct {
+	Type types.String `tfsdk:"type"`
+}
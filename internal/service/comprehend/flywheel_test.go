@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccComprehendFlywheel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "comprehend", fmt.Sprintf("flywheel/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_lake_s3_uri", fmt.Sprintf("s3://%s/flywheel", rName)),
+					resource.TestCheckResourceAttr(resourceName, "data_security_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "model_type", string(awstypes.ModelTypeDocumentClassifier)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FlywheelStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.language_code", string(awstypes.LanguageCodeEn)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.0.mode", string(awstypes.DocumentClassifierModeMultiClass)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.0.labels.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_lake_s3_uri"},
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomprehend.ResourceFlywheel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_entityRecognizer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_entityRecognizer(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "model_type", string(awstypes.ModelTypeEntityRecognizer)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.entity_recognition_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.entity_recognition_config.0.entity_types.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task_config.0.entity_recognition_config.0.entity_types.*", map[string]string{
+						names.AttrType: "ENGINEER",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_lake_s3_uri"},
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_dataSecurityConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_dataSecurityConfig(rName, "model1", "volume1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "data_security_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "data_security_config.0.model_kms_key_id", "aws_kms_key.model1", names.AttrKeyID),
+					resource.TestCheckResourceAttrPair(resourceName, "data_security_config.0.volume_kms_key_id", "aws_kms_key.volume1", names.AttrKeyID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_lake_s3_uri"},
+			},
+			{
+				Config: testAccFlywheelConfig_dataSecurityConfig(rName, "model2", "volume2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &v2),
+					testAccCheckFlywheelNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "data_security_config.0.model_kms_key_id", "aws_kms_key.model2", names.AttrKeyID),
+					resource.TestCheckResourceAttrPair(resourceName, "data_security_config.0.volume_kms_key_id", "aws_kms_key.volume2", names.AttrKeyID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlywheelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_comprehend_flywheel" {
+				continue
+			}
+
+			_, err := tfcomprehend.FindFlywheelByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Comprehend Flywheel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlywheelExists(ctx context.Context, n string, v *awstypes.FlywheelProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		output, err := tfcomprehend.FindFlywheelByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlywheelNotRecreated(before, after *awstypes.FlywheelProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreationTime), aws.ToTime(after.CreationTime); !before.Equal(after) {
+			return fmt.Errorf("Comprehend Flywheel recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccFlywheelConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDocumentClassifierS3BucketConfig(rName),
+		testAccDocumentClassifierBasicRoleConfig(rName),
+		`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role_policy" "flywheel" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:PutObject", "s3:DeleteObject"]
+      Resource = ["${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+`)
+}
+
+func testAccFlywheelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel"
+  model_type           = "DOCUMENT_CLASSIFIER"
+
+  task_config {
+    language_code = "en"
+
+    document_classification_config {
+      mode   = "MULTI_CLASS"
+      labels = ["ENGINEER", "MANAGER"]
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy.test,
+    aws_iam_role_policy.flywheel,
+  ]
+}
+`, rName))
+}
+
+func testAccFlywheelConfig_entityRecognizer(rName string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel"
+  model_type           = "ENTITY_RECOGNIZER"
+
+  task_config {
+    language_code = "en"
+
+    entity_recognition_config {
+      entity_types {
+        type = "ENGINEER"
+      }
+      entity_types {
+        type = "MANAGER"
+      }
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy.test,
+    aws_iam_role_policy.flywheel,
+  ]
+}
+`, rName))
+}
+
+func testAccFlywheelConfig_dataSecurityConfig(rName, modelKey, volumeKey string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "model1" {
+  description             = "%[1]s-model1"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "model2" {
+  description             = "%[1]s-model2"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "volume1" {
+  description             = "%[1]s-volume1"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "volume2" {
+  description             = "%[1]s-volume2"
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role_policy" "kms" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = ["kms:CreateGrant", "kms:Decrypt", "kms:GenerateDataKey"]
+      Resource = [
+        aws_kms_key.model1.arn,
+        aws_kms_key.model2.arn,
+        aws_kms_key.volume1.arn,
+        aws_kms_key.volume2.arn,
+      ]
+    }]
+  })
+}
+
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel"
+  model_type           = "DOCUMENT_CLASSIFIER"
+
+  data_security_config {
+    model_kms_key_id  = aws_kms_key.%[2]s.key_id
+    volume_kms_key_id = aws_kms_key.%[3]s.key_id
+  }
+
+  task_config {
+    language_code = "en"
+
+    document_classification_config {
+      mode   = "MULTI_CLASS"
+      labels = ["ENGINEER", "MANAGER"]
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy.test,
+    aws_iam_role_policy.flywheel,
+    aws_iam_role_policy.kms,
+  ]
+}
+`, rName, modelKey, volumeKey))
+}
@@ -5,7 +5,10 @@ package configservice
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -13,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,6 +50,10 @@ func resourceConfigRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -196,7 +204,10 @@ func resourceConfigRule() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceConfigRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -272,6 +283,7 @@ func resourceConfigRuleRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set(names.AttrARN, rule.ConfigRuleArn)
+	d.Set("created_by", rule.CreatedBy)
 	d.Set(names.AttrDescription, rule.Description)
 	if err := d.Set("evaluation_mode", flattenEvaluationModeConfigurations(rule.EvaluationModes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting evaluation_mode: %s", err)
@@ -327,6 +339,52 @@ func resourceConfigRuleDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceConfigRuleCustomizeDiff verifies at plan time that a rule with proactive evaluation can be evaluated proactively.
+// AWS managed rules that aren't known to support proactive evaluation are logged, and AWS Config rejects them if unsupported.
+func resourceConfigRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("evaluation_mode", "scope", "source") {
+		return nil
+	}
+
+	if !d.NewValueKnown("evaluation_mode") {
+		return nil
+	}
+
+	var proactive bool
+	for _, tfMapRaw := range d.Get("evaluation_mode").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && tfMap[names.AttrMode].(string) == string(types.EvaluationModeProactive) {
+			proactive = true
+			break
+		}
+	}
+
+	if !proactive {
+		return nil
+	}
+
+	switch owner := d.Get("source.0.owner").(string); owner {
+	case string(types.OwnerCustomLambda):
+		return fmt.Errorf("evaluation_mode: %s evaluation is not supported for rules with source owner %s", types.EvaluationModeProactive, owner)
+	case string(types.OwnerAws):
+		if !d.NewValueKnown("source.0.source_identifier") {
+			break
+		}
+
+		if v := d.Get("source.0.source_identifier").(string); !slices.Contains(proactiveManagedRuleIdentifiers, v) {
+			log.Printf("[WARN] evaluation_mode: AWS managed rule %s is not known to support %s evaluation", v, types.EvaluationModeProactive)
+		}
+	}
+
+	// Proactive rules can only be scoped by resource type.
+	for _, k := range []string{"scope.0.compliance_resource_id", "scope.0.tag_key", "scope.0.tag_value"} {
+		if v, ok := d.GetOk(k); ok && v.(string) != "" {
+			return fmt.Errorf("%s: rules with %s evaluation can only be scoped by compliance_resource_types", strings.ReplaceAll(k, ".0.", "."), types.EvaluationModeProactive)
+		}
+	}
+
+	return nil
+}
+
 func findConfigRuleByName(ctx context.Context, conn *configservice.Client, name string) (*types.ConfigRule, error) {
 	input := &configservice.DescribeConfigRulesInput{
 		ConfigRuleNames: []string{name},
@@ -36,6 +36,7 @@ func testAccConfigRule_basic(t *testing.T) {
 				Config: testAccConfigRuleConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckConfigRuleExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "created_by", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "source.0.owner", "AWS"),
@@ -103,6 +104,30 @@ func testAccConfigRule_evaluationMode(t *testing.T) {
 	})
 }
 
+func testAccConfigRule_evaluationModeProactiveInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigRuleConfig_evaluationModeProactiveCustomLambda(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`not supported for rules with source owner CUSTOM_LAMBDA`),
+			},
+			{
+				Config:      testAccConfigRuleConfig_evaluationModeProactiveScopeTagKey(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`can only be scoped by`),
+			},
+		},
+	})
+}
+
 func testAccConfigRule_ownerAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
 	var cr types.ConfigRule
@@ -776,3 +801,51 @@ resource "aws_config_config_rule" "test" {
 }
 `, rName, evaluationMode))
 }
+
+func testAccConfigRuleConfig_evaluationModeProactiveCustomLambda(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "CUSTOM_LAMBDA"
+    source_identifier = "arn:${data.aws_partition.current.partition}:lambda:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:function:%[1]s"
+
+    source_detail {
+      message_type = "ConfigurationItemChangeNotification"
+    }
+  }
+
+  evaluation_mode {
+    mode = "PROACTIVE"
+  }
+}
+`, rName)
+}
+
+func testAccConfigRuleConfig_evaluationModeProactiveScopeTagKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "EIP_ATTACHED"
+  }
+
+  evaluation_mode {
+    mode = "PROACTIVE"
+  }
+
+  scope {
+    tag_key = "Environment"
+  }
+}
+`, rName)
+}
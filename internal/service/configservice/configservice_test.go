@@ -15,16 +15,17 @@ func TestAccConfigService_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"ConfigRule": {
-			acctest.CtBasic:      testAccConfigRule_basic,
-			"ownerAws":           testAccConfigRule_ownerAWS,
-			"customlambda":       testAccConfigRule_customlambda,
-			"customPolicy":       testAccConfigRule_ownerPolicy,
-			"evaluationMode":     testAccConfigRule_evaluationMode,
-			"scopeTagKey":        testAccConfigRule_Scope_TagKey,
-			"scopeTagKeyEmpty":   testAccConfigRule_Scope_TagKey_Empty,
-			"scopeTagValue":      testAccConfigRule_Scope_TagValue,
-			"tags":               testAccConfigRule_tags,
-			acctest.CtDisappears: testAccConfigRule_disappears,
+			acctest.CtBasic:                  testAccConfigRule_basic,
+			"ownerAws":                       testAccConfigRule_ownerAWS,
+			"customlambda":                   testAccConfigRule_customlambda,
+			"customPolicy":                   testAccConfigRule_ownerPolicy,
+			"evaluationMode":                 testAccConfigRule_evaluationMode,
+			"evaluationModeProactiveInvalid": testAccConfigRule_evaluationModeProactiveInvalid,
+			"scopeTagKey":                    testAccConfigRule_Scope_TagKey,
+			"scopeTagKeyEmpty":               testAccConfigRule_Scope_TagKey_Empty,
+			"scopeTagValue":                  testAccConfigRule_Scope_TagValue,
+			"tags":                           testAccConfigRule_tags,
+			acctest.CtDisappears:             testAccConfigRule_disappears,
 		},
 		"ConfigurationRecorderStatus": {
 			acctest.CtBasic:      testAccConfigurationRecorderStatus_basic,
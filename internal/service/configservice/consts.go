@@ -16,3 +16,133 @@ const (
 	defaultConfigurationRecorderName = "default"
 	defaultDeliveryChannelName       = "default"
 )
+
+// proactiveManagedRuleIdentifiers are the identifiers of the AWS managed rules that support proactive evaluation.
+// See https://docs.aws.amazon.com/config/latest/developerguide/managed-rules-by-evaluation-mode.html.
+// AWS adds proactive evaluation support to managed rules over time, so the list is kept up to date by hand and
+// unlisted rules are only logged.
+var proactiveManagedRuleIdentifiers = []string{
+	"API_GW_XRAY_ENABLED",
+	"AUTOSCALING_CAPACITY_REBALANCING",
+	"AUTOSCALING_LAUNCHCONFIG_REQUIRES_IMDSV2",
+	"AUTOSCALING_LAUNCH_CONFIG_HOP_LIMIT",
+	"AUTOSCALING_LAUNCH_CONFIG_PUBLIC_IP_DISABLED",
+	"AUTOSCALING_LAUNCH_TEMPLATE",
+	"AUTOSCALING_MULTIPLE_AZ",
+	"AUTOSCALING_MULTIPLE_INSTANCE_TYPES",
+	"CLOUDTRAIL_S3_DATAEVENTS_ENABLED",
+	"CLOUDTRAIL_SECURITY_TRAIL_ENABLED",
+	"CLOUDWATCH_ALARM_ACTION_CHECK",
+	"CLOUDWATCH_ALARM_ACTION_ENABLED_CHECK",
+	"CLOUDWATCH_ALARM_RESOURCE_CHECK",
+	"CLOUDWATCH_ALARM_SETTINGS_CHECK",
+	"CODEBUILD_PROJECT_ARTIFACT_ENCRYPTION",
+	"CODEBUILD_PROJECT_ENVIRONMENT_PRIVILEGED_CHECK",
+	"CODEBUILD_PROJECT_LOGGING_ENABLED",
+	"CODEBUILD_PROJECT_S3_LOGS_ENCRYPTED",
+	"CODEDEPLOY_AUTO_ROLLBACK_MONITOR_ENABLED",
+	"CODEDEPLOY_EC2_MINIMUM_HEALTHY_HOSTS_CONFIGURED",
+	"CODEDEPLOY_LAMBDA_ALLATONCE_TRAFFIC_SHIFT_DISABLED",
+	"DAX_ENCRYPTION_ENABLED",
+	"DMS_REPLICATION_NOT_PUBLIC",
+	"DYNAMODB_AUTOSCALING_ENABLED",
+	"DYNAMODB_PITR_ENABLED",
+	"DYNAMODB_TABLE_ENCRYPTED_KMS",
+	"EC2_EBS_ENCRYPTION_BY_DEFAULT",
+	"EC2_INSTANCE_DETAILED_MONITORING_ENABLED",
+	"EC2_INSTANCE_MULTIPLE_ENI_CHECK",
+	"EC2_INSTANCE_NO_PUBLIC_IP",
+	"EC2_NO_AMAZON_KEY_PAIR",
+	"EC2_TOKEN_HOP_LIMIT_CHECK",
+	"EC2_TRANSIT_GATEWAY_AUTO_VPC_ATTACH_DISABLED",
+	"ECR_PRIVATE_IMAGE_SCANNING_ENABLED",
+	"ECR_PRIVATE_LIFECYCLE_POLICY_CONFIGURED",
+	"ECR_PRIVATE_TAG_IMMUTABILITY_ENABLED",
+	"ECS_AWSVPC_NETWORKING_ENABLED",
+	"ECS_CONTAINERS_NONPRIVILEGED",
+	"ECS_CONTAINERS_READONLY_ACCESS",
+	"ECS_CONTAINER_INSIGHTS_ENABLED",
+	"ECS_FARGATE_LATEST_PLATFORM_VERSION",
+	"ECS_TASK_DEFINITION_LOG_CONFIGURATION",
+	"ECS_TASK_DEFINITION_MEMORY_HARD_LIMIT",
+	"ECS_TASK_DEFINITION_NONROOT_USER",
+	"ECS_TASK_DEFINITION_PID_MODE_CHECK",
+	"ECS_TASK_DEFINITION_USER_FOR_HOST_MODE_CHECK",
+	"EFS_ACCESS_POINT_ENFORCE_ROOT_DIRECTORY",
+	"EFS_ACCESS_POINT_ENFORCE_USER_IDENTITY",
+	"EFS_ENCRYPTED_CHECK",
+	"EIP_ATTACHED",
+	"EKS_CLUSTER_LOGGING_ENABLED",
+	"EKS_CLUSTER_LOG_ENABLED",
+	"EKS_CLUSTER_SECRETS_ENCRYPTED",
+	"EKS_ENDPOINT_NO_PUBLIC_ACCESS",
+	"EKS_SECRETS_ENCRYPTED",
+	"ELASTICACHE_AUTO_MINOR_VERSION_UPGRADE_CHECK",
+	"ELASTICACHE_REDIS_CLUSTER_AUTOMATIC_BACKUP_CHECK",
+	"ELASTICACHE_REPL_GRP_AUTO_FAILOVER_ENABLED",
+	"ELASTICACHE_REPL_GRP_ENCRYPTED_AT_REST",
+	"ELASTICACHE_REPL_GRP_ENCRYPTED_IN_TRANSIT",
+	"ELASTICACHE_REPL_GRP_REDIS_AUTH_ENABLED",
+	"ELASTICACHE_SUBNET_GROUP_CHECK",
+	"ELASTIC_BEANSTALK_LOGS_TO_CLOUDWATCH",
+	"ELASTIC_BEANSTALK_MANAGED_UPDATES_ENABLED",
+	"ELBV2_ACM_CERTIFICATE_REQUIRED",
+	"ELBV2_MULTIPLE_AZ",
+	"ELB_CROSS_ZONE_LOAD_BALANCING_ENABLED",
+	"ELB_DELETION_PROTECTION_ENABLED",
+	"ELB_LOGGING_ENABLED",
+	"EMR_MASTER_NO_PUBLIC_IP",
+	"LAMBDA_CONCURRENCY_CHECK",
+	"LAMBDA_DLQ_CHECK",
+	"LAMBDA_FUNCTION_PUBLIC_ACCESS_PROHIBITED",
+	"LAMBDA_FUNCTION_SETTINGS_CHECK",
+	"LAMBDA_INSIDE_VPC",
+	"LAMBDA_VPC_MULTI_AZ_CHECK",
+	"MQ_AUTOMATIC_MINOR_VERSION_UPGRADE_ENABLED",
+	"MQ_CLOUDWATCH_AUDIT_LOGGING_ENABLED",
+	"MQ_NO_PUBLIC_ACCESS",
+	"MSK_ENHANCED_MONITORING_ENABLED",
+	"MSK_IN_CLUSTER_NODE_REQUIRE_TLS",
+	"NEPTUNE_CLUSTER_BACKUP_RETENTION_CHECK",
+	"NEPTUNE_CLUSTER_CLOUDWATCH_LOG_EXPORT_ENABLED",
+	"NEPTUNE_CLUSTER_COPY_TAGS_TO_SNAPSHOT_ENABLED",
+	"NEPTUNE_CLUSTER_DELETION_PROTECTION_ENABLED",
+	"NEPTUNE_CLUSTER_ENCRYPTED",
+	"NEPTUNE_CLUSTER_IAM_DATABASE_AUTHENTICATION",
+	"NEPTUNE_CLUSTER_MULTI_AZ_ENABLED",
+	"NEPTUNE_CLUSTER_SNAPSHOT_ENCRYPTED",
+	"RDS_AUTOMATIC_MINOR_VERSION_UPGRADE_ENABLED",
+	"RDS_CLUSTER_DEFAULT_ADMIN_CHECK",
+	"RDS_CLUSTER_DELETION_PROTECTION_ENABLED",
+	"RDS_CLUSTER_ENCRYPTED_AT_REST",
+	"RDS_CLUSTER_IAM_AUTHENTICATION_ENABLED",
+	"RDS_CLUSTER_MULTI_AZ_ENABLED",
+	"RDS_DB_SECURITY_GROUP_NOT_ALLOWED",
+	"RDS_ENHANCED_MONITORING_ENABLED",
+	"RDS_INSTANCE_DEFAULT_ADMIN_CHECK",
+	"RDS_INSTANCE_DELETION_PROTECTION_ENABLED",
+	"RDS_INSTANCE_IAM_AUTHENTICATION_ENABLED",
+	"RDS_INSTANCE_PUBLIC_ACCESS_CHECK",
+	"RDS_LOGGING_ENABLED",
+	"RDS_MULTI_AZ_SUPPORT",
+	"RDS_STORAGE_ENCRYPTED",
+	"REDSHIFT_BACKUP_ENABLED",
+	"REDSHIFT_CLUSTER_CONFIGURATION_CHECK",
+	"REDSHIFT_CLUSTER_MAINTENANCESETTINGS_CHECK",
+	"REDSHIFT_CLUSTER_PUBLIC_ACCESS_CHECK",
+	"REDSHIFT_DEFAULT_ADMIN_CHECK",
+	"REDSHIFT_DEFAULT_DB_NAME_CHECK",
+	"REDSHIFT_ENHANCED_VPC_ROUTING_ENABLED",
+	"S3_BUCKET_LOGGING_ENABLED",
+	"S3_BUCKET_VERSIONING_ENABLED",
+	"S3_DEFAULT_ENCRYPTION_KMS",
+	"SAGEMAKER_ENDPOINT_CONFIGURATION_KMS_KEY_CONFIGURED",
+	"SAGEMAKER_NOTEBOOK_INSTANCE_INSIDE_VPC",
+	"SAGEMAKER_NOTEBOOK_INSTANCE_KMS_KEY_CONFIGURED",
+	"SAGEMAKER_NOTEBOOK_INSTANCE_ROOT_ACCESS_CHECK",
+	"SAGEMAKER_NOTEBOOK_NO_DIRECT_INTERNET_ACCESS",
+	"SECRETSMANAGER_ROTATION_ENABLED_CHECK",
+	"SNS_ENCRYPTED_KMS",
+	"STEP_FUNCTIONS_STATE_MACHINE_LOGGING_ENABLED",
+	"SUBNET_AUTO_ASSIGN_PUBLIC_IP_DISABLED",
+}
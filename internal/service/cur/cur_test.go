@@ -24,9 +24,13 @@ func TestAccCUR_serial(t *testing.T) {
 			"athena":                testAccReportDefinition_athena,
 			"refresh":               testAccReportDefinition_refresh,
 			"overwrite":             testAccReportDefinition_overwrite,
+			"manageS3BucketPolicy":  testAccReportDefinition_manageS3BucketPolicy,
 			"DataSource_basic":      testAccReportDefinitionDataSource_basic,
 			"DataSource_additional": testAccReportDefinitionDataSource_additional,
 		},
+		"ReportDefinitionDataExport": {
+			"DataSource_basic": testAccReportDefinitionDataExportDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 5*time.Second)
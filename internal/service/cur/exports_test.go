@@ -9,4 +9,6 @@ var (
 
 	CheckReportDefinitionPropertyCombination = checkReportDefinitionPropertyCombination
 	FindReportDefinitionByName               = findReportDefinitionByName
+	ReportDefinitionBucketPolicy             = reportDefinitionBucketPolicy
+	ReportDefinitionDataExportQuery          = reportDefinitionDataExportQuery
 )
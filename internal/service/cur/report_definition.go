@@ -5,15 +5,18 @@ package cur
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"slices"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	cur "github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -29,6 +34,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	billingReportsServicePrincipal                   = "billingreports.amazonaws.com"
+	bucketPolicyPropagationTimeout                   = 2 * time.Minute
+	errMessageFailedToVerifyBucketPermission         = "Failed to verify customer bucket permission"
+	reportDefinitionBucketPolicyACLStatementID       = "AllowBillingReportsGetBucketAclAndPolicy"
+	reportDefinitionBucketPolicyPutObjectStatementID = "AllowBillingReportsPutObject"
+)
+
 // @SDKResource("aws_cur_report_definition", name="Report Definition")
 // @Tags(identifierAttribute="report_name")
 func resourceReportDefinition() *schema.Resource {
@@ -63,6 +76,13 @@ func resourceReportDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"billing_view_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"compression": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -73,6 +93,10 @@ func resourceReportDefinition() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: enum.Validate[types.ReportFormat](),
 			},
+			"manage_s3_bucket_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"refresh_closed_reports": {
 				Type:     schema.TypeBool,
 				Default:  true,
@@ -87,6 +111,22 @@ func resourceReportDefinition() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z!\-_.*\'()]+`), "The name must be unique, is case sensitive, and can't include spaces."),
 				),
 			},
+			"report_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_delivery": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"report_versioning": {
 				Type:             schema.TypeString,
 				ForceNew:         true,
@@ -143,6 +183,12 @@ func resourceReportDefinitionCreate(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if d.Get("manage_s3_bucket_policy").(bool) {
+		if err := putReportDefinitionBucketPolicy(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	input := &cur.PutReportDefinitionInput{
 		ReportDefinition: &types.ReportDefinition{
 			AdditionalArtifacts:      additionalArtifacts,
@@ -160,7 +206,13 @@ func resourceReportDefinitionCreate(ctx context.Context, d *schema.ResourceData,
 		Tags: getTagsIn(ctx),
 	}
 
-	_, err := conn.PutReportDefinition(ctx, input)
+	if v, ok := d.GetOk("billing_view_arn"); ok {
+		input.ReportDefinition.BillingViewArn = aws.String(v.(string))
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, bucketPolicyPropagationTimeout, func() (interface{}, error) {
+		return conn.PutReportDefinition(ctx, input)
+	}, errMessageFailedToVerifyBucketPermission)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Cost And Usage Report Definition (%s): %s", reportName, err)
@@ -199,10 +251,14 @@ func resourceReportDefinitionRead(ctx context.Context, d *schema.ResourceData, m
 		Resource:  "definition/" + reportName,
 	}.String()
 	d.Set(names.AttrARN, arn)
+	d.Set("billing_view_arn", reportDefinition.BillingViewArn)
 	d.Set("compression", reportDefinition.Compression)
 	d.Set(names.AttrFormat, reportDefinition.Format)
 	d.Set("refresh_closed_reports", reportDefinition.RefreshClosedReports)
 	d.Set("report_name", reportName)
+	if err := d.Set("report_status", flattenReportStatus(reportDefinition.ReportStatus)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting report_status: %s", err)
+	}
 	d.Set("report_versioning", reportDefinition.ReportVersioning)
 	d.Set(names.AttrS3Bucket, reportDefinition.S3Bucket)
 	d.Set("s3_prefix", reportDefinition.S3Prefix)
@@ -216,7 +272,13 @@ func resourceReportDefinitionUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CURClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.Get("manage_s3_bucket_policy").(bool) && d.HasChanges("manage_s3_bucket_policy", names.AttrS3Bucket, "s3_region") {
+		if err := putReportDefinitionBucketPolicy(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "manage_s3_bucket_policy") {
 		additionalArtifacts := flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set))
 		compression := types.CompressionFormat(d.Get("compression").(string))
 		format := types.ReportFormat(d.Get(names.AttrFormat).(string))
@@ -250,7 +312,13 @@ func resourceReportDefinitionUpdate(ctx context.Context, d *schema.ResourceData,
 			ReportName: aws.String(d.Id()),
 		}
 
-		_, err := conn.ModifyReportDefinition(ctx, input)
+		if v, ok := d.GetOk("billing_view_arn"); ok {
+			input.ReportDefinition.BillingViewArn = aws.String(v.(string))
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, bucketPolicyPropagationTimeout, func() (interface{}, error) {
+			return conn.ModifyReportDefinition(ctx, input)
+		}, errMessageFailedToVerifyBucketPermission)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Cost And Usage Report Definition (%s): %s", d.Id(), err)
@@ -345,6 +413,122 @@ func checkReportDefinitionPropertyCombination(additionalArtifacts []types.Additi
 	return nil
 }
 
+// putReportDefinitionBucketPolicy adds the statements required for report delivery to the destination bucket's policy.
+// Any existing statements are preserved and the policy is only written if it changes.
+func putReportDefinitionBucketPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	awsClient := meta.(*conns.AWSClient)
+	conn := awsClient.S3Client(ctx)
+	bucket := d.Get(names.AttrS3Bucket).(string)
+	optFn := func(o *s3.Options) {
+		o.Region = d.Get("s3_region").(string)
+	}
+
+	existingPolicy, err := tfs3.FindBucketPolicy(ctx, conn, bucket, optFn)
+
+	switch {
+	case tfresource.NotFound(err):
+		existingPolicy = ""
+	case err != nil:
+		return fmt.Errorf("reading S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	policy, err := reportDefinitionBucketPolicy(existingPolicy, awsClient.Partition, awsClient.Region, awsClient.AccountID, bucket)
+
+	if err != nil {
+		return err
+	}
+
+	if existingPolicy != "" && verify.PolicyStringsEquivalent(existingPolicy, policy) {
+		return nil
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+
+	_, err = conn.PutBucketPolicy(ctx, input, optFn)
+
+	if err != nil {
+		return fmt.Errorf("putting S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// reportDefinitionBucketPolicy merges the statements allowing the billing reports service principal
+// to verify and deliver to the specified bucket into an existing bucket policy.
+func reportDefinitionBucketPolicy(existingPolicy, partition, region, accountID, bucket string) (string, error) {
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+	}
+
+	if existingPolicy != "" {
+		if err := json.Unmarshal([]byte(existingPolicy), doc); err != nil {
+			return "", fmt.Errorf("parsing S3 Bucket (%s) Policy: %w", bucket, err)
+		}
+	}
+
+	bucketARN := arn.ARN{
+		Partition: partition,
+		Service:   "s3",
+		Resource:  bucket,
+	}.String()
+	conditions := tfiam.IAMPolicyStatementConditionSet{
+		{
+			Test:     "StringEquals",
+			Variable: "aws:SourceArn",
+			Values: arn.ARN{
+				Partition: partition,
+				Service:   names.CUR,
+				Region:    region,
+				AccountID: accountID,
+				Resource:  "definition/*",
+			}.String(),
+		},
+		{
+			Test:     "StringEquals",
+			Variable: "aws:SourceAccount",
+			Values:   accountID,
+		},
+	}
+	principals := tfiam.IAMPolicyStatementPrincipalSet{
+		{
+			Type:        "Service",
+			Identifiers: billingReportsServicePrincipal,
+		},
+	}
+
+	doc.Merge(&tfiam.IAMPolicyDoc{
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Sid:        reportDefinitionBucketPolicyACLStatementID,
+				Effect:     "Allow",
+				Actions:    []string{"s3:GetBucketAcl", "s3:GetBucketPolicy"},
+				Resources:  bucketARN,
+				Principals: principals,
+				Conditions: conditions,
+			},
+			{
+				Sid:        reportDefinitionBucketPolicyPutObjectStatementID,
+				Effect:     "Allow",
+				Actions:    "s3:PutObject",
+				Resources:  bucketARN + "/*",
+				Principals: principals,
+				Conditions: conditions,
+			},
+		},
+	})
+
+	policy, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(policy), nil
+}
+
 func findReportDefinitionByName(ctx context.Context, conn *cur.Client, name string) (*types.ReportDefinition, error) {
 	input := &cur.DescribeReportDefinitionsInput{}
 
@@ -383,3 +567,16 @@ func findReportDefinitions(ctx context.Context, conn *cur.Client, input *cur.Des
 
 	return output, nil
 }
+
+func flattenReportStatus(apiObject *types.ReportStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"last_delivery": aws.ToString(apiObject.LastDelivery),
+		"last_status":   apiObject.LastStatus,
+	}
+
+	return []interface{}{tfMap}
+}
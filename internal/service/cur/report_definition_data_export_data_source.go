@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cur
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	bcmdataexportstypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	dataExportTableNameCostAndUsageReport = "COST_AND_USAGE_REPORT"
+)
+
+// @SDKDataSource("aws_cur_report_definition_data_export", name="Report Definition Data Export")
+func dataSourceReportDefinitionDataExport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReportDefinitionDataExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"compression": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrFormat: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"overwrite": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_statement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"report_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrS3Bucket: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_properties": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceReportDefinitionDataExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CURClient(ctx)
+
+	reportName := d.Get("report_name").(string)
+	reportDefinition, err := findReportDefinitionByName(ctx, conn, reportName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cost And Usage Report Definition (%s): %s", reportName, err)
+	}
+
+	// Data Exports only delivers GZIP compressed CSV or Parquet files.
+	compression, format := bcmdataexportstypes.CompressionOptionGzip, bcmdataexportstypes.FormatOptionTextOrCsv
+	switch reportDefinition.Format {
+	case types.ReportFormatParquet:
+		compression, format = bcmdataexportstypes.CompressionOptionParquet, bcmdataexportstypes.FormatOptionParquet
+	default:
+		if reportDefinition.Compression != types.CompressionFormatGzip {
+			diags = sdkdiag.AppendWarningf(diags, "Cost And Usage Report Definition (%s) compression %s is not supported by Data Exports, using %s", reportName, reportDefinition.Compression, compression)
+		}
+	}
+
+	if len(reportDefinition.AdditionalArtifacts) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "Cost And Usage Report Definition (%s) additional artifacts %v are not supported by Data Exports", reportName, reportDefinition.AdditionalArtifacts)
+	}
+
+	queryStatement, tableProperties := reportDefinitionDataExportQuery(reportDefinition)
+
+	d.SetId(aws.ToString(reportDefinition.ReportName))
+	d.Set("compression", compression)
+	d.Set(names.AttrFormat, format)
+	d.Set("overwrite", reportDefinition.ReportVersioning)
+	d.Set("query_statement", queryStatement)
+	d.Set("report_name", reportDefinition.ReportName)
+	d.Set(names.AttrS3Bucket, reportDefinition.S3Bucket)
+	d.Set("s3_prefix", reportDefinition.S3Prefix)
+	d.Set("s3_region", reportDefinition.S3Region)
+	d.Set(names.AttrTableName, dataExportTableNameCostAndUsageReport)
+	d.Set("table_properties", tableProperties)
+
+	return diags
+}
+
+// reportDefinitionDataExportQuery returns the Data Exports (CUR 2.0) query statement and table properties
+// that produce the same content as the specified legacy report definition.
+func reportDefinitionDataExportQuery(apiObject *types.ReportDefinition) (string, map[string]string) {
+	includeResources := slices.Contains(apiObject.AdditionalSchemaElements, types.SchemaElementResources)
+	includeSplitCostAllocationData := slices.Contains(apiObject.AdditionalSchemaElements, types.SchemaElementSplitCostAllocationData)
+	includeManualDiscountCompatibility := slices.Contains(apiObject.AdditionalSchemaElements, types.SchemaElementManualDiscountCompatibility)
+
+	columns := slices.Clone(dataExportCostAndUsageReportColumns)
+	if includeResources {
+		columns = append(columns, "line_item_resource_id")
+	}
+	if includeSplitCostAllocationData {
+		columns = append(columns, dataExportCostAndUsageReportSplitCostAllocationDataColumns...)
+	}
+	slices.Sort(columns)
+
+	tableProperties := map[string]string{
+		"INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY": strings.ToUpper(fmt.Sprint(includeManualDiscountCompatibility)),
+		"INCLUDE_RESOURCES":                     strings.ToUpper(fmt.Sprint(includeResources)),
+		"INCLUDE_SPLIT_COST_ALLOCATION_DATA":    strings.ToUpper(fmt.Sprint(includeSplitCostAllocationData)),
+		"TIME_GRANULARITY":                      string(apiObject.TimeUnit),
+	}
+
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), dataExportTableNameCostAndUsageReport), tableProperties
+}
+
+// dataExportCostAndUsageReportColumns are the CUR 2.0 columns available regardless of table properties.
+var dataExportCostAndUsageReportColumns = []string{
+	"bill_bill_type",
+	"bill_billing_entity",
+	"bill_billing_period_end_date",
+	"bill_billing_period_start_date",
+	"bill_invoice_id",
+	"bill_invoicing_entity",
+	"bill_payer_account_id",
+	"bill_payer_account_name",
+	"cost_category",
+	"discount",
+	"discount_bundled_discount",
+	"discount_total_discount",
+	"identity_line_item_id",
+	"identity_time_interval",
+	"line_item_availability_zone",
+	"line_item_blended_cost",
+	"line_item_blended_rate",
+	"line_item_currency_code",
+	"line_item_legal_entity",
+	"line_item_line_item_description",
+	"line_item_line_item_type",
+	"line_item_net_unblended_cost",
+	"line_item_net_unblended_rate",
+	"line_item_normalization_factor",
+	"line_item_normalized_usage_amount",
+	"line_item_operation",
+	"line_item_product_code",
+	"line_item_tax_type",
+	"line_item_unblended_cost",
+	"line_item_unblended_rate",
+	"line_item_usage_account_id",
+	"line_item_usage_account_name",
+	"line_item_usage_amount",
+	"line_item_usage_end_date",
+	"line_item_usage_start_date",
+	"line_item_usage_type",
+	"pricing_currency",
+	"pricing_lease_contract_length",
+	"pricing_offering_class",
+	"pricing_public_on_demand_cost",
+	"pricing_public_on_demand_rate",
+	"pricing_purchase_option",
+	"pricing_rate_code",
+	"pricing_rate_id",
+	"pricing_term",
+	"pricing_unit",
+	"product",
+	"product_comment",
+	"product_fee_code",
+	"product_fee_description",
+	"product_from_location",
+	"product_from_location_type",
+	"product_from_region_code",
+	"product_instance_family",
+	"product_instance_type",
+	"product_instancesku",
+	"product_location",
+	"product_location_type",
+	"product_operation",
+	"product_pricing_unit",
+	"product_product_family",
+	"product_region_code",
+	"product_servicecode",
+	"product_sku",
+	"product_to_location",
+	"product_to_location_type",
+	"product_to_region_code",
+	"product_usagetype",
+	"reservation_amortized_upfront_cost_for_usage",
+	"reservation_amortized_upfront_fee_for_billing_period",
+	"reservation_availability_zone",
+	"reservation_effective_cost",
+	"reservation_end_time",
+	"reservation_modification_status",
+	"reservation_net_amortized_upfront_cost_for_usage",
+	"reservation_net_amortized_upfront_fee_for_billing_period",
+	"reservation_net_effective_cost",
+	"reservation_net_recurring_fee_for_usage",
+	"reservation_net_unused_amortized_upfront_fee_for_billing_period",
+	"reservation_net_unused_recurring_fee",
+	"reservation_net_upfront_value",
+	"reservation_normalized_units_per_reservation",
+	"reservation_number_of_reservations",
+	"reservation_recurring_fee_for_usage",
+	"reservation_reservation_a_r_n",
+	"reservation_start_time",
+	"reservation_subscription_id",
+	"reservation_total_reserved_normalized_units",
+	"reservation_total_reserved_units",
+	"reservation_units_per_reservation",
+	"reservation_unused_amortized_upfront_fee_for_billing_period",
+	"reservation_unused_normalized_unit_quantity",
+	"reservation_unused_quantity",
+	"reservation_unused_recurring_fee",
+	"reservation_upfront_value",
+	"resource_tags",
+	"savings_plan_amortized_upfront_commitment_for_billing_period",
+	"savings_plan_end_time",
+	"savings_plan_instance_type_family",
+	"savings_plan_net_amortized_upfront_commitment_for_billing_period",
+	"savings_plan_net_recurring_commitment_for_billing_period",
+	"savings_plan_net_savings_plan_effective_cost",
+	"savings_plan_offering_type",
+	"savings_plan_payment_option",
+	"savings_plan_purchase_term",
+	"savings_plan_recurring_commitment_for_billing_period",
+	"savings_plan_region",
+	"savings_plan_savings_plan_a_r_n",
+	"savings_plan_savings_plan_effective_cost",
+	"savings_plan_savings_plan_rate",
+	"savings_plan_start_time",
+	"savings_plan_total_commitment_to_date",
+	"savings_plan_used_commitment",
+}
+
+// dataExportCostAndUsageReportSplitCostAllocationDataColumns are the CUR 2.0 columns available when INCLUDE_SPLIT_COST_ALLOCATION_DATA is TRUE.
+var dataExportCostAndUsageReportSplitCostAllocationDataColumns = []string{
+	"split_line_item_actual_usage",
+	"split_line_item_net_split_cost",
+	"split_line_item_net_unused_cost",
+	"split_line_item_parent_resource_id",
+	"split_line_item_public_on_demand_split_cost",
+	"split_line_item_public_on_demand_unused_cost",
+	"split_line_item_reserved_usage",
+	"split_line_item_split_cost",
+	"split_line_item_split_usage",
+	"split_line_item_split_usage_ratio",
+	"split_line_item_unused_cost",
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cur_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcur "github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestReportDefinitionDataExportQuery(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject               *types.ReportDefinition
+		expectedTableProperties map[string]string
+		expectedColumns         []string
+		unexpectedColumns       []string
+	}{
+		"no additional schema elements": {
+			apiObject: &types.ReportDefinition{
+				ReportName: aws.String("test"),
+				TimeUnit:   types.TimeUnitHourly,
+			},
+			expectedTableProperties: map[string]string{
+				"INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY": "FALSE",
+				"INCLUDE_RESOURCES":                     "FALSE",
+				"INCLUDE_SPLIT_COST_ALLOCATION_DATA":    "FALSE",
+				"TIME_GRANULARITY":                      "HOURLY",
+			},
+			expectedColumns:   []string{"identity_line_item_id", "line_item_unblended_cost"},
+			unexpectedColumns: []string{"line_item_resource_id", "split_line_item_split_cost"},
+		},
+		"all additional schema elements": {
+			apiObject: &types.ReportDefinition{
+				AdditionalSchemaElements: []types.SchemaElement{
+					types.SchemaElementManualDiscountCompatibility,
+					types.SchemaElementResources,
+					types.SchemaElementSplitCostAllocationData,
+				},
+				ReportName: aws.String("test"),
+				TimeUnit:   types.TimeUnitDaily,
+			},
+			expectedTableProperties: map[string]string{
+				"INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY": "TRUE",
+				"INCLUDE_RESOURCES":                     "TRUE",
+				"INCLUDE_SPLIT_COST_ALLOCATION_DATA":    "TRUE",
+				"TIME_GRANULARITY":                      "DAILY",
+			},
+			expectedColumns: []string{"identity_line_item_id", "line_item_resource_id", "split_line_item_split_cost"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			queryStatement, tableProperties := tfcur.ReportDefinitionDataExportQuery(testCase.apiObject)
+
+			if diff := cmp.Diff(tableProperties, testCase.expectedTableProperties); diff != "" {
+				t.Errorf("unexpected table properties diff (+wanted, -got): %s", diff)
+			}
+
+			if !strings.HasPrefix(queryStatement, "SELECT ") || !strings.HasSuffix(queryStatement, " FROM COST_AND_USAGE_REPORT") {
+				t.Errorf("unexpected query statement: %s", queryStatement)
+			}
+
+			columns := strings.Split(strings.TrimSuffix(strings.TrimPrefix(queryStatement, "SELECT "), " FROM COST_AND_USAGE_REPORT"), ", ")
+			for _, v := range testCase.expectedColumns {
+				if !slices.Contains(columns, v) {
+					t.Errorf("expected column %s in query statement: %s", v, queryStatement)
+				}
+			}
+			for _, v := range testCase.unexpectedColumns {
+				if slices.Contains(columns, v) {
+					t.Errorf("unexpected column %s in query statement: %s", v, queryStatement)
+				}
+			}
+		})
+	}
+}
+
+func testAccReportDefinitionDataExportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cur_report_definition.test"
+	dataSourceName := "data.aws_cur_report_definition_data_export.test"
+	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CURServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDefinitionDataExportDataSourceConfig_basic(reportName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compression", "GZIP"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrFormat, "TEXT_OR_CSV"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "overwrite", resourceName, "report_versioning"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_statement"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "report_name", resourceName, "report_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrS3Bucket, resourceName, names.AttrS3Bucket),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_prefix", resourceName, "s3_prefix"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_region", resourceName, "s3_region"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrTableName, "COST_AND_USAGE_REPORT"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_properties.%", acctest.Ct4),
+					resource.TestCheckResourceAttr(dataSourceName, "table_properties.INCLUDE_RESOURCES", "TRUE"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_properties.INCLUDE_SPLIT_COST_ALLOCATION_DATA", "TRUE"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_properties.TIME_GRANULARITY", "DAILY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReportDefinitionDataExportDataSourceConfig_basic(reportName, bucketName string) string {
+	return acctest.ConfigCompose(testAccReportDefinitionConfig_manageS3BucketPolicy(reportName, bucketName), `
+data "aws_cur_report_definition_data_export" "test" {
+  report_name = aws_cur_report_definition.test.report_name
+}
+`)
+}
@@ -32,6 +32,10 @@ func dataSourceReportDefinition() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"billing_view_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"compression": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -48,6 +52,22 @@ func dataSourceReportDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"report_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_delivery": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"report_versioning": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,10 +107,14 @@ func dataSourceReportDefinitionRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(aws.ToString(reportDefinition.ReportName))
 	d.Set("additional_artifacts", reportDefinition.AdditionalArtifacts)
 	d.Set("additional_schema_elements", reportDefinition.AdditionalSchemaElements)
+	d.Set("billing_view_arn", reportDefinition.BillingViewArn)
 	d.Set("compression", reportDefinition.Compression)
 	d.Set(names.AttrFormat, reportDefinition.Format)
 	d.Set("refresh_closed_reports", reportDefinition.RefreshClosedReports)
 	d.Set("report_name", reportDefinition.ReportName)
+	if err := d.Set("report_status", flattenReportStatus(reportDefinition.ReportStatus)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting report_status: %s", err)
+	}
 	d.Set("report_versioning", reportDefinition.ReportVersioning)
 	d.Set(names.AttrS3Bucket, reportDefinition.S3Bucket)
 	d.Set("s3_prefix", reportDefinition.S3Prefix)
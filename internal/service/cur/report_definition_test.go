@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -372,6 +373,38 @@ func testAccReportDefinition_disappears(t *testing.T) {
 	})
 }
 
+func testAccReportDefinition_manageS3BucketPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cur_report_definition.test"
+	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CURServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDefinitionConfig_manageS3BucketPolicy(reportName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReportDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manage_s3_bucket_policy", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "report_name", reportName),
+					resource.TestCheckResourceAttr(resourceName, "report_status.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrS3Bucket, bucketName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_s3_bucket_policy"},
+			},
+		},
+	})
+}
+
 func testAccCheckReportDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CURClient(ctx)
@@ -471,6 +504,27 @@ resource "aws_cur_report_definition" "test" {
 `, reportName, bucketName, prefix)
 }
 
+func testAccReportDefinitionConfig_manageS3BucketPolicy(reportName, bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[2]q
+  force_destroy = true
+}
+
+resource "aws_cur_report_definition" "test" {
+  report_name                = %[1]q
+  time_unit                  = "DAILY"
+  format                     = "textORcsv"
+  compression                = "GZIP"
+  additional_schema_elements = ["RESOURCES", "SPLIT_COST_ALLOCATION_DATA"]
+  s3_bucket                  = aws_s3_bucket.test.id
+  s3_prefix                  = ""
+  s3_region                  = aws_s3_bucket.test.region
+  manage_s3_bucket_policy    = true
+}
+`, reportName, bucketName)
+}
+
 func testAccReportDefinitionConfig_additional(reportName string, bucketName string, bucketPrefix string, format string, compression string, additionalArtifacts []string, refreshClosedReports bool, reportVersioning string) string {
 	artifactsStr := strings.Join(additionalArtifacts, "\", \"")
 
@@ -884,3 +938,103 @@ func TestCheckDefinitionPropertyCombination(t *testing.T) {
 		})
 	}
 }
+
+func TestReportDefinitionBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		existingPolicy string
+		expected       string
+	}{
+		"no existing policy": {
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowBillingReportsGetBucketAclAndPolicy",
+      "Effect": "Allow",
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy"],
+      "Resource": "arn:aws:s3:::test-bucket",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Condition": {"StringEquals": {"aws:SourceArn": "arn:aws:cur:us-east-1:123456789012:definition/*", "aws:SourceAccount": "123456789012"}}
+    },
+    {
+      "Sid": "AllowBillingReportsPutObject",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::test-bucket/*",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Condition": {"StringEquals": {"aws:SourceArn": "arn:aws:cur:us-east-1:123456789012:definition/*", "aws:SourceAccount": "123456789012"}}
+    }
+  ]
+}`,
+		},
+		"existing statements preserved": {
+			existingPolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Other",
+      "Effect": "Deny",
+      "Action": "s3:DeleteBucket",
+      "Resource": "arn:aws:s3:::test-bucket",
+      "Principal": "*"
+    },
+    {
+      "Sid": "AllowBillingReportsPutObject",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::old-bucket/*",
+      "Principal": {"Service": "billingreports.amazonaws.com"}
+    }
+  ]
+}`,
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Other",
+      "Effect": "Deny",
+      "Action": "s3:DeleteBucket",
+      "Resource": "arn:aws:s3:::test-bucket",
+      "Principal": "*"
+    },
+    {
+      "Sid": "AllowBillingReportsPutObject",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::test-bucket/*",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Condition": {"StringEquals": {"aws:SourceArn": "arn:aws:cur:us-east-1:123456789012:definition/*", "aws:SourceAccount": "123456789012"}}
+    },
+    {
+      "Sid": "AllowBillingReportsGetBucketAclAndPolicy",
+      "Effect": "Allow",
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy"],
+      "Resource": "arn:aws:s3:::test-bucket",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Condition": {"StringEquals": {"aws:SourceArn": "arn:aws:cur:us-east-1:123456789012:definition/*", "aws:SourceAccount": "123456789012"}}
+    }
+  ]
+}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcur.ReportDefinitionBucketPolicy(testCase.existingPolicy, "aws", "us-east-1", "123456789012", "test-bucket")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(got, testCase.expected); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			} else if !equivalent {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
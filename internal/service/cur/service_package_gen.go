@@ -30,6 +30,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 				IdentifierAttribute: "report_name",
 			},
 		},
+		{
+			Factory:  dataSourceReportDefinitionDataExport,
+			TypeName: "aws_cur_report_definition_data_export",
+			Name:     "Report Definition Data Export",
+		},
 	}
 }
 
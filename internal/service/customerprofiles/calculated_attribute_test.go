@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesCalculatedAttribute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_calculated_attribute.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeConfig_basic(rName, "Average hold time", 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.attribute.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "calculated_attribute_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Average hold time"),
+					resource.TestCheckResourceAttr(resourceName, "statistic", "AVERAGE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCalculatedAttributeConfig_basic(rName, "Average hold time (30 days)", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Average hold time (30 days)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesCalculatedAttribute_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_calculated_attribute.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeConfig_basic(rName, "Average hold time", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, customerprofiles.ResourceCalculatedAttribute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCalculatedAttributeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+		_, err = customerprofiles.FindCalculatedAttributeByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckCalculatedAttributeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_calculated_attribute" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = customerprofiles.FindCalculatedAttributeByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Customer Profiles Calculated Attribute %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCalculatedAttributeConfig_basic(rName, displayName string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_calculated_attribute" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  display_name              = %[2]q
+  statistic                 = "AVERAGE"
+
+  attribute_details {
+    attribute {
+      name = "_ctr.AgentInfo.HoldDuration"
+    }
+
+    expression = "{_ctr.AgentInfo.HoldDuration}"
+  }
+
+  conditions {
+    range {
+      unit  = "DAYS"
+      value = %[3]d
+    }
+  }
+}
+`, rName, displayName, days)
+}
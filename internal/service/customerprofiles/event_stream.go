@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_event_stream")
+// @Tags(identifierAttribute="arn")
+func ResourceEventStream() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventStreamCreate,
+		ReadWithoutTimeout:   resourceEventStreamRead,
+		UpdateWithoutTimeout: resourceEventStreamUpdate,
+		DeleteWithoutTimeout: resourceEventStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unhealthy_since": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrURI: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_stream_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrURI: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	eventStreamResourceIDPartCount = 2
+)
+
+func resourceEventStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	name := d.Get("event_stream_name").(string)
+	id, err := flex.FlattenResourceId([]string{domainName, name}, eventStreamResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &customerprofiles.CreateEventStreamInput{
+		DomainName:      aws.String(domainName),
+		EventStreamName: aws.String(name),
+		Tags:            getTagsIn(ctx),
+		Uri:             aws.String(d.Get(names.AttrURI).(string)),
+	}
+
+	_, err = conn.CreateEventStream(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Customer Profiles Event Stream (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceEventStreamRead(ctx, d, meta)...)
+}
+
+func resourceEventStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), eventStreamResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+	output, err := FindEventStreamByTwoPartKey(ctx, conn, domainName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Event Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Customer Profiles Event Stream (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.EventStreamArn)
+	if err := d.Set("destination_details", flattenEventStreamDestinationDetails(output.DestinationDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination_details: %s", err)
+	}
+	d.Set(names.AttrDomainName, output.DomainName)
+	d.Set("event_stream_name", name)
+	d.Set(names.AttrState, output.State)
+	if output.DestinationDetails != nil {
+		d.Set(names.AttrURI, output.DestinationDetails.Uri)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceEventStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceEventStreamRead(ctx, d, meta)...)
+}
+
+func resourceEventStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), eventStreamResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Customer Profiles Event Stream: %s", d.Id())
+	_, err = conn.DeleteEventStream(ctx, &customerprofiles.DeleteEventStreamInput{
+		DomainName:      aws.String(parts[0]),
+		EventStreamName: aws.String(parts[1]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Customer Profiles Event Stream (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindEventStreamByTwoPartKey(ctx context.Context, conn *customerprofiles.Client, domainName, name string) (*customerprofiles.GetEventStreamOutput, error) {
+	input := &customerprofiles.GetEventStreamInput{
+		DomainName:      aws.String(domainName),
+		EventStreamName: aws.String(name),
+	}
+
+	output, err := conn.GetEventStream(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenEventStreamDestinationDetails(apiObject *types.EventStreamDestinationDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrMessage: aws.ToString(apiObject.Message),
+		names.AttrStatus:  string(apiObject.Status),
+		names.AttrURI:     aws.ToString(apiObject.Uri),
+	}
+
+	if v := apiObject.UnhealthySince; v != nil {
+		tfMap["unhealthy_since"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -224,6 +225,13 @@ func resourceTask() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.TaskMode](),
+			},
 			"task_report_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -302,7 +310,10 @@ func resourceTask() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTaskTaskModeCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -333,6 +344,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("task_mode"); ok {
+		input.TaskMode = awstypes.TaskMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("task_report_config"); ok {
 		input.TaskReportConfig = expandTaskReportConfig(v.([]interface{}))
 	}
@@ -388,6 +403,7 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set(names.AttrSchedule, flattenTaskSchedule(output.Schedule)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
 	}
+	d.Set("task_mode", output.TaskMode)
 	if err := d.Set("task_report_config", flattenTaskReportConfig(output.TaskReportConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting task_report_config: %s", err)
 	}
@@ -461,6 +477,27 @@ func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+func resourceTaskTaskModeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if awstypes.TaskMode(d.Get("task_mode").(string)) != awstypes.TaskModeEnhanced {
+		return nil
+	}
+
+	// Enhanced mode tasks don't support every option available to Basic mode tasks.
+	if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v := tfMap["bytes_per_second"].(int); v != -1 {
+			return fmt.Errorf("options.bytes_per_second must be -1 when task_mode is %s, got: %d", awstypes.TaskModeEnhanced, v)
+		}
+
+		if v := awstypes.VerifyMode(tfMap["verify_mode"].(string)); v == awstypes.VerifyModePointInTimeConsistent {
+			return fmt.Errorf("options.verify_mode must not be %s when task_mode is %s", v, awstypes.TaskModeEnhanced)
+		}
+	}
+
+	return nil
+}
+
 func findTaskByARN(ctx context.Context, conn *datasync.Client, arn string) (*datasync.DescribeTaskOutput, error) {
 	input := &datasync.DescribeTaskInput{
 		TaskArn: aws.String(arn),
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_entity_owner", name="Entity Owner")
+func newResourceEntityOwner(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceEntityOwner{}, nil
+}
+
+const (
+	ResNameEntityOwner = "Entity Owner"
+
+	entityOwnerIDParts = 5
+
+	entityOwnerTypeGroup = "GROUP"
+	entityOwnerTypeUser  = "USER"
+)
+
+func entityOwnerType_Values() []string {
+	return []string{
+		entityOwnerTypeGroup,
+		entityOwnerTypeUser,
+	}
+}
+
+type resourceEntityOwner struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceEntityOwner) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_entity_owner"
+}
+
+func (r *resourceEntityOwner) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataZoneEntityType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"owner_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(entityOwnerType_Values()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEntityOwner) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan entityOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{
+		plan.DomainIdentifier.ValueString(),
+		plan.EntityType.ValueString(),
+		plan.EntityIdentifier.ValueString(),
+		plan.OwnerType.ValueString(),
+		plan.OwnerIdentifier.ValueString(),
+	}, entityOwnerIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEntityOwner, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &datazone.AddEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: plan.EntityIdentifier.ValueStringPointer(),
+		EntityType:       plan.EntityType.ValueEnum(),
+		Owner:            expandEntityOwnerProperties(plan.OwnerType.ValueString(), plan.OwnerIdentifier.ValueString()),
+	}
+
+	_, err = conn.AddEntityOwner(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEntityOwner, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEntityOwner) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := findEntityOwnerByFivePartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.EntityType.ValueEnum(), state.EntityIdentifier.ValueString(), state.OwnerType.ValueString(), state.OwnerIdentifier.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEntityOwner) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.RemoveEntityOwner(ctx, &datazone.RemoveEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: state.EntityIdentifier.ValueStringPointer(),
+		EntityType:       state.EntityType.ValueEnum(),
+		Owner:            expandEntityOwnerProperties(state.OwnerType.ValueString(), state.OwnerIdentifier.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEntityOwner) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, entityOwnerIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: domain_identifier,entity_type,entity_identifier,owner_type,owner_identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_identifier"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_type"), parts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_identifier"), parts[4])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), req.ID)...)
+}
+
+func findEntityOwnerByFivePartKey(ctx context.Context, conn *datazone.Client, domainID string, entityType awstypes.DataZoneEntityType, entityID, ownerType, ownerID string) (awstypes.OwnerPropertiesOutput, error) {
+	// Owners are returned by their DataZone identifiers, so resolve any IAM principal ARN first.
+	if ownerType == entityOwnerTypeUser && arn.IsARN(ownerID) {
+		out, err := conn.GetUserProfile(ctx, &datazone.GetUserProfileInput{
+			DomainIdentifier: aws.String(domainID),
+			UserIdentifier:   aws.String(ownerID),
+		})
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		ownerID = aws.ToString(out.Id)
+	}
+
+	in := &datazone.ListEntityOwnersInput{
+		DomainIdentifier: aws.String(domainID),
+		EntityIdentifier: aws.String(entityID),
+		EntityType:       entityType,
+	}
+
+	pages := datazone.NewListEntityOwnersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Owners {
+			switch v := v.(type) {
+			case *awstypes.OwnerPropertiesOutputMemberGroup:
+				if ownerType == entityOwnerTypeGroup && aws.ToString(v.Value.GroupId) == ownerID {
+					return v, nil
+				}
+			case *awstypes.OwnerPropertiesOutputMemberUser:
+				if ownerType == entityOwnerTypeUser && aws.ToString(v.Value.UserId) == ownerID {
+					return v, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func expandEntityOwnerProperties(ownerType, ownerID string) awstypes.OwnerProperties {
+	if ownerType == entityOwnerTypeGroup {
+		return &awstypes.OwnerPropertiesMemberGroup{
+			Value: awstypes.OwnerGroupProperties{
+				GroupIdentifier: aws.String(ownerID),
+			},
+		}
+	}
+
+	return &awstypes.OwnerPropertiesMemberUser{
+		Value: awstypes.OwnerUserProperties{
+			UserIdentifier: aws.String(ownerID),
+		},
+	}
+}
+
+type entityOwnerResourceModel struct {
+	DomainIdentifier types.String                                    `tfsdk:"domain_identifier"`
+	EntityIdentifier types.String                                    `tfsdk:"entity_identifier"`
+	EntityType       fwtypes.StringEnum[awstypes.DataZoneEntityType] `tfsdk:"entity_type"`
+	ID               types.String                                    `tfsdk:"id"`
+	OwnerIdentifier  types.String                                    `tfsdk:"owner_identifier"`
+	OwnerType        types.String                                    `tfsdk:"owner_type"`
+}
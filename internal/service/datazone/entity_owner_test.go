@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEntityOwner_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_identifier", "aws_datazone_domain_unit.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entity_type", string(awstypes.DataZoneEntityTypeDomainUnit)),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_identifier", "aws_iam_user.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "owner_type", "USER"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEntityOwner_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEntityOwner, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntityOwnerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_entity_owner" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEntityOwnerByFivePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], awstypes.DataZoneEntityType(rs.Primary.Attributes["entity_type"]), rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["owner_type"], rs.Primary.Attributes["owner_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEntityOwnerExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		_, err := tfdatazone.FindEntityOwnerByFivePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], awstypes.DataZoneEntityType(rs.Primary.Attributes["entity_type"]), rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["owner_type"], rs.Primary.Attributes["owner_identifier"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntityOwnerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainUnitConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_datazone_entity_owner" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  entity_identifier = aws_datazone_domain_unit.test.id
+  entity_type       = "DOMAIN_UNIT"
+  owner_identifier  = aws_iam_user.test.arn
+  owner_type        = "USER"
+}
+`, rName))
+}
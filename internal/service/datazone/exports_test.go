@@ -7,20 +7,26 @@ package datazone
 var (
 	ResourceAssetType                         = newResourceAssetType
 	ResourceDomain                            = newResourceDomain
+	ResourceDomainUnit                        = newResourceDomainUnit
+	ResourceEntityOwner                       = newResourceEntityOwner
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
 	ResourceEnvironment                       = newResourceEnvironment
 	ResourceEnvironmentProfile                = newResourceEnvironmentProfile
 	ResourceFormType                          = newResourceFormType
 	ResourceGlossary                          = newResourceGlossary
 	ResourceGlossaryTerm                      = newResourceGlossaryTerm
+	ResourcePolicyGrant                       = newResourcePolicyGrant
 	ResourceProject                           = newResourceProject
 
-	FindAssetTypeByID          = findAssetTypeByID
-	FindEnvironmentByID        = findEnvironmentByID
-	FindEnvironmentProfileByID = findEnvironmentProfileByID
-	FindFormTypeByID           = findFormTypeByID
-	FindGlossaryByID           = findGlossaryByID
-	FindGlossaryTermByID       = findGlossaryTermByID
+	FindAssetTypeByID            = findAssetTypeByID
+	FindDomainUnitByID           = findDomainUnitByID
+	FindEntityOwnerByFivePartKey = findEntityOwnerByFivePartKey
+	FindEnvironmentByID          = findEnvironmentByID
+	FindEnvironmentProfileByID   = findEnvironmentProfileByID
+	FindFormTypeByID             = findFormTypeByID
+	FindGlossaryByID             = findGlossaryByID
+	FindGlossaryTermByID         = findGlossaryTermByID
+	FindPolicyGrantBySixPartKey  = findPolicyGrantBySixPartKey
 
 	IsResourceMissing = isResourceMissing
 )
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_policy_grant", name="Policy Grant")
+func newResourcePolicyGrant(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicyGrant{}, nil
+}
+
+const (
+	ResNamePolicyGrant = "Policy Grant"
+
+	policyGrantIDParts = 6
+
+	policyGrantPrincipalTypeGroup = "GROUP"
+	policyGrantPrincipalTypeUser  = "USER"
+)
+
+func policyGrantPrincipalType_Values() []string {
+	return []string{
+		policyGrantPrincipalTypeGroup,
+		policyGrantPrincipalTypeUser,
+	}
+}
+
+type resourcePolicyGrant struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourcePolicyGrant) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_policy_grant"
+}
+
+func (r *resourcePolicyGrant) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetEntityType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_child_domain_units": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ManagedPolicyType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyGrantPrincipalType_Values()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicyGrant) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan policyGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{
+		plan.DomainIdentifier.ValueString(),
+		plan.EntityType.ValueString(),
+		plan.EntityIdentifier.ValueString(),
+		plan.PolicyType.ValueString(),
+		plan.PrincipalType.ValueString(),
+		plan.PrincipalIdentifier.ValueString(),
+	}, policyGrantIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNamePolicyGrant, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &datazone.AddPolicyGrantInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Detail:           expandPolicyGrantDetail(plan.PolicyType.ValueEnum(), plan.IncludeChildDomainUnits.ValueBoolPointer()),
+		DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: plan.EntityIdentifier.ValueStringPointer(),
+		EntityType:       plan.EntityType.ValueEnum(),
+		PolicyType:       plan.PolicyType.ValueEnum(),
+		Principal:        expandPolicyGrantPrincipal(plan.PrincipalType.ValueString(), plan.PrincipalIdentifier.ValueString()),
+	}
+
+	_, err = conn.AddPolicyGrant(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNamePolicyGrant, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	if plan.IncludeChildDomainUnits.IsUnknown() {
+		plan.IncludeChildDomainUnits = types.BoolNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePolicyGrant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state policyGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPolicyGrantBySixPartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.EntityType.ValueEnum(), state.EntityIdentifier.ValueString(), state.PolicyType.ValueEnum(), state.PrincipalType.ValueString(), state.PrincipalIdentifier.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, ResNamePolicyGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.IncludeChildDomainUnits = types.BoolPointerValue(flattenPolicyGrantDetailIncludeChildDomainUnits(out.Detail))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicyGrant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state policyGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.RemovePolicyGrant(ctx, &datazone.RemovePolicyGrantInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: state.EntityIdentifier.ValueStringPointer(),
+		EntityType:       state.EntityType.ValueEnum(),
+		PolicyType:       state.PolicyType.ValueEnum(),
+		Principal:        expandPolicyGrantPrincipal(state.PrincipalType.ValueString(), state.PrincipalIdentifier.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNamePolicyGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourcePolicyGrant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, policyGrantIDParts, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: domain_identifier,entity_type,entity_identifier,policy_type,principal_type,principal_identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_identifier"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_type"), parts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), parts[4])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_identifier"), parts[5])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), req.ID)...)
+}
+
+func findPolicyGrantBySixPartKey(ctx context.Context, conn *datazone.Client, domainID string, entityType awstypes.TargetEntityType, entityID string, policyType awstypes.ManagedPolicyType, principalType, principalID string) (*awstypes.PolicyGrantMember, error) {
+	// Principals are returned by their DataZone identifiers, so resolve any IAM principal ARN first.
+	if principalType == policyGrantPrincipalTypeUser && arn.IsARN(principalID) {
+		out, err := conn.GetUserProfile(ctx, &datazone.GetUserProfileInput{
+			DomainIdentifier: aws.String(domainID),
+			UserIdentifier:   aws.String(principalID),
+		})
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		principalID = aws.ToString(out.Id)
+	}
+
+	in := &datazone.ListPolicyGrantsInput{
+		DomainIdentifier: aws.String(domainID),
+		EntityIdentifier: aws.String(entityID),
+		EntityType:       entityType,
+		PolicyType:       policyType,
+	}
+
+	pages := datazone.NewListPolicyGrantsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, grant := range page.GrantList {
+			switch principal := grant.Principal.(type) {
+			case *awstypes.PolicyGrantPrincipalMemberGroup:
+				if v, ok := principal.Value.(*awstypes.GroupPolicyGrantPrincipalMemberGroupIdentifier); ok && principalType == policyGrantPrincipalTypeGroup && v.Value == principalID {
+					return &grant, nil
+				}
+			case *awstypes.PolicyGrantPrincipalMemberUser:
+				if v, ok := principal.Value.(*awstypes.UserPolicyGrantPrincipalMemberUserIdentifier); ok && principalType == policyGrantPrincipalTypeUser && v.Value == principalID {
+					return &grant, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func expandPolicyGrantPrincipal(principalType, principalID string) awstypes.PolicyGrantPrincipal {
+	if principalType == policyGrantPrincipalTypeGroup {
+		return &awstypes.PolicyGrantPrincipalMemberGroup{
+			Value: &awstypes.GroupPolicyGrantPrincipalMemberGroupIdentifier{
+				Value: principalID,
+			},
+		}
+	}
+
+	return &awstypes.PolicyGrantPrincipalMemberUser{
+		Value: &awstypes.UserPolicyGrantPrincipalMemberUserIdentifier{
+			Value: principalID,
+		},
+	}
+}
+
+func expandPolicyGrantDetail(policyType awstypes.ManagedPolicyType, includeChildDomainUnits *bool) awstypes.PolicyGrantDetail {
+	switch policyType {
+	case awstypes.ManagedPolicyTypeAddToProjectMemberPool:
+		return &awstypes.PolicyGrantDetailMemberAddToProjectMemberPool{
+			Value: awstypes.AddToProjectMemberPoolPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeCreateAssetType:
+		return &awstypes.PolicyGrantDetailMemberCreateAssetType{
+			Value: awstypes.CreateAssetTypePolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeCreateDomainUnit:
+		return &awstypes.PolicyGrantDetailMemberCreateDomainUnit{
+			Value: awstypes.CreateDomainUnitPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeCreateEnvironment:
+		return &awstypes.PolicyGrantDetailMemberCreateEnvironment{}
+	case awstypes.ManagedPolicyTypeCreateEnvironmentProfile:
+		return &awstypes.PolicyGrantDetailMemberCreateEnvironmentProfile{}
+	case awstypes.ManagedPolicyTypeCreateFormType:
+		return &awstypes.PolicyGrantDetailMemberCreateFormType{
+			Value: awstypes.CreateFormTypePolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeCreateGlossary:
+		return &awstypes.PolicyGrantDetailMemberCreateGlossary{
+			Value: awstypes.CreateGlossaryPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeCreateProject:
+		return &awstypes.PolicyGrantDetailMemberCreateProject{
+			Value: awstypes.CreateProjectPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeDelegateCreateEnvironmentProfile:
+		return &awstypes.PolicyGrantDetailMemberDelegateCreateEnvironmentProfile{}
+	case awstypes.ManagedPolicyTypeOverrideDomainUnitOwners:
+		return &awstypes.PolicyGrantDetailMemberOverrideDomainUnitOwners{
+			Value: awstypes.OverrideDomainUnitOwnersPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	case awstypes.ManagedPolicyTypeOverrideProjectOwners:
+		return &awstypes.PolicyGrantDetailMemberOverrideProjectOwners{
+			Value: awstypes.OverrideProjectOwnersPolicyGrantDetail{IncludeChildDomainUnits: includeChildDomainUnits},
+		}
+	}
+
+	return nil
+}
+
+func flattenPolicyGrantDetailIncludeChildDomainUnits(apiObject awstypes.PolicyGrantDetail) *bool {
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyGrantDetailMemberAddToProjectMemberPool:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberCreateAssetType:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberCreateDomainUnit:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberCreateFormType:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberCreateGlossary:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberCreateProject:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberOverrideDomainUnitOwners:
+		return v.Value.IncludeChildDomainUnits
+	case *awstypes.PolicyGrantDetailMemberOverrideProjectOwners:
+		return v.Value.IncludeChildDomainUnits
+	}
+
+	return nil
+}
+
+type policyGrantResourceModel struct {
+	DomainIdentifier        types.String                                   `tfsdk:"domain_identifier"`
+	EntityIdentifier        types.String                                   `tfsdk:"entity_identifier"`
+	EntityType              fwtypes.StringEnum[awstypes.TargetEntityType]  `tfsdk:"entity_type"`
+	ID                      types.String                                   `tfsdk:"id"`
+	IncludeChildDomainUnits types.Bool                                     `tfsdk:"include_child_domain_units"`
+	PolicyType              fwtypes.StringEnum[awstypes.ManagedPolicyType] `tfsdk:"policy_type"`
+	PrincipalIdentifier     types.String                                   `tfsdk:"principal_identifier"`
+	PrincipalType           types.String                                   `tfsdk:"principal_type"`
+}
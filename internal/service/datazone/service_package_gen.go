@@ -36,6 +36,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourceDomainUnit,
+			Name:    "Domain Unit",
+		},
+		{
+			Factory: newResourceEntityOwner,
+			Name:    "Entity Owner",
+		},
 		{
 			Factory: newResourceEnvironment,
 			Name:    "Environment",
@@ -60,6 +68,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceGlossaryTerm,
 			Name:    "Glossary Term",
 		},
+		{
+			Factory: newResourcePolicyGrant,
+			Name:    "Policy Grant",
+		},
 		{
 			Factory: newResourceProject,
 			Name:    "Project",
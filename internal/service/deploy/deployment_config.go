@@ -5,6 +5,7 @@ package deploy
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -33,6 +35,8 @@ func resourceDeploymentConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: deploymentConfigComputePlatformDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -134,6 +138,49 @@ func resourceDeploymentConfig() *schema.Resource {
 					},
 				},
 			},
+			"zonal_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_zone_monitor_duration_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"minimum_healthy_hosts_per_zone": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsPerZoneType](),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"monitor_duration_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -150,6 +197,10 @@ func resourceDeploymentConfigCreate(ctx context.Context, d *schema.ResourceData,
 		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
 	}
 
+	if v, ok := d.GetOk("zonal_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ZonalConfig = expandZonalConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateDeploymentConfig(ctx, input)
 
 	if err != nil {
@@ -195,6 +246,9 @@ func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(deploymentConfig.TrafficRoutingConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting traffic_routing_config: %s", err)
 	}
+	if err := d.Set("zonal_config", flattenZonalConfig(deploymentConfig.ZonalConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting zonal_config: %s", err)
+	}
 
 	return diags
 }
@@ -215,6 +269,79 @@ func resourceDeploymentConfigDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// deploymentConfigComputePlatformDiff rejects settings that CodeDeploy doesn't support for the configured compute platform.
+func deploymentConfigComputePlatformDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	computePlatform := types.ComputePlatform(d.Get("compute_platform").(string))
+
+	if computePlatform != types.ComputePlatformServer {
+		if v, ok := d.GetOk("zonal_config"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("zonal_config is only supported for the %s compute platform", types.ComputePlatformServer)
+		}
+	}
+
+	v, ok := d.GetOk("traffic_routing_config")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	trafficRoutingType := types.TrafficRoutingType(tfMap[names.AttrType].(string))
+	canary := tfMap["time_based_canary"].([]interface{})
+	linear := tfMap["time_based_linear"].([]interface{})
+
+	// EC2/on-premises deployments always shift traffic all at once.
+	if computePlatform == types.ComputePlatformServer && trafficRoutingType != types.TrafficRoutingTypeAllAtOnce {
+		return fmt.Errorf("traffic_routing_config.0.type %s is not supported for the %s compute platform", trafficRoutingType, computePlatform)
+	}
+
+	switch trafficRoutingType {
+	case types.TrafficRoutingTypeAllAtOnce:
+		if len(canary) > 0 || len(linear) > 0 {
+			return fmt.Errorf("traffic_routing_config.0.time_based_canary and traffic_routing_config.0.time_based_linear must not be set when traffic_routing_config.0.type is %s", trafficRoutingType)
+		}
+	case types.TrafficRoutingTypeTimeBasedCanary:
+		if len(linear) > 0 {
+			return fmt.Errorf("traffic_routing_config.0.time_based_linear must not be set when traffic_routing_config.0.type is %s", trafficRoutingType)
+		}
+
+		if len(canary) == 0 || canary[0] == nil {
+			return fmt.Errorf("traffic_routing_config.0.time_based_canary is required when traffic_routing_config.0.type is %s", trafficRoutingType)
+		}
+
+		if err := validateTrafficShift("traffic_routing_config.0.time_based_canary.0", canary[0].(map[string]interface{}), 99); err != nil {
+			return err
+		}
+	case types.TrafficRoutingTypeTimeBasedLinear:
+		if len(canary) > 0 {
+			return fmt.Errorf("traffic_routing_config.0.time_based_canary must not be set when traffic_routing_config.0.type is %s", trafficRoutingType)
+		}
+
+		if len(linear) == 0 || linear[0] == nil {
+			return fmt.Errorf("traffic_routing_config.0.time_based_linear is required when traffic_routing_config.0.type is %s", trafficRoutingType)
+		}
+
+		if err := validateTrafficShift("traffic_routing_config.0.time_based_linear.0", linear[0].(map[string]interface{}), 100); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTrafficShift checks that a time-based traffic shift waits at least a minute between
+// increments (the bake time) and shifts a valid percentage of traffic.
+func validateTrafficShift(path string, tfMap map[string]interface{}, maxPercentage int) error {
+	if v := tfMap[names.AttrInterval].(int); v < 1 {
+		return fmt.Errorf("%s.%s must be at least 1 minute, got: %d", path, names.AttrInterval, v)
+	}
+
+	if v := tfMap["percentage"].(int); v < 1 || v > maxPercentage {
+		return fmt.Errorf("%s.percentage must be between 1 and %d, got: %d", path, maxPercentage, v)
+	}
+
+	return nil
+}
+
 func findDeploymentConfigByName(ctx context.Context, conn *codedeploy.Client, name string) (*types.DeploymentConfigInfo, error) {
 	input := &codedeploy.GetDeploymentConfigInput{
 		DeploymentConfigName: aws.String(name),
@@ -354,3 +481,49 @@ func flattenTimeBasedLinear(linear *types.TimeBasedLinear) []map[string]interfac
 
 	return append(result, item)
 }
+
+func expandZonalConfig(tfMap map[string]interface{}) *types.ZonalConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ZonalConfig{}
+
+	if v, ok := tfMap["first_zone_monitor_duration_in_seconds"].(int); ok && v != 0 {
+		apiObject.FirstZoneMonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_healthy_hosts_per_zone"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MinimumHealthyHostsPerZone = &types.MinimumHealthyHostsPerZone{
+			Type:  types.MinimumHealthyHostsPerZoneType(tfMap[names.AttrType].(string)),
+			Value: int32(tfMap[names.AttrValue].(int)),
+		}
+	}
+
+	if v, ok := tfMap["monitor_duration_in_seconds"].(int); ok && v != 0 {
+		apiObject.MonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenZonalConfig(apiObject *types.ZonalConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"first_zone_monitor_duration_in_seconds": aws.ToInt64(apiObject.FirstZoneMonitorDurationInSeconds),
+		"monitor_duration_in_seconds":            aws.ToInt64(apiObject.MonitorDurationInSeconds),
+	}
+
+	if v := apiObject.MinimumHealthyHostsPerZone; v != nil {
+		tfMap["minimum_healthy_hosts_per_zone"] = []interface{}{map[string]interface{}{
+			names.AttrType:  v.Type,
+			names.AttrValue: v.Value,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -240,6 +241,83 @@ func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_zonal(rName, 10, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfigConfig_zonal(rName, 20, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "20"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_trafficRoutingInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfigConfig_trafficRouting(rName, "Server", "TimeBasedCanary", "time_based_canary", 10, 50),
+				ExpectError: regexache.MustCompile(`TimeBasedCanary\s+is\s+not\s+supported`),
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_trafficRouting(rName, "ECS", "TimeBasedCanary", "time_based_linear", 10, 50),
+				ExpectError: regexache.MustCompile(`time_based_linear\s+must\s+not\s+be\s+set`),
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_trafficRouting(rName, "ECS", "TimeBasedCanary", "time_based_canary", 0, 50),
+				ExpectError: regexache.MustCompile(`interval\s+must\s+be\s+at\s+least\s+1\s+minute`),
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_trafficRouting(rName, "ECS", "TimeBasedCanary", "time_based_canary", 10, 100),
+				ExpectError: regexache.MustCompile(`percentage\s+must\s+be\s+between\s+1\s+and\s+99`),
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_zonalCompute(rName, "Lambda"),
+				ExpectError: regexache.MustCompile(`zonal_config\s+is\s+only\s+supported`),
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient(ctx)
@@ -358,3 +436,57 @@ resource "aws_codedeploy_deployment_config" "test" {
 }
 `, rName, interval, percentage)
 }
+
+func testAccDeploymentConfigConfig_zonal(rName string, perZoneValue, monitorDuration int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 50
+  }
+
+  zonal_config {
+    first_zone_monitor_duration_in_seconds = 60
+    monitor_duration_in_seconds            = %[3]d
+
+    minimum_healthy_hosts_per_zone {
+      type  = "FLEET_PERCENT"
+      value = %[2]d
+    }
+  }
+}
+`, rName, perZoneValue, monitorDuration)
+}
+
+func testAccDeploymentConfigConfig_zonalCompute(rName, computePlatform string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = %[2]q
+
+  zonal_config {
+    monitor_duration_in_seconds = 60
+  }
+}
+`, rName, computePlatform)
+}
+
+func testAccDeploymentConfigConfig_trafficRouting(rName, computePlatform, trafficRoutingType, block string, interval, percentage int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = %[2]q
+
+  traffic_routing_config {
+    type = %[3]q
+
+    %[4]s {
+      interval   = %[5]d
+      percentage = %[6]d
+    }
+  }
+}
+`, rName, computePlatform, trafficRoutingType, block, interval, percentage)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/devicefarm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -93,10 +95,56 @@ func resourceDevicePool() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceDevicePoolCustomizeDiff,
+		),
 	}
 }
 
+// devicePoolRuleOperators lists the operators that Device Farm accepts for each device attribute.
+var devicePoolRuleOperators = map[awstypes.DeviceAttribute][]awstypes.RuleOperator{
+	awstypes.DeviceAttributeArn:                 {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeAppiumVersion:       {awstypes.RuleOperatorContains, awstypes.RuleOperatorEquals, awstypes.RuleOperatorGreaterThan, awstypes.RuleOperatorGreaterThanOrEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorLessThan, awstypes.RuleOperatorLessThanOrEquals, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeAvailability:        {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeFleetType:           {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeFormFactor:          {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeInstanceArn:         {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeInstanceLabels:      {awstypes.RuleOperatorContains},
+	awstypes.DeviceAttributeManufacturer:        {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeModel:               {awstypes.RuleOperatorContains, awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeOsVersion:           {awstypes.RuleOperatorEquals, awstypes.RuleOperatorGreaterThan, awstypes.RuleOperatorGreaterThanOrEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorLessThan, awstypes.RuleOperatorLessThanOrEquals, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributePlatform:            {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeRemoteAccessEnabled: {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeRemoteDebugEnabled:  {awstypes.RuleOperatorEquals},
+}
+
+func resourceDevicePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Rules may reference values not yet known at plan time.
+	if !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	for _, r := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attribute, operator := awstypes.DeviceAttribute(tfMap["attribute"].(string)), awstypes.RuleOperator(tfMap["operator"].(string))
+
+		if attribute == "" || operator == "" {
+			continue
+		}
+
+		if operators, ok := devicePoolRuleOperators[attribute]; ok && !slices.Contains(operators, operator) {
+			return fmt.Errorf("rule operator %q is not supported for attribute %q, expected one of %q", operator, attribute, operators)
+		}
+	}
+
+	return nil
+}
+
 func resourceDevicePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeviceFarmClient(ctx)
@@ -6,17 +6,21 @@ package devicefarm
 import (
 	"context"
 	"log"
+	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/devicefarm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -36,6 +40,11 @@ func resourceProject() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -52,9 +61,42 @@ func resourceProject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 8,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A project's VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange(names.AttrVPCConfig, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
 	}
 }
 
@@ -71,6 +113,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DefaultJobTimeoutMinutes = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandVPCConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	output, err := conn.CreateProject(ctx, input)
 
 	if err != nil {
@@ -79,6 +125,12 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.ToString(output.Project.Arn))
 
+	if input.VpcConfig != nil {
+		if _, err := waitProjectVPCConfigUpdated(ctx, conn, d.Id(), input.VpcConfig, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DeviceFarm Project (%s) VPC configuration: %s", d.Id(), err)
+		}
+	}
+
 	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting DeviceFarm Project (%s) tags: %s", d.Id(), err)
 	}
@@ -107,6 +159,14 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrARN, arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
 
+	if project.VpcConfig != nil {
+		if err := d.Set(names.AttrVPCConfig, []interface{}{flattenVPCConfig(project.VpcConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+		}
+	} else {
+		d.Set(names.AttrVPCConfig, nil)
+	}
+
 	return diags
 }
 
@@ -127,11 +187,23 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DefaultJobTimeoutMinutes = aws.Int32(int32(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange(names.AttrVPCConfig) {
+			if v, ok := d.GetOk(names.AttrVPCConfig); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.VpcConfig = expandVPCConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateProject(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating DeviceFarm Project (%s): %s", d.Id(), err)
 		}
+
+		if input.VpcConfig != nil {
+			if _, err := waitProjectVPCConfigUpdated(ctx, conn, d.Id(), input.VpcConfig, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for DeviceFarm Project (%s) VPC configuration update: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceProjectRead(ctx, d, meta)...)
@@ -180,3 +252,87 @@ func findProjectByARN(ctx context.Context, conn *devicefarm.Client, arn string)
 
 	return output.Project, nil
 }
+
+func waitProjectVPCConfigUpdated(ctx context.Context, conn *devicefarm.Client, arn string, expected *awstypes.VpcConfig, timeout time.Duration) (*awstypes.Project, error) {
+	var output *awstypes.Project
+
+	err := tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		project, err := findProjectByARN(ctx, conn, arn)
+
+		if err != nil {
+			return false, err
+		}
+
+		output = project
+
+		return vpcConfigEqual(project.VpcConfig, expected), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                5 * time.Second,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func vpcConfigEqual(a, b *awstypes.VpcConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if aws.ToString(a.VpcId) != aws.ToString(b.VpcId) {
+		return false
+	}
+
+	sortedEqual := func(x, y []string) bool {
+		x, y = slices.Clone(x), slices.Clone(y)
+		slices.Sort(x)
+		slices.Sort(y)
+
+		return slices.Equal(x, y)
+	}
+
+	return sortedEqual(a.SecurityGroupIds, b.SecurityGroupIds) && sortedEqual(a.SubnetIds, b.SubnetIds)
+}
+
+func expandVPCConfig(tfMap map[string]interface{}) *awstypes.VpcConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.VpcConfig{}
+
+	if v, ok := tfMap[names.AttrSecurityGroupIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrSubnetIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrVPCID].(string); ok && v != "" {
+		apiObject.VpcId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVPCConfig(apiObject *awstypes.VpcConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrSecurityGroupIDs: apiObject.SecurityGroupIds,
+		names.AttrSubnetIDs:        apiObject.SubnetIds,
+	}
+
+	if v := apiObject.VpcId; v != nil {
+		tfMap[names.AttrVPCID] = aws.ToString(v)
+	}
+
+	return tfMap
+}
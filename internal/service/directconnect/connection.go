@@ -324,7 +324,7 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 			return sdkdiag.AppendErrorf(diags, "updating Direct Connect Connection (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitConnectionConfirmed(ctx, conn, d.Id()); err != nil {
+		if _, err := waitConnectionConfirmed(ctx, conn, d.Id(), connectionConfirmedTimeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Connection (%s) update: %s", d.Id(), err)
 		}
 	}
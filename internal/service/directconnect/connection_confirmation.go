@@ -5,6 +5,7 @@ package directconnect
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -21,6 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	connectionConfirmedTimeout = 10 * time.Minute
+)
+
 // @SDKResource("aws_dx_connection_confirmation", name="Connection Confirmation")
 func resourceConnectionConfirmation() *schema.Resource {
 	return &schema.Resource{
@@ -28,12 +33,48 @@ func resourceConnectionConfirmation() *schema.Resource {
 		ReadWithoutTimeout:   resourceConnectionConfirmationRead,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceConnectionConfirmationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(connectionConfirmedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrConnectionID: {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			names.AttrLocation: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"partner_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrProviderName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vlan": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -43,30 +84,41 @@ func resourceConnectionConfirmationCreate(ctx context.Context, d *schema.Resourc
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
 
 	connectionID := d.Get(names.AttrConnectionID).(string)
-	input := &directconnect.ConfirmConnectionInput{
-		ConnectionId: aws.String(connectionID),
-	}
 
-	_, err := conn.ConfirmConnection(ctx, input)
+	// The hosted connection may still be in the "requested" state if it was only just allocated by the partner.
+	connection, err := waitConnectionOrdering(ctx, conn, connectionID, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "confirming Direct Connect Connection (%s): %s", connectionID, err)
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Connection (%s) ordering: %s", connectionID, err)
+	}
+
+	// The connection may already have been confirmed outside of Terraform.
+	if connection.ConnectionState == awstypes.ConnectionStateOrdering {
+		input := &directconnect.ConfirmConnectionInput{
+			ConnectionId: aws.String(connectionID),
+		}
+
+		_, err := conn.ConfirmConnection(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "confirming Direct Connect Connection (%s): %s", connectionID, err)
+		}
 	}
 
 	d.SetId(connectionID)
 
-	if _, err := waitConnectionConfirmed(ctx, conn, d.Id()); err != nil {
+	if _, err := waitConnectionConfirmed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Connection (%s) confirm: %s", d.Id(), err)
 	}
 
-	return diags
+	return append(diags, resourceConnectionConfirmationRead(ctx, d, meta)...)
 }
 
 func resourceConnectionConfirmationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
 
-	_, err := findConnectionByID(ctx, conn, d.Id())
+	connection, err := findConnectionByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Direct Connect Connection (%s) not found, removing from state", d.Id())
@@ -78,13 +130,53 @@ func resourceConnectionConfirmationRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s): %s", d.Id(), err)
 	}
 
+	d.Set("bandwidth", connection.Bandwidth)
+	d.Set(names.AttrConnectionID, connection.ConnectionId)
+	d.Set(names.AttrLocation, connection.Location)
+	d.Set(names.AttrName, connection.ConnectionName)
+	d.Set("partner_name", connection.PartnerName)
+	d.Set(names.AttrProviderName, connection.ProviderName)
+	d.Set(names.AttrState, connection.ConnectionState)
+	d.Set("vlan", connection.Vlan)
+
 	return diags
 }
 
-func waitConnectionConfirmed(ctx context.Context, conn *directconnect.Client, id string) (*awstypes.Connection, error) { //nolint:unparam
-	const (
-		timeout = 10 * time.Minute
-	)
+func resourceConnectionConfirmationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
+
+	connection, err := findConnectionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only connections that have already been confirmed can be imported.
+	if state := connection.ConnectionState; state == awstypes.ConnectionStateOrdering {
+		return nil, fmt.Errorf("Direct Connect Connection (%s) has not been confirmed (state: %s)", d.Id(), state)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitConnectionOrdering(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.Connection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ConnectionStateRequested),
+		Target:  enum.Slice(awstypes.ConnectionStateOrdering, awstypes.ConnectionStatePending, awstypes.ConnectionStateAvailable),
+		Refresh: statusConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionConfirmed(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.Connection, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ConnectionStatePending, awstypes.ConnectionStateOrdering, awstypes.ConnectionStateRequested),
 		Target:  enum.Slice(awstypes.ConnectionStateAvailable),
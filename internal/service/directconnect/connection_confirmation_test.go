@@ -27,6 +27,7 @@ func TestAccDirectConnectConnectionConfirmation_basic(t *testing.T) {
 	var providers []*schema.Provider
 	connectionName := fmt.Sprintf("tf-dx-%s", sdkacctest.RandString(5))
 	resourceName := "aws_dx_connection_confirmation.test"
+	hostedConnectionResourceName := "aws_dx_hosted_connection.connection"
 	providerFunc := testAccConnectionConfirmationProvider(&providers, 0)
 	altProviderFunc := testAccConnectionConfirmationProvider(&providers, 1)
 
@@ -41,7 +42,19 @@ func TestAccDirectConnectConnectionConfirmation_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccConnectionConfirmationConfig_basic(connectionName, connectionID, ownerAccountID),
-				Check:  testAccCheckConnectionConfirmationExists(ctx, resourceName, providerFunc),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionConfirmationExists(ctx, resourceName, providerFunc),
+					resource.TestCheckResourceAttrPair(resourceName, "bandwidth", hostedConnectionResourceName, "bandwidth"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrConnectionID, hostedConnectionResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, hostedConnectionResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ConnectionStateAvailable)),
+					resource.TestCheckResourceAttrPair(resourceName, "vlan", hostedConnectionResourceName, "vlan"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
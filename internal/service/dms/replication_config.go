@@ -110,11 +110,19 @@ func resourceReplicationConfig() *schema.Resource {
 					},
 				},
 			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"replication_config_identifier": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"replication_deprovision_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			// "replication_settings" is equivalent to "replication_task_settings" on "aws_dms_replication_task"
 			// All changes to this field and supporting tests should be mirrored in "aws_dms_replication_task"
 			"replication_settings": {
@@ -249,6 +257,27 @@ func resourceReplicationConfigRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("table_mappings", replicationConfig.TableMappings)
 	d.Set("target_endpoint_arn", replicationConfig.TargetEndpointArn)
 
+	replication, err := findReplicationByReplicationConfigARN(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("last_failure_message", nil)
+		d.Set("replication_deprovision_time", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication (%s): %s", d.Id(), err)
+	default:
+		if v := replication.FailureMessages; len(v) > 0 {
+			d.Set("last_failure_message", v[len(v)-1])
+		} else {
+			d.Set("last_failure_message", nil)
+		}
+		if v := replication.ReplicationDeprovisionTime; v != nil {
+			d.Set("replication_deprovision_time", aws.ToTime(v).Format(time.RFC3339))
+		} else {
+			d.Set("replication_deprovision_time", nil)
+		}
+	}
+
 	return diags
 }
 
@@ -586,8 +615,14 @@ func stopReplication(ctx context.Context, conn *dms.Client, arn string, timeout
 		return fmt.Errorf("reading DMS Replication Config (%s) replication: %s", arn, err)
 	}
 
-	if replicationStatus := aws.ToString(replication.Status); replicationStatus == replicationStatusStopped || replicationStatus == replicationStatusCreated || replicationStatus == replicationStatusFailed {
+	switch aws.ToString(replication.Status) {
+	case replicationStatusStopped, replicationStatusCreated, replicationStatusFailed:
 		return nil
+	case replicationStatusInitialising, replicationStatusMetadataResources, replicationStatusTestingConnection, replicationStatusFetchingMetadata, replicationStatusCalculatingCapacity, replicationStatusProvisioningCapacity, replicationStatusReplicationStarting:
+		// Serverless capacity is still being provisioned. Wait for it to complete before stopping.
+		if _, err := waitReplicationRunning(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("waiting for DMS Serverless Replication (%s) provisioning: %w", arn, err)
+		}
 	}
 
 	input := &dms.StopReplicationInput{
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -305,7 +306,7 @@ func TestAccDMSReplicationConfig_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dms_replication_config.test"
-	var v awstypes.ReplicationConfig
+	var v1, v2 awstypes.ReplicationConfig
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -316,17 +317,19 @@ func TestAccDMSReplicationConfig_update(t *testing.T) {
 			{
 				Config: testAccReplicationConfigConfig_update(rName, "cdc", 2, 16),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					testAccCheckReplicationConfigExists(ctx, resourceName, &v1),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "replication_type", "cdc"),
 					resource.TestCheckResourceAttr(resourceName, "compute_config.0.max_capacity_units", "16"),
 					resource.TestCheckResourceAttr(resourceName, "compute_config.0.min_capacity_units", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "last_failure_message", ""),
 				),
 			},
 			{
 				Config: testAccReplicationConfigConfig_update(rName, "cdc", 4, 32),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					testAccCheckReplicationConfigExists(ctx, resourceName, &v2),
+					testAccCheckReplicationConfigNotRecreated(&v1, &v2),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "replication_type", "cdc"),
 					resource.TestCheckResourceAttr(resourceName, "compute_config.0.max_capacity_units", "32"),
@@ -374,6 +377,16 @@ func TestAccDMSReplicationConfig_startReplication(t *testing.T) {
 	})
 }
 
+func testAccCheckReplicationConfigNotRecreated(before, after *awstypes.ReplicationConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.ReplicationConfigCreateTime), aws.ToTime(after.ReplicationConfigCreateTime); !before.Equal(after) {
+			return fmt.Errorf("DMS Replication Config recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckReplicationConfigExists(ctx context.Context, n string, v *awstypes.ReplicationConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
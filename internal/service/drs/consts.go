@@ -7,4 +7,5 @@ package drs
 const (
 	ResNameReplicationConfigurationTemplate   = "Replication Configuration Template"
 	ResPrefixReplicationConfigurationTemplate = "ReplicationConfigurationTemplate"
+	ResNameSourceNetwork                      = "Source Network"
 )
@@ -6,6 +6,8 @@ package drs
 // Exports for use in tests only.
 var (
 	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceSourceNetwork                    = newSourceNetworkResource
 
 	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindSourceNetworkByID                    = findSourceNetworkByID
 )
@@ -165,14 +165,17 @@ func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, r
 
 	input.Tags = getTagsIn(ctx)
 
-	_, err := conn.CreateReplicationConfigurationTemplate(ctx, &input)
+	output, err := conn.CreateReplicationConfigurationTemplate(ctx, &input)
 	if err != nil {
 		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
 
 		return
 	}
 
-	output, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.ReplicationConfigurationTemplateID)
+
+	template, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
 		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionWaitingForCreation, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
@@ -180,7 +183,7 @@ func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, r
 		return
 	}
 
-	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, flexOpt)...)
+	response.Diagnostics.Append(flex.Flatten(ctx, template, &data, flexOpt)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -348,7 +351,7 @@ func findReplicationConfigurationTemplates(ctx context.Context, conn *drs.Client
 
 func findReplicationConfigurationTemplateByID(ctx context.Context, conn *drs.Client, id string) (*awstypes.ReplicationConfigurationTemplate, error) {
 	input := &drs.DescribeReplicationConfigurationTemplatesInput{
-		//ReplicationConfigurationTemplateIDs: []string{id}, // Uncomment when SDK supports this, currently MAX of 1 so you find it anyway
+		ReplicationConfigurationTemplateIDs: []string{id},
 	}
 
 	return findReplicationConfigurationTemplate(ctx, conn, input)
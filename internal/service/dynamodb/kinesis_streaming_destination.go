@@ -27,6 +27,7 @@ import (
 )
 
 const (
+	kinesisStreamingDestinationInUseTimeout        = 5 * time.Minute
 	kinesisStreamingDestinationResourceIDPartCount = 2
 )
 
@@ -35,6 +36,7 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKinesisStreamingDestinationCreate,
 		ReadWithoutTimeout:   resourceKinesisStreamingDestinationRead,
+		UpdateWithoutTimeout: resourceKinesisStreamingDestinationUpdate,
 		DeleteWithoutTimeout: resourceKinesisStreamingDestinationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -42,6 +44,12 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approximate_creation_date_time_precision": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ApproximateCreationDateTimePrecision](),
+			},
 			names.AttrStreamARN: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,7 +77,16 @@ func resourceKinesisStreamingDestinationCreate(ctx context.Context, d *schema.Re
 		TableName: aws.String(tableName),
 	}
 
-	_, err := conn.EnableKinesisStreamingDestination(ctx, input)
+	if v, ok := d.GetOk("approximate_creation_date_time_precision"); ok {
+		input.EnableKinesisStreamingConfiguration = &awstypes.EnableKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(v.(string)),
+		}
+	}
+
+	// Only one streaming destination per table can be changing at a time.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, kinesisStreamingDestinationInUseTimeout, func() (interface{}, error) {
+		return conn.EnableKinesisStreamingDestination(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "enabling DynamoDB Kinesis Streaming Destination (%s): %s", id, err)
@@ -106,12 +123,46 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Kinesis Streaming Destination (%s): %s", d.Id(), err)
 	}
 
+	d.Set("approximate_creation_date_time_precision", output.ApproximateCreationDateTimePrecision)
 	d.Set(names.AttrStreamARN, output.StreamArn)
 	d.Set(names.AttrTableName, tableName)
 
 	return diags
 }
 
+func resourceKinesisStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), kinesisStreamingDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tableName, streamARN := parts[0], parts[1]
+	input := &dynamodb.UpdateKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamARN),
+		TableName: aws.String(tableName),
+		UpdateKinesisStreamingConfiguration: &awstypes.UpdateKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(d.Get("approximate_creation_date_time_precision").(string)),
+		},
+	}
+
+	_, err = tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, kinesisStreamingDestinationInUseTimeout, func() (interface{}, error) {
+		return conn.UpdateKinesisStreamingDestination(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Kinesis Streaming Destination (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Kinesis Streaming Destination (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKinesisStreamingDestinationRead(ctx, d, meta)...)
+}
+
 func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -133,9 +184,11 @@ func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("[DEBUG] Deleting DynamoDB Kinesis Streaming Destination: %s", d.Id())
-	_, err = conn.DisableKinesisStreamingDestination(ctx, &dynamodb.DisableKinesisStreamingDestinationInput{
-		TableName: aws.String(tableName),
-		StreamArn: aws.String(streamARN),
+	_, err = tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, kinesisStreamingDestinationInUseTimeout, func() (interface{}, error) {
+		return conn.DisableKinesisStreamingDestination(ctx, &dynamodb.DisableKinesisStreamingDestinationInput{
+			TableName: aws.String(tableName),
+			StreamArn: aws.String(streamARN),
+		})
 	})
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
@@ -153,9 +206,12 @@ func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.Re
 	return diags
 }
 
+// kinesisDataStreamDestinationForStream matches the destination for the specified stream.
+// A table can stream to several Kinesis data streams and keeps DISABLED entries for streams
+// that have been disabled (and possibly re-enabled), so those entries are skipped.
 func kinesisDataStreamDestinationForStream(arn string) tfslices.Predicate[awstypes.KinesisDataStreamDestination] {
 	return func(v awstypes.KinesisDataStreamDestination) bool {
-		return aws.ToString(v.StreamArn) == arn
+		return aws.ToString(v.StreamArn) == arn && v.DestinationStatus != awstypes.DestinationStatusDisabled
 	}
 }
 
@@ -163,17 +219,8 @@ func findKinesisDataStreamDestinationByTwoPartKey(ctx context.Context, conn *dyn
 	input := &dynamodb.DescribeKinesisStreamingDestinationInput{
 		TableName: aws.String(tableName),
 	}
-	output, err := findKinesisDataStreamDestination(ctx, conn, input, kinesisDataStreamDestinationForStream(streamARN))
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output.DestinationStatus == awstypes.DestinationStatusDisabled {
-		return nil, &retry.NotFoundError{}
-	}
 
-	return output, nil
+	return findKinesisDataStreamDestination(ctx, conn, input, kinesisDataStreamDestinationForStream(streamARN))
 }
 
 func findKinesisDataStreamDestination(ctx context.Context, conn *dynamodb.Client, input *dynamodb.DescribeKinesisStreamingDestinationInput, filter tfslices.Predicate[awstypes.KinesisDataStreamDestination]) (*awstypes.KinesisDataStreamDestination, error) {
@@ -209,10 +256,7 @@ func findKinesisDataStreamDestinations(ctx context.Context, conn *dynamodb.Clien
 
 func statusKinesisStreamingDestination(ctx context.Context, conn *dynamodb.Client, streamARN, tableName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &dynamodb.DescribeKinesisStreamingDestinationInput{
-			TableName: aws.String(tableName),
-		}
-		output, err := findKinesisDataStreamDestination(ctx, conn, input, kinesisDataStreamDestinationForStream(streamARN))
+		output, err := findKinesisDataStreamDestinationByTwoPartKey(ctx, conn, streamARN, tableName)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -231,7 +275,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.C
 		timeout = 5 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling),
+		Pending: enum.Slice(awstypes.DestinationStatusEnabling, awstypes.DestinationStatusUpdating),
 		Target:  enum.Slice(awstypes.DestinationStatusActive),
 		Timeout: timeout,
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
@@ -253,7 +297,7 @@ func waitKinesisStreamingDestinationDisabled(ctx context.Context, conn *dynamodb
 	)
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DestinationStatusActive, awstypes.DestinationStatusDisabling),
-		Target:  enum.Slice(awstypes.DestinationStatusDisabled),
+		Target:  []string{},
 		Timeout: timeout,
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -95,6 +96,83 @@ func TestAccDynamoDBKinesisStreamingDestination_Disappears_dynamoDBTable(t *test
 	})
 }
 
+func TestAccDynamoDBKinesisStreamingDestination_approximateCreationDateTimePrecision(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKinesisStreamingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationConfig_approximateCreationDateTimePrecision(rName, "MICROSECOND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "approximate_creation_date_time_precision", "MICROSECOND"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisStreamingDestinationConfig_approximateCreationDateTimePrecision(rName, "MILLISECOND"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "approximate_creation_date_time_precision", "MILLISECOND"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBKinesisStreamingDestination_multipleStreams(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_dynamodb_kinesis_streaming_destination.test.0"
+	resource2Name := "aws_dynamodb_kinesis_streaming_destination.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKinesisStreamingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationConfig_multipleStreams(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationExists(ctx, resource1Name),
+					testAccCheckKinesisStreamingDestinationExists(ctx, resource2Name),
+				),
+			},
+			{
+				// Disabling one destination leaves the other in place.
+				Config: testAccKinesisStreamingDestinationConfig_multipleStreams(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationExists(ctx, resource1Name),
+				),
+			},
+			{
+				// Re-enabling a previously disabled stream.
+				Config: testAccKinesisStreamingDestinationConfig_multipleStreams(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationExists(ctx, resource1Name),
+					testAccCheckKinesisStreamingDestinationExists(ctx, resource2Name),
+				),
+			},
+		},
+	})
+}
+
 func testAccKinesisStreamingDestinationConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -121,6 +199,64 @@ resource "aws_dynamodb_kinesis_streaming_destination" "test" {
 `, rName)
 }
 
+func testAccKinesisStreamingDestinationConfig_approximateCreationDateTimePrecision(rName, precision string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "hk"
+
+  attribute {
+    name = "hk"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 2
+}
+
+resource "aws_dynamodb_kinesis_streaming_destination" "test" {
+  table_name = aws_dynamodb_table.test.name
+  stream_arn = aws_kinesis_stream.test.arn
+
+  approximate_creation_date_time_precision = %[2]q
+}
+`, rName, precision)
+}
+
+func testAccKinesisStreamingDestinationConfig_multipleStreams(rName string, destinationCount int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "hk"
+
+  attribute {
+    name = "hk"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  shard_count = 1
+}
+
+resource "aws_dynamodb_kinesis_streaming_destination" "test" {
+  count = %[2]d
+
+  table_name = aws_dynamodb_table.test.name
+  stream_arn = aws_kinesis_stream.test[count.index].arn
+}
+`, rName, destinationCount)
+}
+
 func testAccCheckKinesisStreamingDestinationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	snapshotCreateVolumePermissionsMaxBatchSize = 100
+)
+
+// @SDKResource("aws_ec2_snapshot_create_volume_permission_exclusive", name="EBS Snapshot CreateVolume Permission Exclusive")
+func resourceSnapshotCreateVolumePermissionExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnapshotCreateVolumePermissionExclusivePut,
+		ReadWithoutTimeout:   resourceSnapshotCreateVolumePermissionExclusiveRead,
+		UpdateWithoutTimeout: resourceSnapshotCreateVolumePermissionExclusivePut,
+		DeleteWithoutTimeout: resourceSnapshotCreateVolumePermissionExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSnapshotCreateVolumePermissionExclusiveCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PermissionGroup](),
+			},
+			names.AttrSnapshotID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCreateVolumePermissionExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	snapshotID := d.Get(names.AttrSnapshotID).(string)
+	permissions := expandCreateVolumePermissions(flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set)), d.Get("group").(string))
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := syncSnapshotCreateVolumePermissions(ctx, conn, snapshotID, permissions, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EBS Snapshot (%s) CreateVolumePermissions: %s", snapshotID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(snapshotID)
+	}
+
+	return append(diags, resourceSnapshotCreateVolumePermissionExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCreateVolumePermissionExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	permissions, err := findSnapshotCreateVolumePermissionsByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot CreateVolumePermission Exclusive %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot CreateVolumePermission Exclusive (%s): %s", d.Id(), err)
+	}
+
+	var accountIDs []string
+	var group string
+	for _, v := range permissions {
+		if v.Group != "" {
+			group = string(v.Group)
+		}
+		if v := aws.ToString(v.UserId); v != "" {
+			accountIDs = append(accountIDs, v)
+		}
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("group", group)
+	d.Set(names.AttrSnapshotID, d.Id())
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting EBS Snapshot CreateVolumePermission Exclusive: %s", d.Id())
+	err := syncSnapshotCreateVolumePermissions(ctx, conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Snapshot CreateVolumePermission Exclusive (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionExclusiveCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(names.AttrSnapshotID) || !diff.NewValueKnown("account_ids") || !diff.NewValueKnown("group") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("account_ids", "group") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	snapshotID := diff.Get(names.AttrSnapshotID).(string)
+
+	snapshot, err := findSnapshotByID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Snapshot (%s): %w", snapshotID, err)
+	}
+
+	if ownerID := aws.ToString(snapshot.OwnerId); slices.Contains(flex.ExpandStringValueSet(diff.Get("account_ids").(*schema.Set)), ownerID) {
+		return fmt.Errorf("AWS Account (%s) owns EBS Snapshot (%s)", ownerID, snapshotID)
+	}
+
+	if group := diff.Get("group").(string); group == string(awstypes.PermissionGroupAll) && (diff.Id() == "" || diff.HasChange("group")) {
+		if aws.ToBool(snapshot.Encrypted) {
+			return fmt.Errorf("encrypted EBS Snapshot (%s) cannot be shared publicly", snapshotID)
+		}
+
+		output, err := conn.GetSnapshotBlockPublicAccessState(ctx, &ec2.GetSnapshotBlockPublicAccessStateInput{})
+
+		if err != nil {
+			return fmt.Errorf("reading EBS Snapshot Block Public Access: %w", err)
+		}
+
+		if state := output.State; state != awstypes.SnapshotBlockPublicAccessStateUnblocked {
+			return fmt.Errorf("EBS Snapshot (%s) cannot be shared publicly, EBS Snapshot Block Public Access is %s", snapshotID, state)
+		}
+	}
+
+	return nil
+}
+
+// syncSnapshotCreateVolumePermissions makes the snapshot's createVolumePermission attribute match the specified permissions.
+// Removals are applied before additions so that public sharing is revoked as early as possible.
+func syncSnapshotCreateVolumePermissions(ctx context.Context, conn *ec2.Client, snapshotID string, permissions []awstypes.CreateVolumePermission, timeout time.Duration) error {
+	current, err := findSnapshotCreateVolumePermissionsByID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return err
+	}
+
+	add, remove := createVolumePermissionsDifference(permissions, current), createVolumePermissionsDifference(current, permissions)
+
+	for _, chunk := range tfslices.Chunks(remove, snapshotCreateVolumePermissionsMaxBatchSize) {
+		input := &ec2.ModifySnapshotAttributeInput{
+			Attribute: awstypes.SnapshotAttributeNameCreateVolumePermission,
+			CreateVolumePermission: &awstypes.CreateVolumePermissionModifications{
+				Remove: chunk,
+			},
+			SnapshotId: aws.String(snapshotID),
+		}
+
+		if _, err := conn.ModifySnapshotAttribute(ctx, input); err != nil {
+			return fmt.Errorf("removing CreateVolumePermissions: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(add, snapshotCreateVolumePermissionsMaxBatchSize) {
+		input := &ec2.ModifySnapshotAttributeInput{
+			Attribute: awstypes.SnapshotAttributeNameCreateVolumePermission,
+			CreateVolumePermission: &awstypes.CreateVolumePermissionModifications{
+				Add: chunk,
+			},
+			SnapshotId: aws.String(snapshotID),
+		}
+
+		if _, err := conn.ModifySnapshotAttribute(ctx, input); err != nil {
+			return fmt.Errorf("adding CreateVolumePermissions: %w", err)
+		}
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	// Wait for the attribute changes to propagate.
+	_, err = tfresource.RetryUntilEqual(ctx, timeout, createVolumePermissionsKey(permissions), func() (string, error) {
+		output, err := findSnapshotCreateVolumePermissionsByID(ctx, conn, snapshotID)
+
+		if err != nil {
+			return "", err
+		}
+
+		return createVolumePermissionsKey(output), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for CreateVolumePermissions: %w", err)
+	}
+
+	return nil
+}
+
+func expandCreateVolumePermissions(accountIDs []string, group string) []awstypes.CreateVolumePermission {
+	var apiObjects []awstypes.CreateVolumePermission
+
+	for _, v := range accountIDs {
+		apiObjects = append(apiObjects, awstypes.CreateVolumePermission{
+			UserId: aws.String(v),
+		})
+	}
+
+	if group != "" {
+		apiObjects = append(apiObjects, awstypes.CreateVolumePermission{
+			Group: awstypes.PermissionGroup(group),
+		})
+	}
+
+	return apiObjects
+}
+
+func createVolumePermissionKey(apiObject awstypes.CreateVolumePermission) string {
+	if apiObject.Group != "" {
+		return "group:" + string(apiObject.Group)
+	}
+
+	return aws.ToString(apiObject.UserId)
+}
+
+// createVolumePermissionsKey returns a stable, order-independent representation of the specified permissions.
+func createVolumePermissionsKey(apiObjects []awstypes.CreateVolumePermission) string {
+	keys := tfslices.ApplyToAll(apiObjects, createVolumePermissionKey)
+	slices.Sort(keys)
+
+	return strings.Join(keys, ",")
+}
+
+// createVolumePermissionsDifference returns the permissions in s1 that are not in s2.
+func createVolumePermissionsDifference(s1, s2 []awstypes.CreateVolumePermission) []awstypes.CreateVolumePermission {
+	keys := tfslices.ApplyToAll(s2, createVolumePermissionKey)
+
+	return tfslices.Filter(s1, func(v awstypes.CreateVolumePermission) bool {
+		return !slices.Contains(keys, createVolumePermissionKey(v))
+	})
+}
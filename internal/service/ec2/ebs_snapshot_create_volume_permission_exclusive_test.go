@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_snapshot_create_volume_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "group", ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, "aws_ebs_snapshot.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionExclusive_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_snapshot_create_volume_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionExclusiveExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSnapshotCreateVolumePermissionExclusive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_snapshot_create_volume_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "group", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionExclusive_snapshotOwnerExpectError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_snapshotOwner(rName),
+				ExpectError: regexache.MustCompile(`owns EBS Snapshot`),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionExclusive_encryptedPublicExpectError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_encryptedPublic(rName),
+				ExpectError: regexache.MustCompile(`cannot be shared publicly`),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotCreateVolumePermissionExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_snapshot_create_volume_permission_exclusive" {
+				continue
+			}
+
+			output, err := tfec2.FindSnapshotCreateVolumePermissionsByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EBS Snapshot CreateVolumePermission Exclusive %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotCreateVolumePermissionExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindSnapshotCreateVolumePermissionsByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+`, rName))
+}
+
+func testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_base(rName), `
+resource "aws_ec2_snapshot_create_volume_permission_exclusive" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  account_ids = [data.aws_caller_identity.alternate.account_id]
+}
+`)
+}
+
+func testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_base(rName), `
+resource "aws_ec2_snapshot_create_volume_permission_exclusive" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+}
+`)
+}
+
+func testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_snapshotOwner(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_ec2_snapshot_create_volume_permission_exclusive" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  account_ids = [data.aws_caller_identity.current.account_id]
+}
+`)
+}
+
+func testAccEBSSnapshotCreateVolumePermissionExclusiveConfig_encryptedPublic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  encrypted         = true
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_snapshot_create_volume_permission_exclusive" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  group       = "all"
+}
+`, rName))
+}
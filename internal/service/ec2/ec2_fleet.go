@@ -64,6 +64,38 @@ func resourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"excess_capacity_termination_policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -774,6 +806,16 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.ToString(output.FleetId))
 
+	// Instant fleets return their launch results synchronously, while DescribeFleets is eventually consistent.
+	if fleetType == awstypes.FleetTypeInstant {
+		if err := d.Set("errors", flattenCreateFleetErrors(output.Errors)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting errors: %s", err)
+		}
+		if err := d.Set("fleet_instance_set", flattenCreateFleetInstances(output.Instances)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting fleet_instance_set: %s", err)
+		}
+	}
+
 	// If a request type is fulfilled immediately, we can miss the transition from active to deleted.
 	// Instead of an error here, allow the Read function to trigger recreation.
 	if input.ValidFrom == nil {
@@ -815,6 +857,11 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}.String()
 	d.Set(names.AttrARN, arn)
 	d.Set("context", fleet.Context)
+	if fleet.Errors != nil {
+		if err := d.Set("errors", flattenDescribeFleetErrors(fleet.Errors)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting errors: %s", err)
+		}
+	}
 	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
 	if fleet.Instances != nil {
 		if err := d.Set("fleet_instance_set", flattenFleetInstanceSet(fleet.Instances)); err != nil {
@@ -956,6 +1003,21 @@ func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v
 				}
 			}
 		}
+	} else if diff.Get(names.AttrType).(string) != string(awstypes.FleetTypeMaintain) {
+		// Only fleets of type maintain can be modified.
+		for _, key := range []string{"launch_template_config", "target_capacity_specification.0.total_target_capacity"} {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if v, ok := diff.GetOk("spot_options.0.maintenance_strategies.0.capacity_rebalance.0.termination_delay"); ok && v.(int) != 0 {
+		if v := diff.Get("spot_options.0.maintenance_strategies.0.capacity_rebalance.0.replacement_strategy").(string); v != string(awstypes.FleetReplacementStrategyLaunchBeforeTerminate) {
+			return fmt.Errorf("EC2 Fleet has an invalid configuration. termination_delay can only be specified when replacement_strategy is %q.", awstypes.FleetReplacementStrategyLaunchBeforeTerminate)
+		}
 	}
 
 	return nil
@@ -1277,6 +1339,80 @@ func flattenCapacityReservationsOptions(apiObject *awstypes.CapacityReservationO
 	return tfMap
 }
 
+func flattenCreateFleetErrors(apiObjects []awstypes.CreateFleetError) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDescribeFleetError(awstypes.DescribeFleetError(apiObject)))
+	}
+
+	return tfList
+}
+
+func flattenCreateFleetInstances(apiObjects []awstypes.CreateFleetInstance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenFleetInstances(awstypes.DescribeFleetsInstances(apiObject)))
+	}
+
+	return tfList
+}
+
+func flattenDescribeFleetError(apiObject awstypes.DescribeFleetError) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ErrorCode; v != nil {
+		tfMap["error_code"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ErrorMessage; v != nil {
+		tfMap["error_message"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LaunchTemplateAndOverrides; v != nil && v.Overrides != nil {
+		if v := v.Overrides.AvailabilityZone; v != nil {
+			tfMap[names.AttrAvailabilityZone] = aws.ToString(v)
+		}
+
+		if v := v.Overrides.InstanceType; v != "" {
+			tfMap[names.AttrInstanceType] = v
+		}
+
+		if v := v.Overrides.SubnetId; v != nil {
+			tfMap[names.AttrSubnetID] = aws.ToString(v)
+		}
+	}
+
+	if v := apiObject.Lifecycle; v != "" {
+		tfMap["lifecycle"] = v
+	}
+
+	return tfMap
+}
+
+func flattenDescribeFleetErrors(apiObjects []awstypes.DescribeFleetError) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDescribeFleetError(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenFleetInstances(apiObject awstypes.DescribeFleetsInstances) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	imageLaunchPermissionsMaxBatchSize = 100
+)
+
+// @SDKResource("aws_ec2_image_launch_permission_exclusive", name="Image Launch Permission Exclusive")
+func resourceImageLaunchPermissionExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageLaunchPermissionExclusivePut,
+		ReadWithoutTimeout:   resourceImageLaunchPermissionExclusiveRead,
+		UpdateWithoutTimeout: resourceImageLaunchPermissionExclusivePut,
+		DeleteWithoutTimeout: resourceImageLaunchPermissionExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceImageLaunchPermissionExclusiveCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PermissionGroup](),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"organizational_unit_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceImageLaunchPermissionExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	imageID := d.Get("image_id").(string)
+	permissions := expandImageLaunchPermissions(
+		flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set)),
+		d.Get("group").(string),
+		flex.ExpandStringValueSet(d.Get("organization_arns").(*schema.Set)),
+		flex.ExpandStringValueSet(d.Get("organizational_unit_arns").(*schema.Set)),
+	)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := syncImageLaunchPermissions(ctx, conn, imageID, permissions, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting AMI (%s) launch permissions: %s", imageID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(imageID)
+	}
+
+	return append(diags, resourceImageLaunchPermissionExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceImageLaunchPermissionExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	permissions, err := findImageLaunchPermissionAttributeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Image Launch Permission Exclusive %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Image Launch Permission Exclusive (%s): %s", d.Id(), err)
+	}
+
+	var accountIDs, organizationARNs, organizationalUnitARNs []string
+	var group string
+	for _, v := range permissions {
+		switch {
+		case v.Group != "":
+			group = string(v.Group)
+		case v.UserId != nil:
+			accountIDs = append(accountIDs, aws.ToString(v.UserId))
+		case v.OrganizationArn != nil:
+			organizationARNs = append(organizationARNs, aws.ToString(v.OrganizationArn))
+		case v.OrganizationalUnitArn != nil:
+			organizationalUnitARNs = append(organizationalUnitARNs, aws.ToString(v.OrganizationalUnitArn))
+		}
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("group", group)
+	d.Set("image_id", d.Id())
+	d.Set("organization_arns", organizationARNs)
+	d.Set("organizational_unit_arns", organizationalUnitARNs)
+
+	return diags
+}
+
+func resourceImageLaunchPermissionExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[INFO] Deleting EC2 Image Launch Permission Exclusive: %s", d.Id())
+	err := syncImageLaunchPermissions(ctx, conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Image Launch Permission Exclusive (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceImageLaunchPermissionExclusiveCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("image_id") || !diff.NewValueKnown("group") {
+		return nil
+	}
+
+	if group := diff.Get("group").(string); group != string(awstypes.PermissionGroupAll) || (diff.Id() != "" && !diff.HasChange("group")) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	imageID := diff.Get("image_id").(string)
+
+	image, err := findImageByID(ctx, conn, imageID)
+
+	if err != nil {
+		return fmt.Errorf("reading AMI (%s): %w", imageID, err)
+	}
+
+	// Block public access only prevents new public sharing.
+	if aws.ToBool(image.Public) {
+		return nil
+	}
+
+	state, err := findImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Image Block Public Access: %w", err)
+	}
+
+	if state := aws.ToString(state); state != string(awstypes.ImageBlockPublicAccessDisabledStateUnblocked) {
+		return fmt.Errorf("AMI (%s) cannot be shared publicly, EC2 Image Block Public Access is %s", imageID, state)
+	}
+
+	return nil
+}
+
+// syncImageLaunchPermissions makes the image's launchPermission attribute match the specified permissions.
+// Removals are applied before additions so that public sharing is revoked as early as possible.
+func syncImageLaunchPermissions(ctx context.Context, conn *ec2.Client, imageID string, permissions []awstypes.LaunchPermission, timeout time.Duration) error {
+	current, err := findImageLaunchPermissionAttributeByID(ctx, conn, imageID)
+
+	if err != nil {
+		return err
+	}
+
+	add, remove := launchPermissionsDifference(permissions, current), launchPermissionsDifference(current, permissions)
+
+	for _, chunk := range tfslices.Chunks(remove, imageLaunchPermissionsMaxBatchSize) {
+		input := &ec2.ModifyImageAttributeInput{
+			Attribute: aws.String(string(awstypes.ImageAttributeNameLaunchPermission)),
+			ImageId:   aws.String(imageID),
+			LaunchPermission: &awstypes.LaunchPermissionModifications{
+				Remove: chunk,
+			},
+		}
+
+		if _, err := conn.ModifyImageAttribute(ctx, input); err != nil {
+			return fmt.Errorf("removing launch permissions: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(add, imageLaunchPermissionsMaxBatchSize) {
+		input := &ec2.ModifyImageAttributeInput{
+			Attribute: aws.String(string(awstypes.ImageAttributeNameLaunchPermission)),
+			ImageId:   aws.String(imageID),
+			LaunchPermission: &awstypes.LaunchPermissionModifications{
+				Add: chunk,
+			},
+		}
+
+		if _, err := conn.ModifyImageAttribute(ctx, input); err != nil {
+			return fmt.Errorf("adding launch permissions: %w", err)
+		}
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	// Wait for the attribute changes to propagate.
+	_, err = tfresource.RetryUntilEqual(ctx, timeout, launchPermissionsKey(permissions), func() (string, error) {
+		output, err := findImageLaunchPermissionAttributeByID(ctx, conn, imageID)
+
+		if err != nil {
+			return "", err
+		}
+
+		return launchPermissionsKey(output), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for launch permissions: %w", err)
+	}
+
+	return nil
+}
+
+func expandImageLaunchPermissions(accountIDs []string, group string, organizationARNs, organizationalUnitARNs []string) []awstypes.LaunchPermission {
+	var apiObjects []awstypes.LaunchPermission
+
+	for _, v := range accountIDs {
+		apiObjects = append(apiObjects, expandLaunchPermissions(v, "", "", "")...)
+	}
+
+	if group != "" {
+		apiObjects = append(apiObjects, expandLaunchPermissions("", group, "", "")...)
+	}
+
+	for _, v := range organizationARNs {
+		apiObjects = append(apiObjects, expandLaunchPermissions("", "", v, "")...)
+	}
+
+	for _, v := range organizationalUnitARNs {
+		apiObjects = append(apiObjects, expandLaunchPermissions("", "", "", v)...)
+	}
+
+	return apiObjects
+}
+
+func launchPermissionKey(apiObject awstypes.LaunchPermission) string {
+	switch {
+	case apiObject.Group != "":
+		return "group:" + string(apiObject.Group)
+	case apiObject.OrganizationArn != nil:
+		return aws.ToString(apiObject.OrganizationArn)
+	case apiObject.OrganizationalUnitArn != nil:
+		return aws.ToString(apiObject.OrganizationalUnitArn)
+	default:
+		return aws.ToString(apiObject.UserId)
+	}
+}
+
+// launchPermissionsKey returns a stable, order-independent representation of the specified permissions.
+func launchPermissionsKey(apiObjects []awstypes.LaunchPermission) string {
+	keys := tfslices.ApplyToAll(apiObjects, launchPermissionKey)
+	slices.Sort(keys)
+
+	return strings.Join(keys, ",")
+}
+
+// launchPermissionsDifference returns the permissions in s1 that are not in s2.
+func launchPermissionsDifference(s1, s2 []awstypes.LaunchPermission) []awstypes.LaunchPermission {
+	keys := tfslices.ApplyToAll(s2, launchPermissionKey)
+
+	return tfslices.Filter(s1, func(v awstypes.LaunchPermission) bool {
+		return !slices.Contains(keys, launchPermissionKey(v))
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2ImageLaunchPermissionExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_image_launch_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageLaunchPermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageLaunchPermissionExclusiveConfig_accountIDs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageLaunchPermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "group", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "aws_ami_copy.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "organization_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_arns.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageLaunchPermissionExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageLaunchPermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ImageLaunchPermissionExclusive_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_image_launch_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageLaunchPermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageLaunchPermissionExclusiveConfig_accountIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageLaunchPermissionExclusiveExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceImageLaunchPermissionExclusive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2ImageLaunchPermissionExclusive_organizationARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_image_launch_permission_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageLaunchPermissionExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageLaunchPermissionExclusiveConfig_organizationARNs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageLaunchPermissionExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "organization_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "organization_arns.*", "data.aws_organizations_organization.current", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageLaunchPermissionExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_image_launch_permission_exclusive" {
+				continue
+			}
+
+			output, err := tfec2.FindImageLaunchPermissionAttributeByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EC2 Image Launch Permission Exclusive %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckImageLaunchPermissionExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindImageLaunchPermissionAttributeByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccImageLaunchPermissionExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_ami_copy" "test" {
+  description       = %[1]q
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  source_ami_region = data.aws_region.current.name
+}
+`, rName))
+}
+
+func testAccImageLaunchPermissionExclusiveConfig_accountIDs(rName string) string {
+	return acctest.ConfigCompose(testAccImageLaunchPermissionExclusiveConfig_base(rName), `
+resource "aws_ec2_image_launch_permission_exclusive" "test" {
+  image_id    = aws_ami_copy.test.id
+  account_ids = [data.aws_caller_identity.current.account_id]
+}
+`)
+}
+
+func testAccImageLaunchPermissionExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccImageLaunchPermissionExclusiveConfig_base(rName), `
+resource "aws_ec2_image_launch_permission_exclusive" "test" {
+  image_id = aws_ami_copy.test.id
+}
+`)
+}
+
+func testAccImageLaunchPermissionExclusiveConfig_organizationARNs(rName string) string {
+	return acctest.ConfigCompose(testAccImageLaunchPermissionExclusiveConfig_base(rName), `
+data "aws_organizations_organization" "current" {}
+
+resource "aws_ec2_image_launch_permission_exclusive" "test" {
+  image_id          = aws_ami_copy.test.id
+  account_ids       = [data.aws_caller_identity.current.account_id]
+  organization_arns = [data.aws_organizations_organization.current.arn]
+}
+`)
+}
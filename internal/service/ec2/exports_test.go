@@ -41,6 +41,7 @@ var (
 	ResourceIPAMResourceDiscoveryAssociation              = resourceIPAMResourceDiscoveryAssociation
 	ResourceIPAMScope                                     = resourceIPAMScope
 	ResourceImageBlockPublicAccess                        = resourceImageBlockPublicAccess
+	ResourceImageLaunchPermissionExclusive                = resourceImageLaunchPermissionExclusive
 	ResourceInstance                                      = resourceInstance
 	ResourceInstanceConnectEndpoint                       = newInstanceConnectEndpointResource
 	ResourceInstanceMetadataDefaults                      = newInstanceMetadataDefaultsResource
@@ -70,6 +71,7 @@ var (
 	ResourceSecurityGroupEgressRule                       = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                      = newSecurityGroupIngressRuleResource
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
+	ResourceSnapshotCreateVolumePermissionExclusive       = resourceSnapshotCreateVolumePermissionExclusive
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                              = resourceSpotFleetRequest
 	ResourceSpotInstanceRequest                           = resourceSpotInstanceRequest
@@ -81,6 +83,8 @@ var (
 	ResourceTrafficMirrorSession                          = resourceTrafficMirrorSession
 	ResourceTrafficMirrorTarget                           = resourceTrafficMirrorTarget
 	ResourceTransitGatewayConnect                         = resourceTransitGatewayConnect
+	ResourceTransitGatewayDefaultRouteTableAssociation    = resourceTransitGatewayDefaultRouteTableAssociation
+	ResourceTransitGatewayDefaultRouteTablePropagation    = resourceTransitGatewayDefaultRouteTablePropagation
 	ResourceTransitGatewayMulticastDomain                 = resourceTransitGatewayMulticastDomain
 	ResourceTransitGatewayMulticastDomainAssociation      = resourceTransitGatewayMulticastDomainAssociation
 	ResourceTransitGatewayMulticastGroupMember            = resourceTransitGatewayMulticastGroupMember
@@ -153,6 +157,7 @@ var (
 	FindIPAMResourceDiscoveryByID                              = findIPAMResourceDiscoveryByID
 	FindIPAMScopeByID                                          = findIPAMScopeByID
 	FindImageLaunchPermission                                  = findImageLaunchPermission
+	FindImageLaunchPermissionAttributeByID                     = findImageLaunchPermissionAttributeByID
 	FindInstanceConnectEndpointByID                            = findInstanceConnectEndpointByID
 	FindInstanceMetadataDefaults                               = findInstanceMetadataDefaults
 	FindInstanceStateByID                                      = findInstanceStateByID
@@ -187,6 +192,7 @@ var (
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
+	FindSnapshotCreateVolumePermissionsByID                    = findSnapshotCreateVolumePermissionsByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
 	FindSpotFleetRequestByID                                   = findSpotFleetRequestByID
 	FindSpotFleetRequests                                      = findSpotFleetRequests
@@ -2940,6 +2940,21 @@ func findVPCEndpointServices(ctx context.Context, conn *ec2.Client, input *ec2.D
 	return serviceDetails, serviceNames, nil
 }
 
+func findVPCEndpointServiceByNameAndRegion(ctx context.Context, conn *ec2.Client, name, region string) (*awstypes.ServiceDetail, error) {
+	input := &ec2.DescribeVpcEndpointServicesInput{
+		ServiceNames:   []string{name},
+		ServiceRegions: []string{region},
+	}
+
+	output, _, err := findVPCEndpointServices(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
 // findVPCEndpointRouteTableAssociationExists returns NotFoundError if no association for the specified VPC endpoint and route table IDs is found.
 func findVPCEndpointRouteTableAssociationExists(ctx context.Context, conn *ec2.Client, vpcEndpointID string, routeTableID string) error {
 	vpcEndpoint, err := findVPCEndpointByID(ctx, conn, vpcEndpointID)
@@ -4275,6 +4290,22 @@ func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id st
 	return output.LaunchPermissions, nil
 }
 
+// findImageLaunchPermissionAttributeByID returns the image's launch permissions, which may be empty.
+func findImageLaunchPermissionAttributeByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.LaunchPermission, error) {
+	input := &ec2.DescribeImageAttributeInput{
+		Attribute: awstypes.ImageAttributeNameLaunchPermission,
+		ImageId:   aws.String(id),
+	}
+
+	output, err := findImageAttribute(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.LaunchPermissions, nil
+}
+
 func findImageLaunchPermission(ctx context.Context, conn *ec2.Client, imageID, accountID, group, organizationARN, organizationalUnitARN string) (*awstypes.LaunchPermission, error) {
 	output, err := findImageLaunchPermissionsByID(ctx, conn, imageID)
 
@@ -5646,6 +5677,21 @@ func findCreateSnapshotCreateVolumePermissionByTwoPartKey(ctx context.Context, c
 	return awstypes.CreateVolumePermission{}, &retry.NotFoundError{LastRequest: input}
 }
 
+func findSnapshotCreateVolumePermissionsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.CreateVolumePermission, error) {
+	input := &ec2.DescribeSnapshotAttributeInput{
+		Attribute:  awstypes.SnapshotAttributeNameCreateVolumePermission,
+		SnapshotId: aws.String(id),
+	}
+
+	output, err := findSnapshotAttribute(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.CreateVolumePermissions, nil
+}
+
 func findFindSnapshotTierStatuses(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSnapshotTierStatusInput) ([]awstypes.SnapshotTierStatus, error) {
 	var output []awstypes.SnapshotTierStatus
 
@@ -729,6 +729,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_image_block_public_access",
 			Name:     "Image Block Public Access",
 		},
+		{
+			Factory:  resourceImageLaunchPermissionExclusive,
+			TypeName: "aws_ec2_image_launch_permission_exclusive",
+			Name:     "Image Launch Permission Exclusive",
+		},
 		{
 			Factory:  resourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -780,6 +785,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_serial_console_access",
 			Name:     "Serial Console Access",
 		},
+		{
+			Factory:  resourceSnapshotCreateVolumePermissionExclusive,
+			TypeName: "aws_ec2_snapshot_create_volume_permission_exclusive",
+			Name:     "EBS Snapshot CreateVolume Permission Exclusive",
+		},
 		{
 			Factory:  resourceSubnetCIDRReservation,
 			TypeName: "aws_ec2_subnet_cidr_reservation",
@@ -802,6 +812,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceTrafficMirrorFilterRule,
 			TypeName: "aws_ec2_traffic_mirror_filter_rule",
 			Name:     "Traffic Mirror Filter Rule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
 		},
 		{
 			Factory:  resourceTrafficMirrorSession,
@@ -843,6 +856,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+			Name:     "Transit Gateway Default Route Table Association",
+		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+			Name:     "Transit Gateway Default Route Table Propagation",
+		},
 		{
 			Factory:  resourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_association", name="Transit Gateway Default Route Table Association")
+func resourceTransitGatewayDefaultRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayDefaultRouteTableAssociationRead,
+		UpdateWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_association": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTransitGatewayID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	tgwID := d.Get(names.AttrTransitGatewayID).(string)
+	tgw, err := findTransitGatewayByID(ctx, conn, tgwID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", tgwID, err)
+	}
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &awstypes.ModifyTransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String(d.Get("transit_gateway_route_table_id").(string)),
+			DefaultRouteTableAssociation:   awstypes.DefaultRouteTableAssociationValueEnable,
+		},
+		TransitGatewayId: aws.String(tgwID),
+	}
+
+	if _, err := conn.ModifyTransitGateway(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Default Route Table Association (%s): %s", tgwID, err)
+	}
+
+	d.SetId(tgwID)
+	d.Set("original_default_route_table_association", tgw.Options.DefaultRouteTableAssociation)
+	d.Set("original_default_route_table_id", tgw.Options.AssociationDefaultRouteTableId)
+
+	if _, err := waitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	tgw, err := findTransitGatewayByID(ctx, conn, d.Id())
+
+	if err == nil && tgw.Options.DefaultRouteTableAssociation == awstypes.DefaultRouteTableAssociationValueDisable {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Default Route Table Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrTransitGatewayID, tgw.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", tgw.Options.AssociationDefaultRouteTableId)
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &awstypes.ModifyTransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String(d.Get("transit_gateway_route_table_id").(string)),
+		},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+
+	if _, err := conn.ModifyTransitGateway(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Restore the Transit Gateway's original default association setting and route table.
+	// If there was no route table, turn off default route table association.
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &awstypes.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("original_default_route_table_id"); ok && d.Get("original_default_route_table_association").(string) != string(awstypes.DefaultRouteTableAssociationValueDisable) {
+		input.Options.AssociationDefaultRouteTableId = aws.String(v.(string))
+	} else {
+		input.Options.DefaultRouteTableAssociation = awstypes.DefaultRouteTableAssociationValueDisable
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Default Route Table Association: %s", d.Id())
+	_, err := conn.ModifyTransitGateway(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -159,6 +160,13 @@ func resourceVPCEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"service_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -213,16 +221,40 @@ func resourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			vpcEndpointServiceRegionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// vpcEndpointServiceRegionCustomizeDiff verifies that only interface endpoints connect to
+// services hosted in another Region. The inbound Resolver endpoint private DNS option isn't
+// supported for cross-Region endpoints.
+func vpcEndpointServiceRegionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !isCrossRegionVPCEndpoint(d.Get("service_region").(string), meta.(*conns.AWSClient).Region) {
+		return nil
+	}
+
+	if v := d.Get("vpc_endpoint_type").(string); v != string(awstypes.VpcEndpointTypeInterface) {
+		return fmt.Errorf(`"service_region" can only differ from the provider Region for VPC endpoints of type %q, got %q`, awstypes.VpcEndpointTypeInterface, v)
+	}
+
+	if v, ok := d.GetOk("dns_options.0.private_dns_only_for_inbound_resolver_endpoint"); ok && v.(bool) {
+		return fmt.Errorf(`"dns_options.0.private_dns_only_for_inbound_resolver_endpoint" cannot be set for cross-Region VPC endpoints`)
+	}
+
+	return nil
+}
+
 func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 	partition := meta.(*conns.AWSClient).Partition
 
 	serviceName := d.Get(names.AttrServiceName).(string)
+	serviceRegion := d.Get("service_region").(string)
+	crossRegion := isCrossRegionVPCEndpoint(serviceRegion, meta.(*conns.AWSClient).Region)
 	input := &ec2.CreateVpcEndpointInput{
 		ClientToken:       aws.String(id.UniqueId()),
 		PrivateDnsEnabled: aws.Bool(d.Get("private_dns_enabled").(bool)),
@@ -234,8 +266,8 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	if v, ok := d.GetOk("dns_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		// PrivateDnsOnlyForInboundResolverEndpoint is only supported for services
-		// that support both gateway and interface endpoints, i.e. S3.
-		if isAmazonS3VPCEndpoint(serviceName) {
+		// that support both gateway and interface endpoints, i.e. S3, in the same Region.
+		if isAmazonS3VPCEndpoint(serviceName) && !crossRegion {
 			input.DnsOptions = expandDNSOptionsSpecificationWithPrivateDNSOnly(v.([]interface{})[0].(map[string]interface{}))
 		} else {
 			input.DnsOptions = expandDNSOptionsSpecification(v.([]interface{})[0].(map[string]interface{}))
@@ -272,6 +304,14 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if crossRegion {
+		if _, err := findVPCEndpointServiceByNameAndRegion(ctx, conn, serviceName, serviceRegion); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s) in Region (%s): %s", serviceName, serviceRegion, err)
+		}
+
+		input.ServiceRegion = aws.String(serviceRegion)
+	}
+
 	output, err := conn.CreateVpcEndpoint(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -357,6 +397,7 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("route_table_ids", vpce.RouteTableIds)
 	d.Set(names.AttrSecurityGroupIDs, flattenSecurityGroupIdentifiers(vpce.Groups))
 	d.Set(names.AttrServiceName, serviceName)
+	d.Set("service_region", vpce.ServiceRegion)
 	d.Set(names.AttrState, vpce.State)
 	d.Set(names.AttrSubnetIDs, vpce.SubnetIds)
 	// VPC endpoints don't have types in GovCloud, so set type to default if empty
@@ -426,8 +467,8 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 			if v, ok := d.GetOk("dns_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				tfMap := v.([]interface{})[0].(map[string]interface{})
 				// PrivateDnsOnlyForInboundResolverEndpoint is only supported for services
-				// that support both gateway and interface endpoints, i.e. S3.
-				if isAmazonS3VPCEndpoint(d.Get(names.AttrServiceName).(string)) {
+				// that support both gateway and interface endpoints, i.e. S3, in the same Region.
+				if isAmazonS3VPCEndpoint(d.Get(names.AttrServiceName).(string)) && !isCrossRegionVPCEndpoint(d.Get("service_region").(string), meta.(*conns.AWSClient).Region) {
 					input.DnsOptions = expandDNSOptionsSpecificationWithPrivateDNSOnly(tfMap)
 				} else {
 					input.DnsOptions = expandDNSOptionsSpecification(tfMap)
@@ -570,6 +611,10 @@ func isAmazonS3VPCEndpoint(serviceName string) bool {
 	return ok
 }
 
+func isCrossRegionVPCEndpoint(serviceRegion, region string) bool {
+	return serviceRegion != "" && serviceRegion != region
+}
+
 func expandDNSOptionsSpecification(tfMap map[string]interface{}) *awstypes.DnsOptionsSpecification {
 	if tfMap == nil {
 		return nil
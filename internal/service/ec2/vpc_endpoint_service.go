@@ -88,6 +88,12 @@ func resourceVPCEndpointService() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"payer_responsibility": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PayerResponsibility](),
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -138,6 +144,15 @@ func resourceVPCEndpointService() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.ServiceConnectivityType](),
 				},
 			},
+			"supported_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -178,6 +193,10 @@ func resourceVPCEndpointServiceCreate(ctx context.Context, d *schema.ResourceDat
 		input.SupportedIpAddressTypes = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("supported_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.SupportedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 VPC Endpoint Service: %v", input)
 	output, err := conn.CreateVpcEndpointServiceConfiguration(ctx, input)
 
@@ -191,6 +210,12 @@ func resourceVPCEndpointServiceCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("payer_responsibility"); ok {
+		if err := modifyVPCEndpointServicePayerResponsibility(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_principals"); ok && v.(*schema.Set).Len() > 0 {
 		input := &ec2.ModifyVpcEndpointServicePermissionsInput{
 			AddAllowedPrincipals: flex.ExpandStringValueSet(v.(*schema.Set)),
@@ -235,6 +260,7 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("gateway_load_balancer_arns", svcCfg.GatewayLoadBalancerArns)
 	d.Set("manages_vpc_endpoints", svcCfg.ManagesVpcEndpoints)
 	d.Set("network_load_balancer_arns", svcCfg.NetworkLoadBalancerArns)
+	d.Set("payer_responsibility", svcCfg.PayerResponsibility)
 	d.Set("private_dns_name", svcCfg.PrivateDnsName)
 	// The EC2 API can return a XML structure with no elements.
 	if tfMap := flattenPrivateDNSNameConfiguration(svcCfg.PrivateDnsNameConfiguration); len(tfMap) > 0 {
@@ -252,6 +278,7 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 	}
 	d.Set(names.AttrState, svcCfg.ServiceState)
 	d.Set("supported_ip_address_types", svcCfg.SupportedIpAddressTypes)
+	d.Set("supported_regions", flattenSupportedRegionDetails(svcCfg.SupportedRegions))
 
 	setTagsOut(ctx, svcCfg.Tags)
 
@@ -270,7 +297,7 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	if d.HasChanges("acceptance_required", "gateway_load_balancer_arns", "network_load_balancer_arns", "private_dns_name", "supported_ip_address_types") {
+	if d.HasChanges("acceptance_required", "gateway_load_balancer_arns", "network_load_balancer_arns", "private_dns_name", "supported_ip_address_types", "supported_regions") {
 		input := &ec2.ModifyVpcEndpointServiceConfigurationInput{
 			ServiceId: aws.String(d.Id()),
 		}
@@ -287,6 +314,7 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		input.AddSupportedIpAddressTypes, input.RemoveSupportedIpAddressTypes = flattenAddAndRemoveStringValueLists(d, "supported_ip_address_types")
+		input.AddSupportedRegions, input.RemoveSupportedRegions = flattenAddAndRemoveStringValueLists(d, "supported_regions")
 
 		log.Printf("[DEBUG] Updating EC2 VPC Endpoint Service: %v", input)
 		_, err := conn.ModifyVpcEndpointServiceConfiguration(ctx, input)
@@ -300,6 +328,12 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("payer_responsibility") {
+		if err := modifyVPCEndpointServicePayerResponsibility(ctx, conn, d.Id(), d.Get("payer_responsibility").(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("allowed_principals") {
 		input := &ec2.ModifyVpcEndpointServicePermissionsInput{
 			ServiceId: aws.String(d.Id()),
@@ -343,6 +377,21 @@ func resourceVPCEndpointServiceDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func modifyVPCEndpointServicePayerResponsibility(ctx context.Context, conn *ec2.Client, id, payerResponsibility string) error {
+	input := &ec2.ModifyVpcEndpointServicePayerResponsibilityInput{
+		PayerResponsibility: awstypes.PayerResponsibility(payerResponsibility),
+		ServiceId:           aws.String(id),
+	}
+
+	_, err := conn.ModifyVpcEndpointServicePayerResponsibility(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying EC2 VPC Endpoint Service (%s) payer responsibility: %w", id, err)
+	}
+
+	return nil
+}
+
 func flattenAllowedPrincipals(apiObjects []awstypes.AllowedPrincipal) []*string {
 	if len(apiObjects) == 0 {
 		return nil
@@ -357,6 +406,20 @@ func flattenAllowedPrincipals(apiObjects []awstypes.AllowedPrincipal) []*string
 	return tfList
 }
 
+func flattenSupportedRegionDetails(apiObjects []awstypes.SupportedRegionDetail) []*string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []*string
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, apiObject.Region)
+	}
+
+	return tfList
+}
+
 func flattenPrivateDNSNameConfiguration(apiObject *awstypes.PrivateDnsNameConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
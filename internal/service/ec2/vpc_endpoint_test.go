@@ -49,6 +49,7 @@ func TestAccVPCEndpoint_gatewayBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "requester_managed", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "route_table_ids.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "service_region", acctest.Region()),
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_type", "Gateway"),
@@ -833,6 +834,27 @@ func TestAccVPCEndpoint_interfaceUserDefinedDualstack(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpoint_crossRegionGateway(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCEndpointConfig_crossRegionGateway(rName, acctest.AlternateRegion()),
+				ExpectError: regexache.MustCompile(`"service_region" can only differ from the provider Region for VPC endpoints of type "Interface"`),
+			},
+		},
+	})
+}
+
 func TestAccVPCEndpoint_VPCEndpointType_gatewayLoadBalancer(t *testing.T) {
 	ctx := acctest.Context(t)
 	var endpoint awstypes.VpcEndpoint
@@ -1078,6 +1100,24 @@ resource "aws_vpc_endpoint" "test" {
 `, rName)
 }
 
+func testAccVPCEndpointConfig_crossRegionGateway(rName, serviceRegion string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id         = aws_vpc.test.id
+  service_name   = "com.amazonaws.%[2]s.s3"
+  service_region = %[2]q
+}
+`, rName, serviceRegion)
+}
+
 func testAccVPCEndpointConfig_interfaceNoPrivateDNS(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
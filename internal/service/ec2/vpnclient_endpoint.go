@@ -13,7 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,7 +43,10 @@ func resourceClientVPNEndpoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceClientVPNEndpointCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -481,6 +486,55 @@ func resourceClientVPNEndpointDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func resourceClientVPNEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Validate against the raw configuration so that values not yet known at plan time are skipped.
+	if v := diff.GetRawConfig().GetAttr("authentication_options"); v.IsKnown() && !v.IsNull() {
+		for _, v := range v.AsValueSlice() {
+			authnType := v.GetAttr(names.AttrType)
+			if !authnType.IsKnown() || authnType.IsNull() {
+				continue
+			}
+
+			switch authnType := authnType.AsString(); authnType {
+			case string(awstypes.ClientVpnAuthenticationTypeCertificateAuthentication):
+				if v.GetAttr("root_certificate_chain_arn").IsNull() {
+					return fmt.Errorf(`authentication_options.root_certificate_chain_arn is required with type = "%s"`, authnType)
+				}
+
+			case string(awstypes.ClientVpnAuthenticationTypeDirectoryServiceAuthentication):
+				if v.GetAttr("active_directory_id").IsNull() {
+					return fmt.Errorf(`authentication_options.active_directory_id is required with type = "%s"`, authnType)
+				}
+
+			case string(awstypes.ClientVpnAuthenticationTypeFederatedAuthentication):
+				if v.GetAttr("saml_provider_arn").IsNull() {
+					return fmt.Errorf(`authentication_options.saml_provider_arn is required with type = "%s"`, authnType)
+				}
+			}
+
+			if authnType.AsString() != string(awstypes.ClientVpnAuthenticationTypeFederatedAuthentication) && !v.GetAttr("self_service_saml_provider_arn").IsNull() {
+				return fmt.Errorf(`authentication_options.self_service_saml_provider_arn is only supported with type = "%s"`, awstypes.ClientVpnAuthenticationTypeFederatedAuthentication)
+			}
+		}
+	}
+
+	if v := diff.GetRawConfig().GetAttr("client_connect_options"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		v := v.Index(cty.NumberIntVal(0))
+		if enabled := v.GetAttr(names.AttrEnabled); enabled.IsKnown() && !enabled.IsNull() && enabled.True() && v.GetAttr("lambda_function_arn").IsNull() {
+			return fmt.Errorf("client_connect_options.lambda_function_arn is required when client_connect_options.enabled is true")
+		}
+	}
+
+	if v := diff.GetRawConfig().GetAttr("client_login_banner_options"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		v := v.Index(cty.NumberIntVal(0))
+		if enabled := v.GetAttr(names.AttrEnabled); enabled.IsKnown() && !enabled.IsNull() && enabled.True() && v.GetAttr("banner_text").IsNull() {
+			return fmt.Errorf("client_login_banner_options.banner_text is required when client_login_banner_options.enabled is true")
+		}
+	}
+
+	return nil
+}
+
 func expandClientVPNAuthenticationRequest(tfMap map[string]interface{}) *awstypes.ClientVpnAuthenticationRequest {
 	if tfMap == nil {
 		return nil
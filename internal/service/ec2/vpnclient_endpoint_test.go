@@ -616,6 +616,70 @@ func testAccClientVPNEndpoint_selfServicePortal(t *testing.T, semaphore tfsync.S
 	})
 }
 
+func testAccClientVPNEndpoint_mutuallyRequiredAttributes(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckClientVPNSyncronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientVPNEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientVPNEndpointConfig_mutuallyRequiredAttributes(`
+  authentication_options {
+    type = "certificate-authentication"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`root_certificate_chain_arn is required`),
+			},
+			{
+				Config: testAccClientVPNEndpointConfig_mutuallyRequiredAttributes(`
+  authentication_options {
+    type                           = "certificate-authentication"
+    root_certificate_chain_arn     = local.certificate_arn
+    self_service_saml_provider_arn = local.saml_provider_arn
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`self_service_saml_provider_arn is only supported with type = "federated-authentication"`),
+			},
+			{
+				Config: testAccClientVPNEndpointConfig_mutuallyRequiredAttributes(`
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = local.certificate_arn
+  }
+
+  client_connect_options {
+    enabled = true
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`lambda_function_arn is required`),
+			},
+			{
+				Config: testAccClientVPNEndpointConfig_mutuallyRequiredAttributes(`
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = local.certificate_arn
+  }
+
+  client_login_banner_options {
+    enabled = true
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`banner_text is required`),
+			},
+		},
+	})
+}
+
 func testAccClientVPNEndpoint_vpcNoSecurityGroups(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.ClientVpnEndpoint
@@ -1237,6 +1301,30 @@ resource "aws_ec2_client_vpn_endpoint" "test" {
 `, rName, selfServicePortal, idpEntityID))
 }
 
+func testAccClientVPNEndpointConfig_mutuallyRequiredAttributes(blocks string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+locals {
+  certificate_arn   = "arn:${data.aws_partition.current.partition}:acm:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:certificate/00000000-0000-0000-0000-000000000000"
+  saml_provider_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:saml-provider/tf-acc-test"
+}
+
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  server_certificate_arn = local.certificate_arn
+  client_cidr_block      = "10.0.0.0/16"
+%[1]s
+  connection_log_options {
+    enabled = false
+  }
+}
+`, blocks)
+}
+
 func testAccClientVPNEndpointConfig_securityGroups(t *testing.T, rName string, nSecurityGroups int) string {
 	return acctest.ConfigCompose(
 		testAccClientVPNEndpointConfig_acmCertificateBase(t, "test"),
@@ -34,6 +34,7 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 			"tags":                         testAccClientVPNEndpoint_tags,
 			"simpleAttributesUpdate":       testAccClientVPNEndpoint_simpleAttributesUpdate,
 			"selfServicePortal":            testAccClientVPNEndpoint_selfServicePortal,
+			"mutuallyRequiredAttributes":   testAccClientVPNEndpoint_mutuallyRequiredAttributes,
 			"vpcNoSecurityGroups":          testAccClientVPNEndpoint_vpcNoSecurityGroups,
 			"vpcSecurityGroups":            testAccClientVPNEndpoint_vpcSecurityGroups,
 			"basicDataSource":              testAccClientVPNEndpointDataSource_basic,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -56,6 +57,24 @@ func validRedisVersionString(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+const (
+	valkeyVersionRegexpPattern = `^[7-9]\.[[:digit:]]+$`
+)
+
+var (
+	valkeyVersionRegexp = regexache.MustCompile(valkeyVersionRegexpPattern)
+)
+
+func validValkeyVersionString(v any, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !valkeyVersionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s: %s is invalid. For Valkey, use <major>.<minor>.", k, value))
+	}
+
+	return
+}
+
 // customizeDiffValidateClusterEngineVersion validates the correct format for `engine_version`, based on `engine`
 func customizeDiffValidateClusterEngineVersion(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	engineVersion, ok := diff.GetOk(names.AttrEngineVersion)
@@ -82,6 +101,32 @@ func validateClusterEngineVersion(engine, engineVersion string) error {
 	return errors.Join(errs...)
 }
 
+// customizeDiffValidateReplicationGroupEngineVersion validates the correct format for `engine_version`, based on `engine`
+func customizeDiffValidateReplicationGroupEngineVersion(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	engineVersion, ok := diff.GetOk(names.AttrEngineVersion)
+	if !ok {
+		return nil
+	}
+
+	return validateReplicationGroupEngineVersion(diff.Get(names.AttrEngine).(string), engineVersion.(string))
+}
+
+// validateReplicationGroupEngineVersion validates the correct format for `engine_version`, based on `engine`
+func validateReplicationGroupEngineVersion(engine, engineVersion string) error {
+	// Valkey: Versions in format <major>.<minor>
+	// Redis: Starting with version 6, must match <major>.<minor>, prior to version 6, <major>.<minor>.<patch>
+	var validator schema.SchemaValidateFunc
+	if strings.EqualFold(engine, engineValkey) {
+		validator = validValkeyVersionString
+	} else {
+		validator = validRedisVersionString
+	}
+
+	_, errs := validator(engineVersion, names.AttrEngineVersion)
+
+	return errors.Join(errs...)
+}
+
 // customizeDiffEngineVersionForceNewOnDowngrade causes re-creation of the resource if the version is being downgraded
 func customizeDiffEngineVersionForceNewOnDowngrade(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	return engineVersionForceNewOnDowngrade(diff)
@@ -299,6 +299,136 @@ func TestValidateClusterEngineVersion(t *testing.T) {
 	}
 }
 
+func TestValidValkeyVersionString(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		version string
+		valid   bool
+	}{
+		{
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			version: "8.0",
+			valid:   true,
+		},
+		{
+			version: "7.2.6",
+			valid:   false,
+		},
+		{
+			version: "7.x",
+			valid:   false,
+		},
+		{
+			version: "6.2",
+			valid:   false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.version, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, errors := tfelasticache.ValidValkeyVersionString(testcase.version, names.AttrKey)
+
+			if l := len(warnings); l != 0 {
+				t.Errorf("expected no warnings, got %d", l)
+			}
+
+			if testcase.valid {
+				if l := len(errors); l != 0 {
+					t.Errorf("expected no errors, got %d: %v", l, errors)
+				}
+			} else {
+				if l := len(errors); l == 0 {
+					t.Error("expected one error, got none")
+				} else if l > 1 {
+					t.Errorf("expected one error, got %d: %v", l, errors)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateReplicationGroupEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		engine  string
+		version string
+		valid   bool
+	}{
+		// Empty engine value is Redis
+		{
+			engine:  "",
+			version: "1.2.3",
+			valid:   true,
+		},
+		{
+			engine:  "",
+			version: "7.0",
+			valid:   true,
+		},
+
+		{
+			engine:  tfelasticache.EngineRedis,
+			version: "6.x",
+			valid:   true,
+		},
+		{
+			engine:  tfelasticache.EngineRedis,
+			version: "7.1",
+			valid:   true,
+		},
+
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "8.0",
+			valid:   true,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "6.x",
+			valid:   false,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "5.0.6",
+			valid:   false,
+		},
+		{
+			engine:  "Valkey",
+			version: "7.2",
+			valid:   true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(fmt.Sprintf("%s %s", testcase.engine, testcase.version), func(t *testing.T) {
+			t.Parallel()
+			err := tfelasticache.ValidateReplicationGroupEngineVersion(testcase.engine, testcase.version)
+
+			if testcase.valid {
+				if err != nil {
+					t.Errorf("expected no error, got %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Error("expected an error, got none")
+				}
+			}
+		})
+	}
+}
+
 type mockGetChangeDiffer struct {
 	old, new string
 }
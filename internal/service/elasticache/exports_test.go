@@ -5,15 +5,16 @@ package elasticache
 
 // Exports for use in tests only.
 var (
-	ResourceCluster                = resourceCluster
-	ResourceGlobalReplicationGroup = resourceGlobalReplicationGroup
-	ResourceParameterGroup         = resourceParameterGroup
-	ResourceReplicationGroup       = resourceReplicationGroup
-	ResourceServerlessCache        = newServerlessCacheResource
-	ResourceSubnetGroup            = resourceSubnetGroup
-	ResourceUser                   = resourceUser
-	ResourceUserGroup              = resourceUserGroup
-	ResourceUserGroupAssociation   = resourceUserGroupAssociation
+	ResourceCluster                 = resourceCluster
+	ResourceGlobalReplicationGroup  = resourceGlobalReplicationGroup
+	ResourceParameterGroup          = resourceParameterGroup
+	ResourceReplicationGroup        = resourceReplicationGroup
+	ResourceServerlessCache         = newServerlessCacheResource
+	ResourceServerlessCacheSnapshot = newServerlessCacheSnapshotResource
+	ResourceSubnetGroup             = resourceSubnetGroup
+	ResourceUser                    = resourceUser
+	ResourceUserGroup               = resourceUserGroup
+	ResourceUserGroupAssociation    = resourceUserGroupAssociation
 
 	FindCacheClusterByID                 = findCacheClusterByID
 	FindCacheParameterGroup              = findCacheParameterGroup
@@ -22,6 +23,7 @@ var (
 	FindGlobalReplicationGroupByID       = findGlobalReplicationGroupByID
 	FindReplicationGroupByID             = findReplicationGroupByID
 	FindServerlessCacheByID              = findServerlessCacheByID
+	FindServerlessCacheSnapshotByID      = findServerlessCacheSnapshotByID
 	FindUserByID                         = findUserByID
 	FindUserGroupByID                    = findUserGroupByID
 	FindUserGroupAssociationByTwoPartKey = findUserGroupAssociationByTwoPartKey
@@ -35,14 +37,17 @@ var (
 	EmptyDescription                          = emptyDescription
 	EngineMemcached                           = engineMemcached
 	EngineRedis                               = engineRedis
+	EngineValkey                              = engineValkey
 	EngineVersionForceNewOnDowngrade          = engineVersionForceNewOnDowngrade
 	EngineVersionIsDowngrade                  = engineVersionIsDowngrade
 	GlobalReplicationGroupRegionPrefixFormat  = globalReplicationGroupRegionPrefixFormat
 	NormalizeEngineVersion                    = normalizeEngineVersion
 	ParamGroupNameRequiresMajorVersionUpgrade = paramGroupNameRequiresMajorVersionUpgrade
 	ValidateClusterEngineVersion              = validateClusterEngineVersion
+	ValidateReplicationGroupEngineVersion     = validateReplicationGroupEngineVersion
 	ValidMemcachedVersionString               = validMemcachedVersionString
 	ValidRedisVersionString                   = validRedisVersionString
+	ValidValkeyVersionString                  = validValkeyVersionString
 )
 
 type (
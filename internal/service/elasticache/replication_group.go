@@ -124,15 +124,13 @@ func resourceReplicationGroup() *schema.Resource {
 			names.AttrEngine: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      engineRedis,
-				ValidateFunc: validation.StringInSlice([]string{engineRedis}, true),
+				ValidateFunc: validation.StringInSlice([]string{engineRedis, engineValkey}, true),
 			},
 			names.AttrEngineVersion: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validRedisVersionString,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 			"engine_version_actual": {
 				Type:     schema.TypeString,
@@ -395,7 +393,12 @@ func resourceReplicationGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			replicationGroupValidateMultiAZAutomaticFailover,
+			customizeDiffValidateReplicationGroupEngineVersion,
 			customizeDiffEngineVersionForceNewOnDowngrade,
+			customdiff.ForceNewIfChange(names.AttrEngine, func(_ context.Context, old, new, meta interface{}) bool {
+				// Only an upgrade from Redis OSS to Valkey can be made in place.
+				return !strings.EqualFold(old.(string), engineRedis) || !strings.EqualFold(new.(string), engineValkey)
+			}),
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
 					diff.HasChange("num_node_groups") ||
@@ -407,6 +410,7 @@ func resourceReplicationGroup() *schema.Resource {
 				return semver.LessThan(d.Get("engine_version_actual").(string), "7.0.5")
 			}),
 			replicationGroupValidateAutomaticFailoverNumCacheClusters,
+			replicationGroupValidateNumNodeGroupsClusterMode,
 			verify.SetTagsDiff,
 		),
 	}
@@ -774,9 +778,14 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		o, n := d.GetChange("num_cache_clusters")
 		oldCacheClusterCount, newCacheClusterCount := o.(int), n.(int)
 
+		// Online resharding requires cluster mode, so shard configuration changes are deferred until any cluster mode change has been applied.
+		deferShardConfiguration := d.HasChange("cluster_mode")
+
 		if d.HasChanges("num_node_groups", "replicas_per_node_group") {
-			if err := modifyReplicationGroupShardConfiguration(ctx, conn, d); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+			if !deferShardConfiguration {
+				if err := modifyReplicationGroupShardConfiguration(ctx, conn, d); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
 			}
 		} else if d.HasChange("num_cache_clusters") {
 			if newCacheClusterCount > oldCacheClusterCount {
@@ -816,6 +825,11 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
+		if d.HasChange(names.AttrEngine) {
+			input.Engine = aws.String(d.Get(names.AttrEngine).(string))
+			requestUpdate = true
+		}
+
 		if d.HasChange(names.AttrEngineVersion) {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 			requestUpdate = true
@@ -984,6 +998,12 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if deferShardConfiguration && d.HasChanges("num_node_groups", "replicas_per_node_group") {
+			if err := modifyReplicationGroupShardConfiguration(ctx, conn, d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		if d.HasChange("num_cache_clusters") {
 			if newCacheClusterCount < oldCacheClusterCount {
 				if err := decreaseReplicationGroupReplicaCount(ctx, conn, d.Id(), newCacheClusterCount, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -1122,8 +1142,8 @@ func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context,
 	const (
 		delay = 30 * time.Second
 	)
-	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
-		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
+	if _, err := waitReplicationGroupReshardingCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) resharding: %w", d.Id(), err)
 	}
 
 	return nil
@@ -1311,6 +1331,51 @@ func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client
 	return nil, err
 }
 
+// statusReplicationGroupResharding reports a replication group as modifying until any online resharding slot migration has completed.
+func statusReplicationGroupResharding(ctx context.Context, conn *elasticache.Client, replicationGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationGroupByID(ctx, conn, replicationGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.PendingModifiedValues; v != nil && v.Resharding != nil && v.Resharding.SlotMigration != nil {
+			log.Printf("[DEBUG] ElastiCache Replication Group (%s) resharding slot migration progress: %.1f%%", replicationGroupID, aws.ToFloat64(v.Resharding.SlotMigration.ProgressPercentage))
+
+			return output, replicationGroupStatusModifying, nil
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitReplicationGroupReshardingCompleted(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration, delay time.Duration) (*awstypes.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationGroupStatusModifying,
+			replicationGroupStatusSnapshotting,
+		},
+		Target:     []string{replicationGroupStatusAvailable},
+		Refresh:    statusReplicationGroupResharding(ctx, conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -1439,3 +1504,20 @@ func replicationGroupValidateAutomaticFailoverNumCacheClusters(_ context.Context
 	}
 	return errors.New(`"num_cache_clusters": must be at least 2 if automatic_failover_enabled is true`)
 }
+
+func replicationGroupValidateNumNodeGroupsClusterMode(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("num_node_groups", "cluster_mode") {
+		return nil
+	}
+	raw := diff.GetRawConfig().GetAttr("num_node_groups")
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil
+	}
+	if raw.LessThanOrEqualTo(cty.NumberIntVal(1)).True() {
+		return nil
+	}
+	if v := diff.GetRawConfig().GetAttr("cluster_mode"); !v.IsKnown() || v.IsNull() || v.AsString() != string(awstypes.ClusterModeDisabled) {
+		return nil
+	}
+	return errors.New(`"num_node_groups": must be 1 if cluster_mode is "disabled"`)
+}
@@ -10,31 +10,58 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/stateupgrade"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func replicationGroupStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	if rawState == nil {
-		rawState = map[string]interface{}{}
-	}
+// replicationGroupStateUpgradeV1 upgrades replication group state from schema version 1.
+var replicationGroupStateUpgradeV1 = stateupgrade.Upgrade("aws_elasticache_replication_group", 1,
+	replicationGroupAuthTokenUpdateStrategyConverter,
+	replicationGroupClusterModeBlockConverter,
+)
+
+// Set auth_token_update_strategy to new default value when it isn't already set.
+var replicationGroupAuthTokenUpdateStrategyConverter = stateupgrade.Converter{
+	Description: "set auth_token_update_strategy to its default value (added in v5.27.0)",
+	Detect: func(rawState map[string]any) bool {
+		v, ok := rawState["auth_token_update_strategy"]
+
+		return !ok || v == nil || v == ""
+	},
+	Convert: func(_ context.Context, rawState map[string]any) error {
+		rawState["auth_token_update_strategy"] = awstypes.AuthTokenUpdateStrategyTypeRotate
 
-	// Set auth_token_update_strategy to new default value.
-	rawState["auth_token_update_strategy"] = awstypes.AuthTokenUpdateStrategyTypeRotate
+		return nil
+	},
+}
 
-	// The v4.67.0 schema contained block attribute named 'cluster_mode'.
-	// It was removed at v5.0.0.
-	// The v5.59.0 schema introduced a new string attribute named 'cluster_mode'.
-	// Remove any trace of the old cluster_mode block.
-	for _, k := range tfmaps.Keys(rawState) {
-		if strings.HasPrefix(k, "cluster_mode.") {
-			delete(rawState, k)
+// The v4.67.0 schema contained block attribute named 'cluster_mode'.
+// It was removed at v5.0.0.
+// The v5.59.0 schema introduced a new string attribute named 'cluster_mode'.
+// Remove any trace of the old cluster_mode block.
+var replicationGroupClusterModeBlockConverter = stateupgrade.Converter{
+	Description: "remove cluster_mode configuration block (removed in v5.0.0)",
+	Detect: func(rawState map[string]any) bool {
+		for k := range rawState {
+			if k == "cluster_mode" || strings.HasPrefix(k, "cluster_mode.") {
+				return true
+			}
 		}
-	}
-	delete(rawState, "cluster_mode")
 
-	return rawState, nil
+		return false
+	},
+	Convert: func(_ context.Context, rawState map[string]any) error {
+		for _, k := range tfmaps.Keys(rawState) {
+			if strings.HasPrefix(k, "cluster_mode.") {
+				delete(rawState, k)
+			}
+		}
+		delete(rawState, "cluster_mode")
+
+		return nil
+	},
 }
 
 func resourceReplicationGroupConfigV1() *schema.Resource {
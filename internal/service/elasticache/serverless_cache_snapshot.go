@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Serverless Cache Snapshot")
+// @Tags(identifierAttribute="arn")
+func newServerlessCacheSnapshotResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serverlessCacheSnapshotResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type serverlessCacheSnapshotResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*serverlessCacheSnapshotResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_elasticache_serverless_cache_snapshot"
+}
+
+func (r *serverlessCacheSnapshotResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bytes_used_for_cache": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"export_s3_bucket_name": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *serverlessCacheSnapshotResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serverlessCacheSnapshotResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	input := &elasticache.CreateServerlessCacheSnapshotInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	// The serverless cache may be briefly unavailable, e.g. while taking its daily snapshot.
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidServerlessCacheStateFault](ctx, 5*time.Minute, func() (interface{}, error) {
+		return conn.CreateServerlessCacheSnapshot(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating ElastiCache Serverless Cache Snapshot", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := waitServerlessCacheSnapshotAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache Snapshot (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !data.ExportS3BucketName.IsNull() {
+		output, err = exportServerlessCacheSnapshot(ctx, conn, data.ID.ValueString(), data.ExportS3BucketName.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("exporting ElastiCache Serverless Cache Snapshot (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serverlessCacheSnapshotResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serverlessCacheSnapshotResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	output, err := findServerlessCacheSnapshotByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache Snapshot (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serverlessCacheSnapshotResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serverlessCacheSnapshotResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	// Each change of destination bucket exports the snapshot again.
+	if !new.ExportS3BucketName.IsNull() && !new.ExportS3BucketName.Equal(old.ExportS3BucketName) {
+		if _, err := exportServerlessCacheSnapshot(ctx, conn, new.ID.ValueString(), new.ExportS3BucketName.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("exporting ElastiCache Serverless Cache Snapshot (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findServerlessCacheSnapshotByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache Snapshot (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serverlessCacheSnapshotResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serverlessCacheSnapshotResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	tflog.Debug(ctx, "deleting ElastiCache Serverless Cache Snapshot", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := &elasticache.DeleteServerlessCacheSnapshotInput{
+		ServerlessCacheSnapshotName: fwflex.StringFromFramework(ctx, data.ID),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidServerlessCacheSnapshotStateFault](ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.DeleteServerlessCacheSnapshot(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ServerlessCacheSnapshotNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ElastiCache Serverless Cache Snapshot (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitServerlessCacheSnapshotDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Serverless Cache Snapshot (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *serverlessCacheSnapshotResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func exportServerlessCacheSnapshot(ctx context.Context, conn *elasticache.Client, id, bucket string, timeout time.Duration) (*awstypes.ServerlessCacheSnapshot, error) {
+	input := &elasticache.ExportServerlessCacheSnapshotInput{
+		S3BucketName:                aws.String(bucket),
+		ServerlessCacheSnapshotName: aws.String(id),
+	}
+
+	_, err := conn.ExportServerlessCacheSnapshot(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	output, err := waitServerlessCacheSnapshotAvailable(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for export to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return output, nil
+}
+
+func findServerlessCacheSnapshot(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeServerlessCacheSnapshotsInput) (*awstypes.ServerlessCacheSnapshot, error) {
+	output, err := findServerlessCacheSnapshots(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findServerlessCacheSnapshots(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeServerlessCacheSnapshotsInput) ([]awstypes.ServerlessCacheSnapshot, error) {
+	var output []awstypes.ServerlessCacheSnapshot
+
+	pages := elasticache.NewDescribeServerlessCacheSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ServerlessCacheSnapshotNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServerlessCacheSnapshots...)
+	}
+
+	return output, nil
+}
+
+func findServerlessCacheSnapshotByID(ctx context.Context, conn *elasticache.Client, id string) (*awstypes.ServerlessCacheSnapshot, error) {
+	input := &elasticache.DescribeServerlessCacheSnapshotsInput{
+		ServerlessCacheSnapshotName: aws.String(id),
+	}
+
+	return findServerlessCacheSnapshot(ctx, conn, input)
+}
+
+func statusServerlessCacheSnapshot(ctx context.Context, conn *elasticache.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findServerlessCacheSnapshotByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+const (
+	serverlessCacheSnapshotStatusAvailable = "available"
+	serverlessCacheSnapshotStatusCreating  = "creating"
+	serverlessCacheSnapshotStatusDeleting  = "deleting"
+	serverlessCacheSnapshotStatusExporting = "exporting"
+)
+
+func waitServerlessCacheSnapshotAvailable(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.ServerlessCacheSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			serverlessCacheSnapshotStatusCreating,
+			serverlessCacheSnapshotStatusExporting,
+		},
+		Target:     []string{serverlessCacheSnapshotStatusAvailable},
+		Refresh:    statusServerlessCacheSnapshot(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServerlessCacheSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServerlessCacheSnapshotDeleted(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.ServerlessCacheSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			serverlessCacheSnapshotStatusAvailable,
+			serverlessCacheSnapshotStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    statusServerlessCacheSnapshot(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServerlessCacheSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type serverlessCacheSnapshotResourceModel struct {
+	ARN                         types.String      `tfsdk:"arn"`
+	BytesUsedForCache           types.String      `tfsdk:"bytes_used_for_cache"`
+	CreateTime                  timetypes.RFC3339 `tfsdk:"create_time"`
+	ExpiryTime                  timetypes.RFC3339 `tfsdk:"expiry_time"`
+	ExportS3BucketName          types.String      `tfsdk:"export_s3_bucket_name"`
+	ID                          types.String      `tfsdk:"id"`
+	KmsKeyID                    types.String      `tfsdk:"kms_key_id"`
+	ServerlessCacheName         types.String      `tfsdk:"serverless_cache_name"`
+	ServerlessCacheSnapshotName types.String      `tfsdk:"name"`
+	SnapshotType                types.String      `tfsdk:"snapshot_type"`
+	Status                      types.String      `tfsdk:"status"`
+	Tags                        tftags.Map        `tfsdk:"tags"`
+	TagsAll                     tftags.Map        `tfsdk:"tags_all"`
+	Timeouts                    timeouts.Value    `tfsdk:"timeouts"`
+}
+
+func (data *serverlessCacheSnapshotResourceModel) setID() {
+	data.ID = data.ServerlessCacheSnapshotName
+}
+
+func (data *serverlessCacheSnapshotResourceModel) InitFromID() error {
+	data.ServerlessCacheSnapshotName = data.ID
+
+	return nil
+}
+
+func (data *serverlessCacheSnapshotResourceModel) flatten(ctx context.Context, apiObject *awstypes.ServerlessCacheSnapshot) diag.Diagnostics {
+	diags := fwflex.Flatten(ctx, apiObject, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.ServerlessCacheConfiguration; v != nil {
+		data.ServerlessCacheName = fwflex.StringToFramework(ctx, v.ServerlessCacheName)
+	}
+
+	return diags
+}
@@ -56,7 +56,8 @@ func resourceCluster() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		SchemaFunc: func() map[string]*schema.Schema {
-			instanceFleetConfigSchema := func() *schema.Resource {
+			// The master instance fleet always has a target capacity of 1 and cannot be modified.
+			instanceFleetConfigSchema := func(resizable bool) *schema.Resource {
 				return &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
@@ -66,35 +67,35 @@ func resourceCluster() *schema.Resource {
 						"instance_type_configs": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: !resizable,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"bid_price": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 									},
 									"bid_price_as_percentage_of_on_demand_price": {
 										Type:     schema.TypeFloat,
 										Optional: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 										Default:  100,
 									},
 									"configurations": {
 										Type:     schema.TypeSet,
 										Optional: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"classification": {
 													Type:     schema.TypeString,
 													Optional: true,
-													ForceNew: true,
+													ForceNew: !resizable,
 												},
 												names.AttrProperties: {
 													Type:     schema.TypeMap,
 													Optional: true,
-													ForceNew: true,
+													ForceNew: !resizable,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 											},
@@ -104,29 +105,29 @@ func resourceCluster() *schema.Resource {
 										Type:     schema.TypeSet,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												names.AttrIOPS: {
 													Type:     schema.TypeInt,
 													Optional: true,
-													ForceNew: true,
+													ForceNew: !resizable,
 												},
 												names.AttrSize: {
 													Type:     schema.TypeInt,
 													Required: true,
-													ForceNew: true,
+													ForceNew: !resizable,
 												},
 												names.AttrType: {
 													Type:         schema.TypeString,
 													Required:     true,
-													ForceNew:     true,
+													ForceNew:     !resizable,
 													ValidateFunc: validEBSVolumeType(),
 												},
 												"volumes_per_instance": {
 													Type:     schema.TypeInt,
 													Optional: true,
-													ForceNew: true,
+													ForceNew: !resizable,
 													Default:  1,
 												},
 											},
@@ -136,12 +137,12 @@ func resourceCluster() *schema.Resource {
 									names.AttrInstanceType: {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 									},
 									"weighted_capacity": {
 										Type:     schema.TypeInt,
 										Optional: true,
-										ForceNew: true,
+										ForceNew: !resizable,
 										Default:  1,
 									},
 								},
@@ -223,13 +224,13 @@ func resourceCluster() *schema.Resource {
 						"target_on_demand_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: !resizable,
 							Default:  0,
 						},
 						"target_spot_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: !resizable,
 							Default:  0,
 						},
 					},
@@ -330,7 +331,7 @@ func resourceCluster() *schema.Resource {
 					ForceNew:      true,
 					Computed:      true,
 					MaxItems:      1,
-					Elem:          instanceFleetConfigSchema(),
+					Elem:          instanceFleetConfigSchema(true),
 					ConflictsWith: []string{"core_instance_group", "master_instance_group"},
 				},
 				"core_instance_group": {
@@ -563,7 +564,7 @@ func resourceCluster() *schema.Resource {
 					ForceNew:      true,
 					Computed:      true,
 					MaxItems:      1,
-					Elem:          instanceFleetConfigSchema(),
+					Elem:          instanceFleetConfigSchema(false),
 					ConflictsWith: []string{"core_instance_group", "master_instance_group"},
 				},
 				"master_instance_group": {
@@ -1354,6 +1355,36 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChanges("core_instance_fleet.0.instance_type_configs", "core_instance_fleet.0.target_on_demand_capacity", "core_instance_fleet.0.target_spot_capacity") {
+		instanceFleetID := d.Get("core_instance_fleet.0.id").(string)
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &awstypes.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(instanceFleetID),
+				TargetOnDemandCapacity: aws.Int32(int32(d.Get("core_instance_fleet.0.target_on_demand_capacity").(int))),
+				TargetSpotCapacity:     aws.Int32(int32(d.Get("core_instance_fleet.0.target_spot_capacity").(int))),
+			},
+		}
+
+		if d.HasChange("core_instance_fleet.0.instance_type_configs") {
+			input.InstanceFleet.InstanceTypeConfigs = expandInstanceTypeConfigs(d.Get("core_instance_fleet.0.instance_type_configs").(*schema.Set).List())
+		}
+
+		_, err := conn.ModifyInstanceFleet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EMR Cluster (%s) Instance Fleet (%s): %s", d.Id(), instanceFleetID, err)
+		}
+
+		const (
+			timeout = 75 * time.Minute
+		)
+		if _, err := waitInstanceFleetRunning(ctx, conn, d.Id(), instanceFleetID, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %s", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
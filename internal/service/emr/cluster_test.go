@@ -1668,6 +1668,53 @@ func TestAccEMRCluster_InstanceFleet_basic(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_InstanceFleet_resize(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 awstypes.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetsTargetSpotCapacity(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsTargetSpotCapacity(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsCore(rName, "m5.2xlarge", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.instance_type_configs.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "core_instance_fleet.0.instance_type_configs.*", map[string]string{
+						names.AttrInstanceType: "m5.2xlarge",
+						"weighted_capacity":    acctest.Ct2,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster awstypes.Cluster
@@ -3976,6 +4023,14 @@ resource "aws_emr_cluster" "test" {
 }
 
 func testAccClusterConfig_instanceFleets(rName string) string {
+	return testAccClusterConfig_instanceFleetsTargetSpotCapacity(rName, 2)
+}
+
+func testAccClusterConfig_instanceFleetsTargetSpotCapacity(rName string, targetSpotCapacity int) string {
+	return testAccClusterConfig_instanceFleetsCore(rName, "m4.2xlarge", targetSpotCapacity)
+}
+
+func testAccClusterConfig_instanceFleetsCore(rName, instanceType string, targetSpotCapacity int) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
 		testAccClusterConfig_baseIAMServiceRole(rName),
@@ -4025,7 +4080,7 @@ resource "aws_emr_cluster" "test" {
         type                 = "gp2"
         volumes_per_instance = 1
       }
-      instance_type     = "m4.2xlarge"
+      instance_type     = %[3]q
       weighted_capacity = 2
     }
     launch_specifications {
@@ -4038,7 +4093,7 @@ resource "aws_emr_cluster" "test" {
     }
     name                      = "core fleet"
     target_on_demand_capacity = 0
-    target_spot_capacity      = 2
+    target_spot_capacity      = %[2]d
   }
   service_role = aws_iam_role.emr_service.arn
   depends_on = [
@@ -4060,7 +4115,7 @@ resource "aws_emr_cluster" "test" {
     args = ["instance.isMaster=true", "echo running on master node"]
   }
 }
-`, rName))
+`, rName, targetSpotCapacity, instanceType))
 }
 
 func testAccClusterConfig_instanceFleetMultipleSubnets(rName string) string {
@@ -56,35 +56,29 @@ func resourceInstanceFleet() *schema.Resource {
 			"instance_type_configs": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bid_price": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"bid_price_as_percentage_of_on_demand_price": {
 							Type:     schema.TypeFloat,
 							Optional: true,
-							ForceNew: true,
 							Default:  100,
 						},
 						"configurations": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"classification": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 									names.AttrProperties: {
 										Type:     schema.TypeMap,
 										Optional: true,
-										ForceNew: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
 								},
@@ -94,29 +88,24 @@ func resourceInstanceFleet() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrIOPS: {
 										Type:     schema.TypeInt,
 										Optional: true,
-										ForceNew: true,
 									},
 									names.AttrSize: {
 										Type:     schema.TypeInt,
 										Required: true,
-										ForceNew: true,
 									},
 									names.AttrType: {
 										Type:         schema.TypeString,
 										Required:     true,
-										ForceNew:     true,
 										ValidateFunc: validEBSVolumeType(),
 									},
 									"volumes_per_instance": {
 										Type:     schema.TypeInt,
 										Optional: true,
-										ForceNew: true,
 										Default:  1,
 									},
 								},
@@ -126,12 +115,10 @@ func resourceInstanceFleet() *schema.Resource {
 						names.AttrInstanceType: {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"weighted_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 							Default:  1,
 						},
 					},
@@ -296,6 +283,10 @@ func resourceInstanceFleetUpdate(ctx context.Context, d *schema.ResourceData, me
 		},
 	}
 
+	if d.HasChange("instance_type_configs") {
+		input.InstanceFleet.InstanceTypeConfigs = expandInstanceTypeConfigs(d.Get("instance_type_configs").(*schema.Set).List())
+	}
+
 	_, err := conn.ModifyInstanceFleet(ctx, input)
 
 	if err != nil {
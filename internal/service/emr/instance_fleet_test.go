@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -49,6 +50,43 @@ func TestAccEMRInstanceFleet_basic(t *testing.T) {
 	})
 }
 
+func TestAccEMRInstanceFleet_instanceTypeConfigs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 awstypes.InstanceFleet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emr_instance_fleet.task"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceFleetConfig_instanceTypeConfigs(rName, "m4.xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_configs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_configs.*", map[string]string{
+						names.AttrInstanceType: "m4.xlarge",
+					}),
+				),
+			},
+			{
+				Config: testAccInstanceFleetConfig_instanceTypeConfigs(rName, "m5.xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckInstanceFleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_configs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_configs.*", map[string]string{
+						names.AttrInstanceType: "m5.xlarge",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRInstanceFleet_Zero_count(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet awstypes.InstanceFleet
@@ -174,6 +212,16 @@ func testAccCheckInstanceFleetExists(ctx context.Context, n string, v *awstypes.
 	}
 }
 
+func testAccCheckInstanceFleetNotRecreated(before, after *awstypes.InstanceFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before != after {
+			return fmt.Errorf("EMR Instance Fleet (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccInstanceFleetResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -425,6 +473,29 @@ resource "aws_emr_instance_fleet" "task" {
 `, rName))
 }
 
+func testAccInstanceFleetConfig_instanceTypeConfigs(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccInstanceFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_instance_fleet" "task" {
+  cluster_id = aws_emr_cluster.test.id
+
+  instance_type_configs {
+    instance_type     = %[2]q
+    weighted_capacity = 1
+  }
+
+  launch_specifications {
+    on_demand_specification {
+      allocation_strategy = "lowest-price"
+    }
+  }
+
+  name                      = "emr_instance_fleet_%[1]s"
+  target_on_demand_capacity = 1
+  target_spot_capacity      = 0
+}
+`, rName, instanceType))
+}
+
 func testAccInstanceFleetConfig_zeroCount(rName string) string {
 	return acctest.ConfigCompose(testAccInstanceFleetConfig_base(rName), fmt.Sprintf(`
 resource "aws_emr_instance_fleet" "task" {
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceIDNamespace      = newIDNamespaceResource
+	ResourceMatchingWorkflow = newMatchingWorkflowResource
+	ResourceSchemaMapping    = newSchemaMappingResource
+
+	FindIDNamespaceByName      = findIDNamespaceByName
+	FindMatchingWorkflowByName = findMatchingWorkflowByName
+	FindSchemaMappingByName    = findSchemaMappingByName
+)
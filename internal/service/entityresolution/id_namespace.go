@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_entityresolution_id_namespace", name="ID Namespace")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/entityresolution;entityresolution.GetIdNamespaceOutput")
+// @Testing(tagsTest=false)
+func newIDNamespaceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &idNamespaceResource{}
+
+	return r, nil
+}
+
+type idNamespaceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*idNamespaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_entityresolution_id_namespace"
+}
+
+func (r *idNamespaceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"id_namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IdNamespaceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"id_mapping_workflow_properties": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idNamespaceIDMappingWorkflowPropertiesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id_mapping_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.IdMappingType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"provider_properties": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[namespaceProviderPropertiesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("rule_based_properties")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"provider_configuration": schema.StringAttribute{
+										CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+										Optional:   true,
+									},
+									"provider_service_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"rule_based_properties": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[namespaceRuleBasedPropertiesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute_matching_model": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AttributeMatchingModel](),
+										Optional:   true,
+									},
+									"record_matching_models": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.RecordMatchingModel]()),
+										},
+									},
+									"rule_definition_types": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.IdMappingWorkflowRuleDefinitionType]()),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrRule: ruleBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_source_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idNamespaceInputSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_source_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"schema_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *idNamespaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data idNamespaceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	name := data.IDNamespaceName.ValueString()
+	input := &entityresolution.CreateIdNamespaceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateIdNamespace(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Entity Resolution ID Namespace (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	output, err := findIDNamespaceByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution ID Namespace (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *idNamespaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data idNamespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	output, err := findIDNamespaceByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution ID Namespace (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *idNamespaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new idNamespaceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.IDMappingWorkflowProperties.Equal(old.IDMappingWorkflowProperties) ||
+		!new.InputSourceConfig.Equal(old.InputSourceConfig) ||
+		!new.RoleARN.Equal(old.RoleARN) {
+		name := new.ID.ValueString()
+		input := &entityresolution.UpdateIdNamespaceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIdNamespace(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Entity Resolution ID Namespace (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *idNamespaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data idNamespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	_, err := conn.DeleteIdNamespace(ctx, &entityresolution.DeleteIdNamespaceInput{
+		IdNamespaceName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Entity Resolution ID Namespace (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *idNamespaceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIDNamespaceByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetIdNamespaceOutput, error) {
+	input := &entityresolution.GetIdNamespaceInput{
+		IdNamespaceName: aws.String(name),
+	}
+
+	output, err := conn.GetIdNamespace(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type idNamespaceResourceModel struct {
+	Description                 types.String                                                                 `tfsdk:"description"`
+	ID                          types.String                                                                 `tfsdk:"id"`
+	IDMappingWorkflowProperties fwtypes.ListNestedObjectValueOf[idNamespaceIDMappingWorkflowPropertiesModel] `tfsdk:"id_mapping_workflow_properties"`
+	IDNamespaceARN              types.String                                                                 `tfsdk:"arn"`
+	IDNamespaceName             types.String                                                                 `tfsdk:"id_namespace_name"`
+	InputSourceConfig           fwtypes.ListNestedObjectValueOf[idNamespaceInputSourceModel]                 `tfsdk:"input_source_config"`
+	RoleARN                     fwtypes.ARN                                                                  `tfsdk:"role_arn"`
+	Tags                        tftags.Map                                                                   `tfsdk:"tags"`
+	TagsAll                     tftags.Map                                                                   `tfsdk:"tags_all"`
+	Type                        fwtypes.StringEnum[awstypes.IdNamespaceType]                                 `tfsdk:"type"`
+}
+
+type idNamespaceIDMappingWorkflowPropertiesModel struct {
+	IDMappingType       fwtypes.StringEnum[awstypes.IdMappingType]                         `tfsdk:"id_mapping_type"`
+	ProviderProperties  fwtypes.ListNestedObjectValueOf[namespaceProviderPropertiesModel]  `tfsdk:"provider_properties"`
+	RuleBasedProperties fwtypes.ListNestedObjectValueOf[namespaceRuleBasedPropertiesModel] `tfsdk:"rule_based_properties"`
+}
+
+type namespaceProviderPropertiesModel struct {
+	ProviderConfiguration fwtypes.SmithyJSON[document.Interface] `tfsdk:"provider_configuration"`
+	ProviderServiceARN    fwtypes.ARN                            `tfsdk:"provider_service_arn"`
+}
+
+type namespaceRuleBasedPropertiesModel struct {
+	AttributeMatchingModel fwtypes.StringEnum[awstypes.AttributeMatchingModel] `tfsdk:"attribute_matching_model"`
+	RecordMatchingModels   fwtypes.SetValueOf[types.String]                    `tfsdk:"record_matching_models"`
+	RuleDefinitionTypes    fwtypes.SetValueOf[types.String]                    `tfsdk:"rule_definition_types"`
+	Rules                  fwtypes.ListNestedObjectValueOf[ruleModel]          `tfsdk:"rule"`
+}
+
+type idNamespaceInputSourceModel struct {
+	InputSourceARN fwtypes.ARN  `tfsdk:"input_source_arn"`
+	SchemaName     types.String `tfsdk:"schema_name"`
+}
@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/entityresolution;entityresolution.GetMatchingWorkflowOutput")
+// @Testing(tagsTest=false)
+func newMatchingWorkflowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &matchingWorkflowResource{}
+
+	return r, nil
+}
+
+type matchingWorkflowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*matchingWorkflowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_entityresolution_matching_workflow"
+}
+
+func (r *matchingWorkflowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workflow_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"incremental_run_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[incrementalRunConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"incremental_run_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.IncrementalRunType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"input_source_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"apply_normalization": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"input_source_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"schema_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"apply_normalization": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"output_s3_path": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`), "must be an S3 URI"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[outputAttributeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 750),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hashed": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resolution_techniques": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resolutionTechniquesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resolution_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResolutionType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"provider_properties": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[providerPropertiesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("rule_based_properties")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"provider_configuration": schema.StringAttribute{
+										CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+										Optional:   true,
+									},
+									"provider_service_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"intermediate_source_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[intermediateSourceConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"intermediate_s3_path": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_based_properties": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ruleBasedPropertiesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute_matching_model": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AttributeMatchingModel](),
+										Required:   true,
+									},
+									"match_purpose": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.MatchPurpose](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrRule: ruleBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *matchingWorkflowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data matchingWorkflowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	name := data.WorkflowName.ValueString()
+	input := &entityresolution.CreateMatchingWorkflowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateMatchingWorkflow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Entity Resolution Matching Workflow (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	output, err := findMatchingWorkflowByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution Matching Workflow (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *matchingWorkflowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data matchingWorkflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	output, err := findMatchingWorkflowByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution Matching Workflow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *matchingWorkflowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new matchingWorkflowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.IncrementalRunConfig.Equal(old.IncrementalRunConfig) ||
+		!new.InputSourceConfig.Equal(old.InputSourceConfig) ||
+		!new.OutputSourceConfig.Equal(old.OutputSourceConfig) ||
+		!new.ResolutionTechniques.Equal(old.ResolutionTechniques) ||
+		!new.RoleARN.Equal(old.RoleARN) {
+		name := new.ID.ValueString()
+		input := &entityresolution.UpdateMatchingWorkflowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateMatchingWorkflow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Entity Resolution Matching Workflow (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findMatchingWorkflowByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution Matching Workflow (%s)", name), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *matchingWorkflowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data matchingWorkflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	_, err := conn.DeleteMatchingWorkflow(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Entity Resolution Matching Workflow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *matchingWorkflowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func ruleBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ruleModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(15),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"matching_keys": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.List{
+						listvalidator.SizeBetween(1, 15),
+					},
+				},
+				"rule_name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 255),
+					},
+				},
+			},
+		},
+	}
+}
+
+func findMatchingWorkflowByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type matchingWorkflowResourceModel struct {
+	Description          types.String                                               `tfsdk:"description"`
+	ID                   types.String                                               `tfsdk:"id"`
+	IncrementalRunConfig fwtypes.ListNestedObjectValueOf[incrementalRunConfigModel] `tfsdk:"incremental_run_config"`
+	InputSourceConfig    fwtypes.ListNestedObjectValueOf[inputSourceModel]          `tfsdk:"input_source_config"`
+	OutputSourceConfig   fwtypes.ListNestedObjectValueOf[outputSourceModel]         `tfsdk:"output_source_config"`
+	ResolutionTechniques fwtypes.ListNestedObjectValueOf[resolutionTechniquesModel] `tfsdk:"resolution_techniques"`
+	RoleARN              fwtypes.ARN                                                `tfsdk:"role_arn"`
+	Tags                 tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                 `tfsdk:"tags_all"`
+	WorkflowARN          types.String                                               `tfsdk:"arn"`
+	WorkflowName         types.String                                               `tfsdk:"workflow_name"`
+}
+
+type incrementalRunConfigModel struct {
+	IncrementalRunType fwtypes.StringEnum[awstypes.IncrementalRunType] `tfsdk:"incremental_run_type"`
+}
+
+type inputSourceModel struct {
+	ApplyNormalization types.Bool   `tfsdk:"apply_normalization"`
+	InputSourceARN     fwtypes.ARN  `tfsdk:"input_source_arn"`
+	SchemaName         types.String `tfsdk:"schema_name"`
+}
+
+type outputSourceModel struct {
+	ApplyNormalization types.Bool                                            `tfsdk:"apply_normalization"`
+	KMSArn             fwtypes.ARN                                           `tfsdk:"kms_key_arn"`
+	Output             fwtypes.ListNestedObjectValueOf[outputAttributeModel] `tfsdk:"output"`
+	OutputS3Path       types.String                                          `tfsdk:"output_s3_path"`
+}
+
+type outputAttributeModel struct {
+	Hashed types.Bool   `tfsdk:"hashed"`
+	Name   types.String `tfsdk:"name"`
+}
+
+type resolutionTechniquesModel struct {
+	ProviderProperties  fwtypes.ListNestedObjectValueOf[providerPropertiesModel]  `tfsdk:"provider_properties"`
+	ResolutionType      fwtypes.StringEnum[awstypes.ResolutionType]               `tfsdk:"resolution_type"`
+	RuleBasedProperties fwtypes.ListNestedObjectValueOf[ruleBasedPropertiesModel] `tfsdk:"rule_based_properties"`
+}
+
+type providerPropertiesModel struct {
+	IntermediateSourceConfiguration fwtypes.ListNestedObjectValueOf[intermediateSourceConfigurationModel] `tfsdk:"intermediate_source_configuration"`
+	ProviderConfiguration           fwtypes.SmithyJSON[document.Interface]                                `tfsdk:"provider_configuration"`
+	ProviderServiceARN              fwtypes.ARN                                                           `tfsdk:"provider_service_arn"`
+}
+
+type intermediateSourceConfigurationModel struct {
+	IntermediateS3Path types.String `tfsdk:"intermediate_s3_path"`
+}
+
+type ruleBasedPropertiesModel struct {
+	AttributeMatchingModel fwtypes.StringEnum[awstypes.AttributeMatchingModel] `tfsdk:"attribute_matching_model"`
+	MatchPurpose           fwtypes.StringEnum[awstypes.MatchPurpose]           `tfsdk:"match_purpose"`
+	Rules                  fwtypes.ListNestedObjectValueOf[ruleModel]          `tfsdk:"rule"`
+}
+
+type ruleModel struct {
+	MatchingKeys fwtypes.ListValueOf[types.String] `tfsdk:"matching_keys"`
+	RuleName     types.String                      `tfsdk:"rule_name"`
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_entityresolution_id_namespace", sweepIDNamespaces)
+	awsv2.Register("aws_entityresolution_matching_workflow", sweepMatchingWorkflows)
+	awsv2.Register("aws_entityresolution_schema_mapping", sweepSchemaMappings, "aws_entityresolution_id_namespace", "aws_entityresolution_matching_workflow")
+}
+
+func sweepIDNamespaces(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EntityResolutionClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := entityresolution.NewListIdNamespacesPaginator(conn, &entityresolution.ListIdNamespacesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.IdNamespaceSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newIDNamespaceResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.IdNamespaceName))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepMatchingWorkflows(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EntityResolutionClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := entityresolution.NewListMatchingWorkflowsPaginator(conn, &entityresolution.ListMatchingWorkflowsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMatchingWorkflowResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WorkflowName))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSchemaMappings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EntityResolutionClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := entityresolution.NewListSchemaMappingsPaginator(conn, &entityresolution.ListSchemaMappingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SchemaList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newSchemaMappingResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.SchemaName))))
+		}
+	}
+
+	return sweepResources, nil
+}
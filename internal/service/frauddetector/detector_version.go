@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/frauddetector"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_frauddetector_detector_version", name="Detector Version")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/frauddetector;frauddetector;frauddetector.GetDetectorVersionOutput")
+// @Testing(tagsTest=false)
+func newDetectorVersionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &detectorVersionResource{}
+
+	return r, nil
+}
+
+type detectorVersionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*detectorVersionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_frauddetector_detector_version"
+}
+
+func (r *detectorVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					descriptionValidator,
+				},
+			},
+			"detector_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"detector_version_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_model_endpoints": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"rule_execution_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RuleExecutionMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DetectorVersionStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"model_version": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelVersionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"model_id": schema.StringAttribute{
+							Required: true,
+						},
+						"model_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ModelTypeEnum](),
+							Required:   true,
+						},
+						"model_version_number": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[detectorVersionRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"detector_id": schema.StringAttribute{
+							Required: true,
+						},
+						"rule_id": schema.StringAttribute{
+							Required: true,
+						},
+						"rule_version": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *detectorVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data detectorVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	detectorID := data.DetectorID.ValueString()
+	input := &frauddetector.CreateDetectorVersionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDetectorVersion(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Fraud Detector Detector Version (%s)", detectorID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DetectorVersionID = fwflex.StringToFramework(ctx, output.DetectorVersionId)
+	data.setID()
+
+	// New detector versions are created in DRAFT status.
+	if status := data.Status.ValueEnum(); !data.Status.IsUnknown() && status != awstypes.DetectorVersionStatusDraft {
+		if err := updateDetectorVersionStatus(ctx, conn, detectorID, data.DetectorVersionID.ValueString(), status); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Detector Version (%s) status", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	version, err := findDetectorVersionByTwoPartKey(ctx, conn, detectorID, data.DetectorVersionID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Detector Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, version.Arn)
+	data.RuleExecutionMode = fwtypes.StringEnumValue(version.RuleExecutionMode)
+	data.Status = fwtypes.StringEnumValue(version.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *detectorVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data detectorVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	output, err := findDetectorVersionByTwoPartKey(ctx, conn, data.DetectorID.ValueString(), data.DetectorVersionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Detector Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *detectorVersionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new detectorVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	detectorID, detectorVersionID := new.DetectorID.ValueString(), new.DetectorVersionID.ValueString()
+
+	// Only DRAFT versions can be modified. See ModifyPlan.
+	if new.hasContentChanges(old) {
+		input := &frauddetector.UpdateDetectorVersionInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// ExternalModelEndpoints is required.
+		if input.ExternalModelEndpoints == nil {
+			input.ExternalModelEndpoints = []string{}
+		}
+
+		_, err := conn.UpdateDetectorVersion(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Detector Version (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Status.IsUnknown() && !new.Status.Equal(old.Status) {
+		if err := updateDetectorVersionStatus(ctx, conn, detectorID, detectorVersionID, new.Status.ValueEnum()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Detector Version (%s) status", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findDetectorVersionByTwoPartKey(ctx, conn, detectorID, detectorVersionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Detector Version (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.RuleExecutionMode = fwtypes.StringEnumValue(output.RuleExecutionMode)
+	new.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *detectorVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data detectorVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	detectorID, detectorVersionID := data.DetectorID.ValueString(), data.DetectorVersionID.ValueString()
+
+	// ACTIVE detector versions cannot be deleted.
+	if data.Status.ValueEnum() == awstypes.DetectorVersionStatusActive {
+		err := updateDetectorVersionStatus(ctx, conn, detectorID, detectorVersionID, awstypes.DetectorVersionStatusInactive)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deactivating Fraud Detector Detector Version (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	_, err := conn.DeleteDetectorVersion(ctx, &frauddetector.DeleteDetectorVersionInput{
+		DetectorId:        aws.String(detectorID),
+		DetectorVersionId: aws.String(detectorVersionID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Fraud Detector Detector Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *detectorVersionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var old, new detectorVersionResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Only DRAFT detector versions can be modified in place.
+		if new.hasContentChanges(old) && old.Status.ValueEnum() != awstypes.DetectorVersionStatusDraft {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRule))
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateDetectorVersionStatus(ctx context.Context, conn *frauddetector.Client, detectorID, detectorVersionID string, status awstypes.DetectorVersionStatus) error {
+	input := &frauddetector.UpdateDetectorVersionStatusInput{
+		DetectorId:        aws.String(detectorID),
+		DetectorVersionId: aws.String(detectorVersionID),
+		Status:            status,
+	}
+
+	_, err := conn.UpdateDetectorVersionStatus(ctx, input)
+
+	return err
+}
+
+func findDetectorVersionByTwoPartKey(ctx context.Context, conn *frauddetector.Client, detectorID, detectorVersionID string) (*frauddetector.GetDetectorVersionOutput, error) {
+	input := &frauddetector.GetDetectorVersionInput{
+		DetectorId:        aws.String(detectorID),
+		DetectorVersionId: aws.String(detectorVersionID),
+	}
+
+	output, err := conn.GetDetectorVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type detectorVersionResourceModel struct {
+	ARN                    types.String                                              `tfsdk:"arn"`
+	Description            types.String                                              `tfsdk:"description"`
+	DetectorID             types.String                                              `tfsdk:"detector_id"`
+	DetectorVersionID      types.String                                              `tfsdk:"detector_version_id"`
+	ExternalModelEndpoints fwtypes.SetValueOf[types.String]                          `tfsdk:"external_model_endpoints"`
+	ID                     types.String                                              `tfsdk:"id"`
+	ModelVersions          fwtypes.ListNestedObjectValueOf[modelVersionModel]        `tfsdk:"model_version"`
+	RuleExecutionMode      fwtypes.StringEnum[awstypes.RuleExecutionMode]            `tfsdk:"rule_execution_mode"`
+	Rules                  fwtypes.ListNestedObjectValueOf[detectorVersionRuleModel] `tfsdk:"rule"`
+	Status                 fwtypes.StringEnum[awstypes.DetectorVersionStatus]        `tfsdk:"status"`
+	Tags                   tftags.Map                                                `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                `tfsdk:"tags_all"`
+}
+
+const (
+	detectorVersionResourceIDPartCount = 2
+)
+
+func (data *detectorVersionResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, detectorVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DetectorID = types.StringValue(parts[0])
+	data.DetectorVersionID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *detectorVersionResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DetectorID.ValueString(), data.DetectorVersionID.ValueString()}, detectorVersionResourceIDPartCount, false)))
+}
+
+func (data *detectorVersionResourceModel) hasContentChanges(old detectorVersionResourceModel) bool {
+	return !data.Description.Equal(old.Description) ||
+		!data.ExternalModelEndpoints.Equal(old.ExternalModelEndpoints) ||
+		!data.ModelVersions.Equal(old.ModelVersions) ||
+		(!data.RuleExecutionMode.IsUnknown() && !data.RuleExecutionMode.Equal(old.RuleExecutionMode)) ||
+		!data.Rules.Equal(old.Rules)
+}
+
+type modelVersionModel struct {
+	ARN                fwtypes.ARN                                `tfsdk:"arn"`
+	ModelID            types.String                               `tfsdk:"model_id"`
+	ModelType          fwtypes.StringEnum[awstypes.ModelTypeEnum] `tfsdk:"model_type"`
+	ModelVersionNumber types.String                               `tfsdk:"model_version_number"`
+}
+
+type detectorVersionRuleModel struct {
+	DetectorID  types.String `tfsdk:"detector_id"`
+	RuleID      types.String `tfsdk:"rule_id"`
+	RuleVersion types.String `tfsdk:"rule_version"`
+}
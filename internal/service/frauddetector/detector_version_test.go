@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/frauddetector"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorDetectorVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.GetDetectorVersionOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_detector_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("detector-version/%s/1", rName)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "detector_version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "model_version.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.rule_id", "aws_frauddetector_rule.test", "rule_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.rule_version", "aws_frauddetector_rule.test", "rule_version"),
+					resource.TestCheckResourceAttr(resourceName, "rule_execution_mode", string(awstypes.RuleExecutionModeFirstMatched)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DetectorVersionStatusDraft)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetectorVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.GetDetectorVersionOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_detector_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceDetectorVersion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetectorVersion_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.GetDetectorVersionOutput
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_detector_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorVersionConfig_status(rName, "description 1", string(awstypes.DetectorVersionStatusDraft)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DetectorVersionStatusDraft)),
+				),
+			},
+			{
+				// DRAFT detector versions can be updated in place.
+				Config: testAccDetectorVersionConfig_status(rName, "description 2", string(awstypes.DetectorVersionStatusDraft)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+			{
+				Config: testAccDetectorVersionConfig_status(rName, "description 2", string(awstypes.DetectorVersionStatusActive)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DetectorVersionStatusActive)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Non-DRAFT detector versions must be replaced.
+				Config: testAccDetectorVersionConfig_status(rName, "description 3", string(awstypes.DetectorVersionStatusActive)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 3"),
+					resource.TestCheckResourceAttr(resourceName, "detector_version_id", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DetectorVersionStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_detector_version" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindDetectorVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["detector_version_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Detector Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorVersionExists(ctx context.Context, n string, v *frauddetector.GetDetectorVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		output, err := tffrauddetector.FindDetectorVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["detector_version_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDetectorVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_basic(rName, "> 100"), `
+resource "aws_frauddetector_detector_version" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+
+  rule {
+    detector_id  = aws_frauddetector_rule.test.detector_id
+    rule_id      = aws_frauddetector_rule.test.rule_id
+    rule_version = aws_frauddetector_rule.test.rule_version
+  }
+}
+`)
+}
+
+func testAccDetectorVersionConfig_status(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_basic(rName, "> 100"), fmt.Sprintf(`
+resource "aws_frauddetector_detector_version" "test" {
+  detector_id         = aws_frauddetector_detector.test.detector_id
+  description         = %[1]q
+  rule_execution_mode = "ALL_MATCHED"
+  status              = %[2]q
+
+  rule {
+    detector_id  = aws_frauddetector_rule.test.detector_id
+    rule_id      = aws_frauddetector_rule.test.rule_id
+    rule_version = aws_frauddetector_rule.test.rule_version
+  }
+}
+`, description, status))
+}
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/frauddetector"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_frauddetector_event_type", name="Event Type")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/frauddetector/types;awstypes;awstypes.EventType")
+// @Testing(tagsTest=false)
+func newEventTypeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eventTypeResource{}
+
+	return r, nil
+}
+
+type eventTypeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*eventTypeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_frauddetector_event_type"
+}
+
+func (r *eventTypeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					descriptionValidator,
+				},
+			},
+			"entity_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"event_ingestion": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EventIngestion](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_variables": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"labels": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					nameValidator,
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"event_orchestration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventOrchestrationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"event_bridge_enabled": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *eventTypeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eventTypeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &frauddetector.PutEventTypeInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.PutEventType(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Fraud Detector Event Type (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	output, err := findEventTypeByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Event Type (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.EventIngestion = fwtypes.StringEnumValue(output.EventIngestion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eventTypeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eventTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	output, err := findEventTypeByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Event Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eventTypeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new eventTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.EntityTypes.Equal(old.EntityTypes) ||
+		!new.EventIngestion.Equal(old.EventIngestion) ||
+		!new.EventOrchestration.Equal(old.EventOrchestration) ||
+		!new.EventVariables.Equal(old.EventVariables) ||
+		!new.Labels.Equal(old.Labels) {
+		input := &frauddetector.PutEventTypeInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.PutEventType(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Event Type (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *eventTypeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eventTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	_, err := conn.DeleteEventType(ctx, &frauddetector.DeleteEventTypeInput{
+		Name: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Fraud Detector Event Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *eventTypeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findEventTypeByName(ctx context.Context, conn *frauddetector.Client, name string) (*awstypes.EventType, error) {
+	input := &frauddetector.GetEventTypesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEventTypes(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.EventTypes)
+}
+
+type eventTypeResourceModel struct {
+	ARN                types.String                                             `tfsdk:"arn"`
+	Description        types.String                                             `tfsdk:"description"`
+	EntityTypes        fwtypes.SetValueOf[types.String]                         `tfsdk:"entity_types"`
+	EventIngestion     fwtypes.StringEnum[awstypes.EventIngestion]              `tfsdk:"event_ingestion"`
+	EventOrchestration fwtypes.ListNestedObjectValueOf[eventOrchestrationModel] `tfsdk:"event_orchestration"`
+	EventVariables     fwtypes.SetValueOf[types.String]                         `tfsdk:"event_variables"`
+	ID                 types.String                                             `tfsdk:"id"`
+	Labels             fwtypes.SetValueOf[types.String]                         `tfsdk:"labels"`
+	Name               types.String                                             `tfsdk:"name"`
+	Tags               tftags.Map                                               `tfsdk:"tags"`
+	TagsAll            tftags.Map                                               `tfsdk:"tags_all"`
+}
+
+type eventOrchestrationModel struct {
+	EventBridgeEnabled types.Bool `tfsdk:"event_bridge_enabled"`
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorEventType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EventType
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("event-type/%s", rName)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "entity_types.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_orchestration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EventType
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceEventType, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EventType
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_description(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventTypeConfig_description(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_event_type" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Event Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventTypeExists(ctx context.Context, n string, v *awstypes.EventType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		output, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventTypeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test" {
+  name          = %[1]q
+  data_source   = "EVENT"
+  data_type     = "FLOAT"
+  default_value = "0.0"
+}
+
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEventTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.test.name]
+  labels          = [aws_frauddetector_label.test.name]
+}
+`, rName))
+}
+
+func testAccEventTypeConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.test.name]
+  labels          = [aws_frauddetector_label.test.name]
+
+  event_orchestration {
+    event_bridge_enabled = false
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+// Exports for use in tests only.
+var (
+	ResourceDetector        = newDetectorResource
+	ResourceDetectorVersion = newDetectorVersionResource
+	ResourceEntityType      = newEntityTypeResource
+	ResourceEventType       = newEventTypeResource
+	ResourceLabel           = newLabelResource
+	ResourceModel           = newModelResource
+	ResourceOutcome         = newOutcomeResource
+	ResourceRule            = newRuleResource
+	ResourceVariable        = newVariableResource
+
+	FindDetectorByID                = findDetectorByID
+	FindDetectorVersionByTwoPartKey = findDetectorVersionByTwoPartKey
+	FindEntityTypeByName            = findEntityTypeByName
+	FindEventTypeByName             = findEventTypeByName
+	FindLabelByName                 = findLabelByName
+	FindLatestRuleByTwoPartKey      = findLatestRuleByTwoPartKey
+	FindModelByTwoPartKey           = findModelByTwoPartKey
+	FindOutcomeByName               = findOutcomeByName
+	FindVariableByName              = findVariableByName
+)
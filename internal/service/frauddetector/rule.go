@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/frauddetector"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_frauddetector_rule", name="Rule")
+// @Tags
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/frauddetector/types;awstypes;awstypes.RuleDetail")
+// @Testing(tagsTest=false)
+func newRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ruleResource{}
+
+	return r, nil
+}
+
+type ruleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ruleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_frauddetector_rule"
+}
+
+func (r *ruleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					descriptionValidator,
+				},
+			},
+			"detector_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrExpression: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					ruleExpressionValidator,
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"language": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Language](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.LanguageDetectorpl)),
+			},
+			"outcomes": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					nameValidator,
+				},
+			},
+			"rule_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *ruleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ruleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	input := &frauddetector.CreateRuleInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Fraud Detector Rule (%s)", data.RuleID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findLatestRuleByTwoPartKey(ctx, conn, data.DetectorID.ValueString(), data.RuleID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.RuleVersion = fwflex.StringToFramework(ctx, output.RuleVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ruleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ruleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	output, err := findLatestRuleByTwoPartKey(ctx, conn, data.DetectorID.ValueString(), data.RuleID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Each rule version has its own ARN, so tags must be handled explicitly
+	// rather than with transparent tagging.
+	tags, err := listTags(ctx, conn, data.ARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for Fraud Detector Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ruleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	rule := &awstypes.Rule{
+		DetectorId:  fwflex.StringFromFramework(ctx, old.DetectorID),
+		RuleId:      fwflex.StringFromFramework(ctx, old.RuleID),
+		RuleVersion: fwflex.StringFromFramework(ctx, old.RuleVersion),
+	}
+
+	if !new.Expression.Equal(old.Expression) ||
+		!new.Language.Equal(old.Language) ||
+		!new.Outcomes.Equal(old.Outcomes) {
+		// Rule versions are immutable. Changing the rule's logic creates a new version.
+		input := &frauddetector.UpdateRuleVersionInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Rule = rule
+		input.Tags = getTagsIn(ctx)
+
+		_, err := conn.UpdateRuleVersion(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Rule (%s) version", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	} else {
+		if !new.Description.Equal(old.Description) {
+			input := &frauddetector.UpdateRuleMetadataInput{
+				Description: fwflex.StringFromFramework(ctx, new.Description),
+				Rule:        rule,
+			}
+
+			_, err := conn.UpdateRuleMetadata(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Rule (%s) metadata", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		// A new rule version is created with the current tags, otherwise update the existing version's tags.
+		if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
+			if err := updateTags(ctx, conn, old.ARN.ValueString(), oldTagsAll, newTagsAll); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Fraud Detector Rule (%s) tags", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	output, err := findLatestRuleByTwoPartKey(ctx, conn, new.DetectorID.ValueString(), new.RuleID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Rule (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	new.RuleVersion = fwflex.StringToFramework(ctx, output.RuleVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ruleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ruleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FraudDetectorClient(ctx)
+
+	ruleDetails, err := findRulesByTwoPartKey(ctx, conn, data.DetectorID.ValueString(), data.RuleID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Fraud Detector Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Delete every version of the rule, newest first.
+	for i := len(ruleDetails) - 1; i >= 0; i-- {
+		v := ruleDetails[i]
+		_, err := conn.DeleteRule(ctx, &frauddetector.DeleteRuleInput{
+			Rule: &awstypes.Rule{
+				DetectorId:  v.DetectorId,
+				RuleId:      v.RuleId,
+				RuleVersion: v.RuleVersion,
+			},
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting Fraud Detector Rule (%s) version (%s)", data.ID.ValueString(), aws.ToString(v.RuleVersion)), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *ruleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var old, new ruleResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Changing the rule's logic creates a new rule version with a new ARN.
+		if !new.Expression.Equal(old.Expression) ||
+			!new.Language.Equal(old.Language) ||
+			!new.Outcomes.Equal(old.Outcomes) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrARN), types.StringUnknown())...)
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("rule_version"), types.StringUnknown())...)
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+// findRulesByTwoPartKey returns all versions of the specified rule, ordered by ascending version number.
+func findRulesByTwoPartKey(ctx context.Context, conn *frauddetector.Client, detectorID, ruleID string) ([]awstypes.RuleDetail, error) {
+	input := &frauddetector.GetRulesInput{
+		DetectorId: aws.String(detectorID),
+		RuleId:     aws.String(ruleID),
+	}
+	var output []awstypes.RuleDetail
+
+	pages := frauddetector.NewGetRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RuleDetails...)
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	slices.SortFunc(output, func(a, b awstypes.RuleDetail) int {
+		return ruleVersionNumber(a) - ruleVersionNumber(b)
+	})
+
+	return output, nil
+}
+
+func findLatestRuleByTwoPartKey(ctx context.Context, conn *frauddetector.Client, detectorID, ruleID string) (*awstypes.RuleDetail, error) {
+	output, err := findRulesByTwoPartKey(ctx, conn, detectorID, ruleID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output[len(output)-1], nil
+}
+
+func ruleVersionNumber(v awstypes.RuleDetail) int {
+	n, _ := strconv.Atoi(aws.ToString(v.RuleVersion))
+
+	return n
+}
+
+type ruleResourceModel struct {
+	ARN         types.String                          `tfsdk:"arn"`
+	Description types.String                          `tfsdk:"description"`
+	DetectorID  types.String                          `tfsdk:"detector_id"`
+	Expression  types.String                          `tfsdk:"expression"`
+	ID          types.String                          `tfsdk:"id"`
+	Language    fwtypes.StringEnum[awstypes.Language] `tfsdk:"language"`
+	Outcomes    fwtypes.ListValueOf[types.String]     `tfsdk:"outcomes"`
+	RuleID      types.String                          `tfsdk:"rule_id"`
+	RuleVersion types.String                          `tfsdk:"rule_version"`
+	Tags        tftags.Map                            `tfsdk:"tags"`
+	TagsAll     tftags.Map                            `tfsdk:"tags_all"`
+}
+
+const (
+	ruleResourceIDPartCount = 2
+)
+
+func (data *ruleResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, ruleResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DetectorID = types.StringValue(parts[0])
+	data.RuleID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *ruleResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DetectorID.ValueString(), data.RuleID.ValueString()}, ruleResourceIDPartCount, false)))
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/frauddetector/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleDetail
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "> 100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("rule/%[1]s/%[1]s/1", rName)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrExpression, fmt.Sprintf("$%s > 100", rName)),
+					resource.TestCheckResourceAttr(resourceName, "language", string(awstypes.LanguageDetectorpl)),
+					resource.TestCheckResourceAttr(resourceName, "outcomes.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "outcomes.0", "aws_frauddetector_outcome.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleDetail
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "> 100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleDetail
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_description(rName, "> 100", "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+				),
+			},
+			{
+				// Metadata-only changes don't create a new rule version.
+				Config: testAccRuleConfig_description(rName, "> 100", "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccRuleConfig_description(rName, "> 500", "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("rule/%[1]s/%[1]s/2", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrExpression, fmt.Sprintf("$%s > 500", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleDetail
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FraudDetectorEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_tags1(rName, "> 100", acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig_tags1(rName, "> 100", acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+			{
+				// New rule versions are created with the configured tags.
+				Config: testAccRuleConfig_tags1(rName, "> 500", acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_rule" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindLatestRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["rule_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuleExists(ctx context.Context, n string, v *awstypes.RuleDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorClient(ctx)
+
+		output, err := tffrauddetector.FindLatestRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuleConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorConfig_basic(rName), fmt.Sprintf(`
+resource "aws_frauddetector_outcome" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccRuleConfig_basic(rName, condition string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  expression  = "$%[1]s %[2]s"
+  outcomes    = [aws_frauddetector_outcome.test.name]
+}
+`, rName, condition))
+}
+
+func testAccRuleConfig_description(rName, condition, description string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  description = %[3]q
+  expression  = "$%[1]s %[2]s"
+  outcomes    = [aws_frauddetector_outcome.test.name]
+}
+`, rName, condition, description))
+}
+
+func testAccRuleConfig_tags1(rName, condition, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  expression  = "$%[1]s %[2]s"
+  outcomes    = [aws_frauddetector_outcome.test.name]
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, condition, tagKey1, tagValue1))
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_frauddetector_entity_type", sweepEntityTypes, "aws_frauddetector_event_type")
+	awsv2.Register("aws_frauddetector_event_type", sweepEventTypes)
+	awsv2.Register("aws_frauddetector_label", sweepLabels, "aws_frauddetector_event_type")
+	awsv2.Register("aws_frauddetector_outcome", sweepOutcomes)
+	awsv2.Register("aws_frauddetector_variable", sweepVariables, "aws_frauddetector_event_type")
+}
+
+func sweepEntityTypes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.FraudDetectorClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := frauddetector.NewGetEntityTypesPaginator(conn, &frauddetector.GetEntityTypesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EntityTypes {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEntityTypeResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepEventTypes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.FraudDetectorClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := frauddetector.NewGetEventTypesPaginator(conn, &frauddetector.GetEventTypesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EventTypes {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEventTypeResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepLabels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.FraudDetectorClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := frauddetector.NewGetLabelsPaginator(conn, &frauddetector.GetLabelsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Labels {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newLabelResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepOutcomes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.FraudDetectorClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := frauddetector.NewGetOutcomesPaginator(conn, &frauddetector.GetOutcomesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Outcomes {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newOutcomeResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVariables(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.FraudDetectorClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := frauddetector.NewGetVariablesPaginator(conn, &frauddetector.GetVariablesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Variables {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVariableResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
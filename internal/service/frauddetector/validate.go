@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	nameRegex               = regexache.MustCompile(`^[0-9a-z_-]+$`)
+	ruleExpressionVariables = regexache.MustCompile(`\$[0-9a-z_]+`)
+)
+
+var nameValidator validator.String = stringvalidator.All(
+	stringvalidator.LengthBetween(1, 64),
+	stringvalidator.RegexMatches(nameRegex, "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+)
+
+var descriptionValidator validator.String = stringvalidator.LengthBetween(1, 128)
+
+// ruleExpressionValidator performs a client-side syntax check of a Fraud Detector rule expression.
+// It catches the most common mistakes (unbalanced parentheses or quotes and expressions that don't
+// reference any variables) at plan time. The full grammar is validated by the service.
+var ruleExpressionValidator validator.String = ruleExpressionValidatorImpl{}
+
+type ruleExpressionValidatorImpl struct{}
+
+func (v ruleExpressionValidatorImpl) Description(_ context.Context) string {
+	return "value must be a valid Fraud Detector rule expression"
+}
+
+func (v ruleExpressionValidatorImpl) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ruleExpressionValidatorImpl) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateRuleExpression(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func validateRuleExpression(expression string) error {
+	if n := len(expression); n < 1 || n > 4096 {
+		return fmt.Errorf("length must be between 1 and 4096, got %d", n)
+	}
+
+	depth := 0
+	inString := false
+	for i := 0; i < len(expression); i++ {
+		switch c := expression[i]; {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case c == '(' && !inString:
+			depth++
+		case c == ')' && !inString:
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected ')' at position %d", i)
+			}
+		}
+	}
+
+	if inString {
+		return fmt.Errorf("unterminated string literal")
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("unbalanced parentheses")
+	}
+
+	if !ruleExpressionVariables.MatchString(expression) {
+		return fmt.Errorf("must reference at least one variable (for example, $variable_name)")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateRuleExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		`$amount > 100`,
+		`$email_address_risk_score > 800 and $ip_address_risk_score > 800`,
+		`($amount > 100 or $amount < 1) and $country == "US"`,
+		`$customer_name == "\"quoted\" (name"`,
+		`$ip_address in ["192.0.2.1", "192.0.2.2"]`,
+	}
+	for _, v := range validExpressions {
+		if err := validateRuleExpression(v); err != nil {
+			t.Fatalf("%q should be a valid Fraud Detector rule expression: %s", v, err)
+		}
+	}
+
+	invalidExpressions := []string{
+		``,
+		`amount > 100`,
+		`($amount > 100`,
+		`$amount > 100)`,
+		`)$amount > 100(`,
+		`$country == "US`,
+		"$amount > " + strings.Repeat("1", 4096),
+	}
+	for _, v := range invalidExpressions {
+		if err := validateRuleExpression(v); err == nil {
+			t.Fatalf("%q should be an invalid Fraud Detector rule expression", v)
+		}
+	}
+}
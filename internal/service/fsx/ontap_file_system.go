@@ -5,7 +5,9 @@ package fsx
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -250,12 +252,73 @@ func resourceONTAPFileSystem() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			resourceONTAPFileSystemDeploymentTypeCustomizeDiff,
 			resourceONTAPFileSystemThroughputCapacityPerHAPairCustomizeDiff,
 			resourceONTAPFileSystemHAPairsCustomizeDiff,
 		),
 	}
 }
 
+func resourceONTAPFileSystemDeploymentTypeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	deploymentType := awstypes.OntapDeploymentType(d.Get("deployment_type").(string))
+
+	haPairs, ok := ontapFileSystemConfigInt(d, "ha_pairs")
+	if ok {
+		if haPairs > 1 && deploymentType != awstypes.OntapDeploymentTypeSingleAz2 {
+			return fmt.Errorf("ha_pairs must be 1 for deployment_type %s", deploymentType)
+		}
+	} else {
+		// Not configured, so either the current value or the API default of 1.
+		haPairs = max(d.Get("ha_pairs").(int), 1)
+	}
+
+	if _, ok := ontapFileSystemConfigInt(d, "throughput_capacity"); ok && haPairs > 1 {
+		return fmt.Errorf("throughput_capacity is not supported when ha_pairs is greater than 1, use throughput_capacity_per_ha_pair")
+	}
+
+	throughput, ok := ontapFileSystemConfigInt(d, "throughput_capacity_per_ha_pair")
+	if !ok {
+		if throughput, ok = ontapFileSystemConfigInt(d, "throughput_capacity"); !ok {
+			return nil
+		}
+	}
+
+	var validValues []int
+	switch deploymentType {
+	case awstypes.OntapDeploymentTypeSingleAz1, awstypes.OntapDeploymentTypeMultiAz1:
+		validValues = []int{128, 256, 512, 1024, 2048, 4096}
+	case awstypes.OntapDeploymentTypeMultiAz2:
+		validValues = []int{384, 768, 1536, 3072, 6144}
+	case awstypes.OntapDeploymentTypeSingleAz2:
+		if haPairs > 1 {
+			validValues = []int{1536, 3072, 6144}
+		} else {
+			validValues = []int{384, 768, 1536, 3072, 6144}
+		}
+	default:
+		return nil
+	}
+
+	if !slices.Contains(validValues, throughput) {
+		return fmt.Errorf("throughput capacity %d is not valid for deployment_type %s with %d HA pair(s), valid values: %v", throughput, deploymentType, haPairs, validValues)
+	}
+
+	return nil
+}
+
+// ontapFileSystemConfigInt returns the configured value of an integer attribute, if known.
+func ontapFileSystemConfigInt(d *schema.ResourceDiff, key string) (int, bool) {
+	v := d.GetRawConfig().GetAttr(key)
+
+	if !v.IsKnown() || v.IsNull() {
+		return 0, false
+	}
+
+	i, _ := v.AsBigFloat().Int64()
+
+	return int(i), true
+}
+
 func resourceONTAPFileSystemThroughputCapacityPerHAPairCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	// we want to force a new resource if the throughput_capacity_per_ha_pair is increased for Gen1 file systems
 	if d.HasChange("throughput_capacity_per_ha_pair") {
@@ -272,6 +335,7 @@ func resourceONTAPFileSystemThroughputCapacityPerHAPairCustomizeDiff(_ context.C
 
 func resourceONTAPFileSystemHAPairsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	// we want to force a new resource if the ha_pairs is increased for Gen1 single AZ file systems. multiple ha_pairs is not supported on Multi AZ.
+	// HA pairs can only be added in place, so a decrease also forces a new resource.
 	if d.HasChange("ha_pairs") {
 		o, n := d.GetChange("ha_pairs")
 		if n != nil && n.(int) != 0 && n.(int) > o.(int) && (d.Get("deployment_type").(string) == string(awstypes.OntapDeploymentTypeSingleAz1)) {
@@ -279,6 +343,11 @@ func resourceONTAPFileSystemHAPairsCustomizeDiff(_ context.Context, d *schema.Re
 				return err
 			}
 		}
+		if n != nil && n.(int) != 0 && n.(int) < o.(int) {
+			if err := d.ForceNew("ha_pairs"); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
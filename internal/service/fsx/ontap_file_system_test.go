@@ -257,6 +257,32 @@ func TestAccFSxONTAPFileSystem_haPair_increase(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPFileSystem_haPair_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccONTAPFileSystemConfig_haPairInvalid(rName, "MULTI_AZ_2", 2, 1536),
+				ExpectError: regexache.MustCompile(`ha_pairs must be 1 for deployment_type\s+MULTI_AZ_2`),
+			},
+			{
+				Config:      testAccONTAPFileSystemConfig_haPairInvalid(rName, "SINGLE_AZ_2", 2, 384),
+				ExpectError: regexache.MustCompile(`throughput capacity 384 is not valid for\s+deployment_type\s+SINGLE_AZ_2`),
+			},
+			{
+				Config:      testAccONTAPFileSystemConfig_haPairInvalid(rName, "SINGLE_AZ_1", 1, 384),
+				ExpectError: regexache.MustCompile(`throughput capacity 384 is not valid for\s+deployment_type\s+SINGLE_AZ_1`),
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPFileSystem_fsxAdminPassword(t *testing.T) {
 	ctx := acctest.Context(t)
 	var filesystem1, filesystem2 awstypes.FileSystem
@@ -1016,6 +1042,23 @@ resource "aws_fsx_ontap_file_system" "test" {
 `, rName, capacity))
 }
 
+func testAccONTAPFileSystemConfig_haPairInvalid(rName, deploymentType string, haPairs, throughput int) string {
+	return acctest.ConfigCompose(testAccONTAPFileSystemConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_file_system" "test" {
+  storage_capacity                = 2048
+  subnet_ids                      = [aws_subnet.test[0].id]
+  deployment_type                 = %[2]q
+  ha_pairs                        = %[3]d
+  throughput_capacity_per_ha_pair = %[4]d
+  preferred_subnet_id             = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, deploymentType, haPairs, throughput))
+}
+
 func testAccONTAPFileSystemConfig_oneHaPair(rName string, capacity int) string {
 	return acctest.ConfigCompose(testAccONTAPFileSystemConfig_base(rName), fmt.Sprintf(`
 resource "aws_fsx_ontap_file_system" "test" {
@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_fsx_s3_access_point_attachment", name="S3 Access Point Attachment")
+func resourceS3AccessPointAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceS3AccessPointAttachmentCreate,
+		ReadWithoutTimeout:   resourceS3AccessPointAttachmentRead,
+		DeleteWithoutTimeout: resourceS3AccessPointAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z][0-9a-z-]*[0-9a-z]$`), "must contain only lowercase alphanumeric characters and hyphens, and must begin and end with a lowercase alphanumeric character"),
+				),
+			},
+			"openzfs_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_system_identity": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"posix_user": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gid": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"secondary_gids": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 15,
+													Elem: &schema.Schema{
+														Type:         schema.TypeInt,
+														ValidateFunc: validation.IntAtLeast(0),
+													},
+												},
+												"uid": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.OpenZFSFileSystemUserType](),
+									},
+								},
+							},
+						},
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"s3_access_point": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPolicy: {
+							Type:                  schema.TypeString,
+							Optional:              true,
+							ForceNew:              true,
+							ValidateFunc:          validation.StringIsJSON,
+							DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+							DiffSuppressOnRefresh: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						names.AttrVPCConfiguration: {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrVPCID: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"s3_access_point_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_access_point_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.S3AccessPointAttachmentType](),
+			},
+		},
+	}
+}
+
+func resourceS3AccessPointAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &fsx.CreateAndAttachS3AccessPointInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Name:               aws.String(name),
+		Type:               awstypes.S3AccessPointAttachmentType(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOk("openzfs_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OpenZFSConfiguration = expandCreateAndAttachS3AccessPointOpenZFSConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_access_point"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		s3AccessPoint, err := expandCreateAndAttachS3AccessPointS3Configuration(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.S3AccessPoint = s3AccessPoint
+	}
+
+	_, err := conn.CreateAndAttachS3AccessPoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating FSx S3 Access Point Attachment (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitS3AccessPointAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for FSx S3 Access Point Attachment (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceS3AccessPointAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceS3AccessPointAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxClient(ctx)
+
+	output, err := findS3AccessPointAttachmentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FSx S3 Access Point Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FSx S3 Access Point Attachment (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrName, output.Name)
+	if output.OpenZFSConfiguration != nil {
+		if err := d.Set("openzfs_configuration", []interface{}{flattenS3AccessPointOpenZFSConfiguration(output.OpenZFSConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting openzfs_configuration: %s", err)
+		}
+	} else {
+		d.Set("openzfs_configuration", nil)
+	}
+	if v := output.S3AccessPoint; v != nil {
+		// The access point policy isn't returned by the FSx API.
+		tfMap := map[string]interface{}{
+			names.AttrPolicy: d.Get("s3_access_point.0.policy").(string),
+		}
+
+		if v := v.VpcConfiguration; v != nil {
+			tfMap[names.AttrVPCConfiguration] = []interface{}{map[string]interface{}{
+				names.AttrVPCID: aws.ToString(v.VpcId),
+			}}
+		}
+
+		if tfMap[names.AttrPolicy] != "" || tfMap[names.AttrVPCConfiguration] != nil {
+			if err := d.Set("s3_access_point", []interface{}{tfMap}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting s3_access_point: %s", err)
+			}
+		} else {
+			d.Set("s3_access_point", nil)
+		}
+
+		d.Set("s3_access_point_alias", v.Alias)
+		d.Set("s3_access_point_arn", v.ResourceARN)
+	} else {
+		d.Set("s3_access_point", nil)
+		d.Set("s3_access_point_alias", nil)
+		d.Set("s3_access_point_arn", nil)
+	}
+	d.Set(names.AttrType, output.Type)
+
+	return diags
+}
+
+func resourceS3AccessPointAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxClient(ctx)
+
+	log.Printf("[DEBUG] Deleting FSx S3 Access Point Attachment: %s", d.Id())
+	_, err := conn.DetachAndDeleteS3AccessPoint(ctx, &fsx.DetachAndDeleteS3AccessPointInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Name:               aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.S3AccessPointAttachmentNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting FSx S3 Access Point Attachment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitS3AccessPointAttachmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for FSx S3 Access Point Attachment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findS3AccessPointAttachmentByName(ctx context.Context, conn *fsx.Client, name string) (*awstypes.S3AccessPointAttachment, error) {
+	input := &fsx.DescribeS3AccessPointAttachmentsInput{
+		Names: []string{name},
+	}
+
+	return findS3AccessPointAttachment(ctx, conn, input, tfslices.PredicateTrue[*awstypes.S3AccessPointAttachment]())
+}
+
+func findS3AccessPointAttachment(ctx context.Context, conn *fsx.Client, input *fsx.DescribeS3AccessPointAttachmentsInput, filter tfslices.Predicate[*awstypes.S3AccessPointAttachment]) (*awstypes.S3AccessPointAttachment, error) {
+	output, err := findS3AccessPointAttachments(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findS3AccessPointAttachments(ctx context.Context, conn *fsx.Client, input *fsx.DescribeS3AccessPointAttachmentsInput, filter tfslices.Predicate[*awstypes.S3AccessPointAttachment]) ([]awstypes.S3AccessPointAttachment, error) {
+	var output []awstypes.S3AccessPointAttachment
+
+	pages := fsx.NewDescribeS3AccessPointAttachmentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.S3AccessPointAttachmentNotFound](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.S3AccessPointAttachments {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusS3AccessPointAttachment(ctx context.Context, conn *fsx.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findS3AccessPointAttachmentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Lifecycle), nil
+	}
+}
+
+func waitS3AccessPointAttachmentCreated(ctx context.Context, conn *fsx.Client, name string, timeout time.Duration) (*awstypes.S3AccessPointAttachment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.S3AccessPointAttachmentLifecycleCreating),
+		Target:  enum.Slice(awstypes.S3AccessPointAttachmentLifecycleAvailable),
+		Refresh: statusS3AccessPointAttachment(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.S3AccessPointAttachment); ok {
+		if output.LifecycleTransitionReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.LifecycleTransitionReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitS3AccessPointAttachmentDeleted(ctx context.Context, conn *fsx.Client, name string, timeout time.Duration) (*awstypes.S3AccessPointAttachment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.S3AccessPointAttachmentLifecycleDeleting),
+		Target:  []string{},
+		Refresh: statusS3AccessPointAttachment(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.S3AccessPointAttachment); ok {
+		if output.LifecycleTransitionReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.LifecycleTransitionReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCreateAndAttachS3AccessPointOpenZFSConfiguration(tfMap map[string]interface{}) *awstypes.CreateAndAttachS3AccessPointOpenZFSConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CreateAndAttachS3AccessPointOpenZFSConfiguration{}
+
+	if v, ok := tfMap["file_system_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FileSystemIdentity = expandOpenZFSFileSystemIdentity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["volume_id"].(string); ok && v != "" {
+		apiObject.VolumeId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOpenZFSFileSystemIdentity(tfMap map[string]interface{}) *awstypes.OpenZFSFileSystemIdentity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.OpenZFSFileSystemIdentity{}
+
+	if v, ok := tfMap["posix_user"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PosixUser = expandOpenZFSPosixFileSystemUser(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = awstypes.OpenZFSFileSystemUserType(v)
+	}
+
+	return apiObject
+}
+
+func expandOpenZFSPosixFileSystemUser(tfMap map[string]interface{}) *awstypes.OpenZFSPosixFileSystemUser {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.OpenZFSPosixFileSystemUser{}
+
+	if v, ok := tfMap["gid"].(int); ok {
+		apiObject.Gid = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["secondary_gids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecondaryGids = flex.ExpandInt64ValueList(v)
+	}
+
+	if v, ok := tfMap["uid"].(int); ok {
+		apiObject.Uid = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandCreateAndAttachS3AccessPointS3Configuration(tfMap map[string]interface{}) (*awstypes.CreateAndAttachS3AccessPointS3Configuration, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &awstypes.CreateAndAttachS3AccessPointS3Configuration{}
+
+	if v, ok := tfMap[names.AttrPolicy].(string); ok && v != "" {
+		policy, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Policy = aws.String(policy)
+	}
+
+	if v, ok := tfMap[names.AttrVPCConfiguration].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap[names.AttrVPCID].(string); ok && v != "" {
+			apiObject.VpcConfiguration = &awstypes.S3AccessPointVpcConfiguration{
+				VpcId: aws.String(v),
+			}
+		}
+	}
+
+	return apiObject, nil
+}
+
+func flattenS3AccessPointOpenZFSConfiguration(apiObject *awstypes.S3AccessPointOpenZFSConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"volume_id": aws.ToString(apiObject.VolumeId),
+	}
+
+	if v := apiObject.FileSystemIdentity; v != nil {
+		tfMap["file_system_identity"] = []interface{}{flattenOpenZFSFileSystemIdentity(v)}
+	}
+
+	return tfMap
+}
+
+func flattenOpenZFSFileSystemIdentity(apiObject *awstypes.OpenZFSFileSystemIdentity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
+	if v := apiObject.PosixUser; v != nil {
+		tfMap["posix_user"] = []interface{}{map[string]interface{}{
+			"gid": aws.ToInt64(v.Gid),
+			"secondary_gids": tfslices.ApplyToAll(v.SecondaryGids, func(v int64) any {
+				return int(v)
+			}),
+			"uid": aws.ToInt64(v.Uid),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffsx "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFSxS3AccessPointAttachment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.S3AccessPointAttachment
+	resourceName := "aws_fsx_s3_access_point_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckS3AccessPointAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3AccessPointAttachmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3AccessPointAttachmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.0.file_system_identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.0.file_system_identity.0.posix_user.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.0.file_system_identity.0.posix_user.0.gid", "0"),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.0.file_system_identity.0.posix_user.0.uid", "0"),
+					resource.TestCheckResourceAttr(resourceName, "openzfs_configuration.0.file_system_identity.0.type", "POSIX"),
+					resource.TestCheckResourceAttrPair(resourceName, "openzfs_configuration.0.volume_id", "aws_fsx_openzfs_file_system.test", "root_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_access_point.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_access_point_alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_access_point_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "OPENZFS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFSxS3AccessPointAttachment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.S3AccessPointAttachment
+	resourceName := "aws_fsx_s3_access_point_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckS3AccessPointAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3AccessPointAttachmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3AccessPointAttachmentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffsx.ResourceS3AccessPointAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFSxS3AccessPointAttachment_policy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.S3AccessPointAttachment
+	resourceName := "aws_fsx_s3_access_point_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckS3AccessPointAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3AccessPointAttachmentConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3AccessPointAttachmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "s3_access_point.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_access_point.0.policy"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_access_point"},
+			},
+		},
+	})
+}
+
+func TestAccFSxS3AccessPointAttachment_vpcConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.S3AccessPointAttachment
+	resourceName := "aws_fsx_s3_access_point_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckS3AccessPointAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3AccessPointAttachmentConfig_vpcConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3AccessPointAttachmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "s3_access_point.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_access_point.0.vpc_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_access_point.0.vpc_configuration.0.vpc_id", "aws_vpc.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckS3AccessPointAttachmentExists(ctx context.Context, n string, v *awstypes.S3AccessPointAttachment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxClient(ctx)
+
+		output, err := tffsx.FindS3AccessPointAttachmentByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckS3AccessPointAttachmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_fsx_s3_access_point_attachment" {
+				continue
+			}
+
+			_, err := tffsx.FindS3AccessPointAttachmentByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("FSx S3 Access Point Attachment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccS3AccessPointAttachmentConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_fsx_openzfs_file_system" "test" {
+  storage_capacity    = 64
+  subnet_ids          = [aws_subnet.test[0].id]
+  deployment_type     = "SINGLE_AZ_HA_2"
+  throughput_capacity = 160
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccS3AccessPointAttachmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccS3AccessPointAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_s3_access_point_attachment" "test" {
+  name = %[1]q
+  type = "OPENZFS"
+
+  openzfs_configuration {
+    volume_id = aws_fsx_openzfs_file_system.test.root_volume_id
+
+    file_system_identity {
+      type = "POSIX"
+
+      posix_user {
+        uid = 0
+        gid = 0
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccS3AccessPointAttachmentConfig_policy(rName string) string {
+	return acctest.ConfigCompose(testAccS3AccessPointAttachmentConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_fsx_s3_access_point_attachment" "test" {
+  name = %[1]q
+  type = "OPENZFS"
+
+  openzfs_configuration {
+    volume_id = aws_fsx_openzfs_file_system.test.root_volume_id
+
+    file_system_identity {
+      type = "POSIX"
+
+      posix_user {
+        uid = 0
+        gid = 0
+      }
+    }
+  }
+
+  s3_access_point {
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Action   = "s3:GetObject"
+        Resource = "arn:${data.aws_partition.current.partition}:s3:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:accesspoint/%[1]s/object/*"
+      }]
+    })
+  }
+}
+`, rName))
+}
+
+func testAccS3AccessPointAttachmentConfig_vpcConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccS3AccessPointAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_s3_access_point_attachment" "test" {
+  name = %[1]q
+  type = "OPENZFS"
+
+  openzfs_configuration {
+    volume_id = aws_fsx_openzfs_file_system.test.root_volume_id
+
+    file_system_identity {
+      type = "POSIX"
+
+      posix_user {
+        uid = 0
+        gid = 0
+      }
+    }
+  }
+
+  s3_access_point {
+    vpc_configuration {
+      vpc_id = aws_vpc.test.id
+    }
+  }
+}
+`, rName))
+}
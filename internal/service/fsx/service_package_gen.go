@@ -135,6 +135,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceS3AccessPointAttachment,
+			TypeName: "aws_fsx_s3_access_point_attachment",
+			Name:     "S3 Access Point Attachment",
+		},
 		{
 			Factory:  resourceWindowsFileSystem,
 			TypeName: "aws_fsx_windows_file_system",
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glue_usage_profile", name="Usage Profile")
+// @Tags(identifierAttribute="arn")
+func resourceUsageProfile() *schema.Resource {
+	configurationObjectSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allowed_values": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					names.AttrDefaultValue: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrKey: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"max_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"min_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsageProfileCreate,
+		ReadWithoutTimeout:   resourceUsageProfileRead,
+		UpdateWithoutTimeout: resourceUsageProfileUpdate,
+		DeleteWithoutTimeout: resourceUsageProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_configuration":     configurationObjectSchema(),
+						"session_configuration": configurationObjectSchema(),
+					},
+				},
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"last_modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 128),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceUsageProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &glue.CreateUsageProfileInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrConfiguration); ok && len(v.([]interface{})) > 0 {
+		input.Configuration = expandProfileConfiguration(v.([]interface{})[0])
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateUsageProfile(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Usage Profile (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceUsageProfileRead(ctx, d, meta)...)
+}
+
+func resourceUsageProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	output, err := findUsageProfileByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Usage Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Usage Profile (%s): %s", d.Id(), err)
+	}
+
+	usageProfileARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "glue",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("usageProfile/%s", d.Id()),
+	}.String()
+	d.Set(names.AttrARN, usageProfileARN)
+	if err := d.Set(names.AttrConfiguration, flattenProfileConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	if v := output.CreatedOn; v != nil {
+		d.Set("created_on", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, output.Description)
+	if v := output.LastModifiedOn; v != nil {
+		d.Set("last_modified_on", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourceUsageProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	if d.HasChanges(names.AttrConfiguration, names.AttrDescription) {
+		input := &glue.UpdateUsageProfileInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk(names.AttrConfiguration); ok && len(v.([]interface{})) > 0 {
+			input.Configuration = expandProfileConfiguration(v.([]interface{})[0])
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateUsageProfile(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Glue Usage Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsageProfileRead(ctx, d, meta)...)
+}
+
+func resourceUsageProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Glue Usage Profile: %s", d.Id())
+	_, err := conn.DeleteUsageProfile(ctx, &glue.DeleteUsageProfileInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Usage Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findUsageProfileByName(ctx context.Context, conn *glue.Client, name string) (*glue.GetUsageProfileOutput, error) {
+	input := &glue.GetUsageProfileInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetUsageProfile(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandProfileConfiguration(tfMapRaw interface{}) *awstypes.ProfileConfiguration {
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &awstypes.ProfileConfiguration{}
+
+	if v, ok := tfMap["job_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobConfiguration = expandConfigurationObjects(v.List())
+	}
+
+	if v, ok := tfMap["session_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SessionConfiguration = expandConfigurationObjects(v.List())
+	}
+
+	return apiObject
+}
+
+func expandConfigurationObjects(tfList []interface{}) map[string]awstypes.ConfigurationObject {
+	apiObjects := make(map[string]awstypes.ConfigurationObject, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ConfigurationObject{}
+
+		if v, ok := tfMap["allowed_values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AllowedValues = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_value"].(string); ok && v != "" {
+			apiObject.MaxValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["min_value"].(string); ok && v != "" {
+			apiObject.MinValue = aws.String(v)
+		}
+
+		apiObjects[tfMap[names.AttrKey].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenProfileConfiguration(apiObject *awstypes.ProfileConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_configuration":     flattenConfigurationObjects(apiObject.JobConfiguration),
+		"session_configuration": flattenConfigurationObjects(apiObject.SessionConfiguration),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenConfigurationObjects(apiObjects map[string]awstypes.ConfigurationObject) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for key, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"allowed_values":       apiObject.AllowedValues,
+			names.AttrDefaultValue: aws.ToString(apiObject.DefaultValue),
+			names.AttrKey:          key,
+			"max_value":            aws.ToString(apiObject.MaxValue),
+			"min_value":            aws.ToString(apiObject.MinValue),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -179,11 +179,11 @@ func (r *resourceMalwareProtectionPlan) Create(ctx context.Context, req resource
 	state := plan
 	state.ID = flex.StringToFramework(ctx, out.MalwareProtectionPlanId)
 
-	// Read after create to get computed attributes omitted from the create response
-	readOut, err := FindMalwareProtectionPlanByID(ctx, conn, state.ID.ValueString())
+	// Wait for the plan to settle; this also reads computed attributes omitted from the create response
+	readOut, err := waitMalwareProtectionPlanActive(ctx, conn, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameMalwareProtectionPlan, plan.ID.String(), err),
+			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionWaitingForCreation, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
 			err.Error(),
 		)
 		return
@@ -269,11 +269,11 @@ func (r *resourceMalwareProtectionPlan) Update(ctx context.Context, req resource
 		}
 	}
 
-	out, err := FindMalwareProtectionPlanByID(ctx, conn, state.ID.ValueString())
+	out, err := waitMalwareProtectionPlanActive(ctx, conn, state.ID.ValueString())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionUpdating, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
+			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionWaitingForUpdate, ResNameMalwareProtectionPlan, state.ID.ValueString(), err),
 			err.Error(),
 		)
 		return
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -63,6 +64,23 @@ func statusPublishingDestination(ctx context.Context, conn *guardduty.Client, de
 	}
 }
 
+// statusMalwareProtectionPlan fetches the MalwareProtectionPlan and its Status
+func statusMalwareProtectionPlan(ctx context.Context, conn *guardduty.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMalwareProtectionPlanByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
 // TODO: Migrate to shared internal package guardduty
 func getOrganizationAdminAccount(ctx context.Context, conn *guardduty.Client, adminAccountID string) (*awstypes.AdminAccount, error) {
 	input := &guardduty.ListOrganizationAdminAccountsInput{}
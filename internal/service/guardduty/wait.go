@@ -5,12 +5,16 @@ package guardduty
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,6 +27,9 @@ const (
 	// Maximum amount of time to wait for a PublishingDestination to return Publishing
 	publishingDestinationCreatedTimeout = 5 * time.Minute
 
+	// Maximum amount of time to wait for a MalwareProtectionPlan to return Active
+	malwareProtectionPlanActiveTimeout = 5 * time.Minute
+
 	// Maximum amount of time to wait for membership to propagate
 	// When removing Organization Admin Accounts, there is eventual
 	// consistency even after the account is no longer listed.
@@ -84,3 +91,35 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Clien
 
 	return nil, err
 }
+
+// waitMalwareProtectionPlanActive waits for a MalwareProtectionPlan to return Active or Warning
+func waitMalwareProtectionPlanActive(ctx context.Context, conn *guardduty.Client, id string) (*guardduty.GetMalwareProtectionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.MalwareProtectionPlanStatusActive, awstypes.MalwareProtectionPlanStatusWarning),
+		Refresh:                   statusMalwareProtectionPlan(ctx, conn, id),
+		Timeout:                   malwareProtectionPlanActiveTimeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*guardduty.GetMalwareProtectionPlanOutput); ok {
+		tfresource.SetLastError(err, malwareProtectionPlanStatusReasonsError(output.StatusReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func malwareProtectionPlanStatusReasonsError(apiObjects []awstypes.MalwareProtectionPlanStatusReason) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(apiObject.Code), aws.ToString(apiObject.Message)))
+	}
+
+	return errors.Join(errs...)
+}
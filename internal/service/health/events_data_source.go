@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/health/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_health_events", name="Events")
+func newEventsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &eventsDataSource{}, nil
+}
+
+type eventsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*eventsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_health_events"
+}
+
+func (d *eventsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAvailabilityZones: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"event_status_codes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.EventStatusCode]()),
+				},
+			},
+			"event_type_categories": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.EventTypeCategory]()),
+				},
+			},
+			"event_type_codes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"events": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[eventModel](ctx),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"services": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *eventsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data eventsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthClient(ctx)
+
+	var filter awstypes.EventFilter
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &filter)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &health.DescribeEventsInput{
+		Filter: &filter,
+	}
+
+	events, err := findEvents(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Health Events", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, events, &data.Events)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().AccountID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEvents(ctx context.Context, conn *health.Client, input *health.DescribeEventsInput) ([]awstypes.Event, error) {
+	var output []awstypes.Event
+
+	pages := health.NewDescribeEventsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Events...)
+	}
+
+	return output, nil
+}
+
+type eventsDataSourceModel struct {
+	AvailabilityZones   fwtypes.SetValueOf[types.String]            `tfsdk:"availability_zones"`
+	EventStatusCodes    fwtypes.SetValueOf[types.String]            `tfsdk:"event_status_codes"`
+	EventTypeCategories fwtypes.SetValueOf[types.String]            `tfsdk:"event_type_categories"`
+	EventTypeCodes      fwtypes.SetValueOf[types.String]            `tfsdk:"event_type_codes"`
+	Events              fwtypes.ListNestedObjectValueOf[eventModel] `tfsdk:"events"`
+	ID                  types.String                                `tfsdk:"id"`
+	Regions             fwtypes.SetValueOf[types.String]            `tfsdk:"regions"`
+	Services            fwtypes.SetValueOf[types.String]            `tfsdk:"services"`
+}
+
+type eventModel struct {
+	ARN               types.String                                   `tfsdk:"arn"`
+	AvailabilityZone  types.String                                   `tfsdk:"availability_zone"`
+	EndTime           timetypes.RFC3339                              `tfsdk:"end_time"`
+	EventScopeCode    fwtypes.StringEnum[awstypes.EventScopeCode]    `tfsdk:"event_scope_code"`
+	EventTypeCategory fwtypes.StringEnum[awstypes.EventTypeCategory] `tfsdk:"event_type_category"`
+	EventTypeCode     types.String                                   `tfsdk:"event_type_code"`
+	LastUpdatedTime   timetypes.RFC3339                              `tfsdk:"last_updated_time"`
+	Region            types.String                                   `tfsdk:"region"`
+	Service           types.String                                   `tfsdk:"service"`
+	StartTime         timetypes.RFC3339                              `tfsdk:"start_time"`
+	StatusCode        fwtypes.StringEnum[awstypes.EventStatusCode]   `tfsdk:"status_code"`
+}
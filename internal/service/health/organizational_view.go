@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_health_organizational_view", name="Organizational View")
+// @Testing(serialize=true)
+func newOrganizationalViewResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationalViewResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type organizationalViewResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*organizationalViewResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_health_organizational_view"
+}
+
+func (r *organizationalViewResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *organizationalViewResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationalViewResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthClient(ctx)
+
+	_, err := conn.EnableHealthServiceAccessForOrganization(ctx, &health.EnableHealthServiceAccessForOrganizationInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("enabling Health organizational view", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	if _, err := waitOrganizationalViewEnabled(ctx, conn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError("waiting for Health organizational view enable", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *organizationalViewResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationalViewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthClient(ctx)
+
+	_, err := findOrganizationalView(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Health organizational view (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationalViewResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationalViewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthClient(ctx)
+
+	_, err := conn.DisableHealthServiceAccessForOrganization(ctx, &health.DisableHealthServiceAccessForOrganizationInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("disabling Health organizational view (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitOrganizationalViewDisabled(ctx, conn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Health organizational view (%s) disable", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+const (
+	healthServiceAccessStatusDisabled = "DISABLED"
+	healthServiceAccessStatusEnabled  = "ENABLED"
+	healthServiceAccessStatusPending  = "PENDING"
+)
+
+func findHealthServiceAccessStatusForOrganization(ctx context.Context, conn *health.Client) (*string, error) {
+	input := &health.DescribeHealthServiceStatusForOrganizationInput{}
+
+	output, err := conn.DescribeHealthServiceStatusForOrganization(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HealthServiceAccessStatusForOrganization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HealthServiceAccessStatusForOrganization, nil
+}
+
+func findOrganizationalView(ctx context.Context, conn *health.Client) (*string, error) {
+	output, err := findHealthServiceAccessStatusForOrganization(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.ToString(output); status == healthServiceAccessStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return output, nil
+}
+
+func statusHealthServiceAccessForOrganization(ctx context.Context, conn *health.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findHealthServiceAccessStatusForOrganization(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output), nil
+	}
+}
+
+func waitOrganizationalViewEnabled(ctx context.Context, conn *health.Client, timeout time.Duration) (*string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{healthServiceAccessStatusDisabled, healthServiceAccessStatusPending},
+		Target:  []string{healthServiceAccessStatusEnabled},
+		Refresh: statusHealthServiceAccessForOrganization(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*string); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationalViewDisabled(ctx context.Context, conn *health.Client, timeout time.Duration) (*string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{healthServiceAccessStatusEnabled, healthServiceAccessStatusPending},
+		Target:  []string{healthServiceAccessStatusDisabled},
+		Refresh: statusHealthServiceAccessForOrganization(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*string); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type organizationalViewResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
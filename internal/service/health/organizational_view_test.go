@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealth "github.com/hashicorp/terraform-provider-aws/internal/service/health"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthOrganizationalView_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccOrganizationalView_basic,
+		acctest.CtDisappears: testAccOrganizationalView_disappears,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccOrganizationalView_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_health_organizational_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationalViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalViewConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationalViewExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccOrganizationalView_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_health_organizational_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationalViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalViewConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationalViewExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfhealth.ResourceOrganizationalView, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationalViewDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_health_organizational_view" {
+				continue
+			}
+
+			_, err := tfhealth.FindOrganizationalView(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Health Organizational View %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationalViewExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthClient(ctx)
+
+		_, err := tfhealth.FindOrganizationalView(ctx, conn)
+
+		return err
+	}
+}
+
+const testAccOrganizationalViewConfig_basic = `
+resource "aws_health_organizational_view" "test" {}
+`
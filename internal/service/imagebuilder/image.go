@@ -5,7 +5,10 @@ package imagebuilder
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -13,12 +16,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,11 +38,16 @@ func ResourceImage() *schema.Resource {
 		DeleteWithoutTimeout: resourceImageDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("rebuild_on_change", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +58,6 @@ func ResourceImage() *schema.Resource {
 			"container_recipe_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 				ExactlyOneOf: []string{"container_recipe_arn", "image_recipe_arn"},
 			},
@@ -77,7 +86,6 @@ func ResourceImage() *schema.Resource {
 			"image_recipe_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 				ExactlyOneOf: []string{"container_recipe_arn", "image_recipe_arn"},
 			},
@@ -144,7 +152,6 @@ func ResourceImage() *schema.Resource {
 			"infrastructure_configuration_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrName: {
@@ -212,6 +219,11 @@ func ResourceImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rebuild_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVersion: {
@@ -268,66 +280,35 @@ func ResourceImage() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceImageCustomizeDiff,
+		),
 	}
 }
 
+// imageRebuildAttributes are the attributes that, when changed, either force
+// replacement of the image or trigger a new build of it.
+var imageRebuildAttributes = []string{
+	"container_recipe_arn",
+	"image_recipe_arn",
+	"infrastructure_configuration_arn",
+}
+
 func resourceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
 
-	input := &imagebuilder.CreateImageInput{
-		ClientToken:                  aws.String(id.UniqueId()),
-		EnhancedImageMetadataEnabled: aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
-		Tags:                         getTagsIn(ctx),
-	}
-
-	if v, ok := d.GetOk("container_recipe_arn"); ok {
-		input.ContainerRecipeArn = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("distribution_configuration_arn"); ok {
-		input.DistributionConfigurationArn = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("execution_role"); ok {
-		input.ExecutionRole = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("image_recipe_arn"); ok {
-		input.ImageRecipeArn = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("image_scanning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.ImageScanningConfiguration = expandImageScanningConfiguration(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("image_tests_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.ImageTestsConfiguration = expandImageTestConfiguration(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("infrastructure_configuration_arn"); ok {
-		input.InfrastructureConfigurationArn = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("workflow"); ok && len(v.(*schema.Set).List()) > 0 {
-		input.Workflows = expandWorkflowConfigurations(v.(*schema.Set).List())
-	}
-
-	output, err := conn.CreateImageWithContext(ctx, input)
+	imageBuildVersionARN, err := createImage(ctx, conn, d)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Image Builder Image: %s", err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "creating Image Builder Image: empty response")
-	}
-
-	d.SetId(aws.StringValue(output.ImageBuildVersionArn))
+	d.SetId(imageBuildVersionARN)
 
-	if _, err := waitImageStatusAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Image Builder Image (%s) to become available: %s", d.Id(), err)
+	if image, err := waitImageStatusAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return append(diags, imageBuildFailureDiagnostic(meta.(*conns.AWSClient), d.Id(), image, err))
 	}
 
 	return append(diags, resourceImageRead(ctx, d, meta)...)
@@ -407,8 +388,41 @@ func resourceImageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	// Changes to the rebuild attributes only reach Update when rebuild_on_change is enabled.
+	if d.HasChanges(imageRebuildAttributes...) {
+		imageBuildVersionARN, err := createImage(ctx, conn, d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "rebuilding Image Builder Image (%s): %s", d.Id(), err)
+		}
+
+		// The previous build is kept in state until the new build succeeds.
+		// A new build that fails or times out is not tracked in state, so remove it.
+		if image, err := waitImageStatusAvailable(ctx, conn, imageBuildVersionARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, imageBuildFailureDiagnostic(meta.(*conns.AWSClient), imageBuildVersionARN, image, err))
+
+			if image == nil || aws.StringValue(image.State.Status) != imagebuilder.ImageStatusFailed {
+				if err := cancelImageCreation(ctx, conn, imageBuildVersionARN); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "canceling Image Builder Image (%s) creation: %s", imageBuildVersionARN, err)
+				}
+			}
+
+			if err := deleteImage(ctx, conn, imageBuildVersionARN); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "deleting failed Image Builder Image (%s): %s", imageBuildVersionARN, err)
+			}
+
+			return diags
+		}
 
-	// Tags only.
+		previousImageBuildVersionARN := d.Id()
+		d.SetId(imageBuildVersionARN)
+
+		if err := deleteImage(ctx, conn, previousImageBuildVersionARN); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting previous Image Builder Image (%s): %s", previousImageBuildVersionARN, err)
+		}
+	}
 
 	return append(diags, resourceImageRead(ctx, d, meta)...)
 }
@@ -417,21 +431,155 @@ func resourceImageDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
 
-	input := &imagebuilder.DeleteImageInput{
-		ImageBuildVersionArn: aws.String(d.Id()),
+	if err := deleteImage(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Image Builder Image (%s): %s", d.Id(), err)
 	}
 
-	_, err := conn.DeleteImageWithContext(ctx, input)
+	return diags
+}
 
-	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
-		return diags
+func resourceImageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	var rebuild bool
+	for _, k := range imageRebuildAttributes {
+		if !d.HasChange(k) {
+			continue
+		}
+
+		if !d.Get("rebuild_on_change").(bool) {
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		rebuild = true
 	}
 
+	if rebuild {
+		for _, k := range []string{names.AttrARN, "date_created", names.AttrName, "os_version", "output_resources", "platform", names.AttrVersion} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func createImage(ctx context.Context, conn *imagebuilder.Imagebuilder, d *schema.ResourceData) (string, error) {
+	input := &imagebuilder.CreateImageInput{
+		ClientToken:                  aws.String(id.UniqueId()),
+		EnhancedImageMetadataEnabled: aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
+		Tags:                         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("container_recipe_arn"); ok {
+		input.ContainerRecipeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distribution_configuration_arn"); ok {
+		input.DistributionConfigurationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_role"); ok {
+		input.ExecutionRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_recipe_arn"); ok {
+		input.ImageRecipeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_scanning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ImageScanningConfiguration = expandImageScanningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("image_tests_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ImageTestsConfiguration = expandImageTestConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("infrastructure_configuration_arn"); ok {
+		input.InfrastructureConfigurationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workflow"); ok && len(v.(*schema.Set).List()) > 0 {
+		input.Workflows = expandWorkflowConfigurations(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateImageWithContext(ctx, input)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Image Builder Image (%s): %s", d.Id(), err)
+		return "", err
 	}
 
-	return diags
+	if output == nil || output.ImageBuildVersionArn == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.ImageBuildVersionArn), nil
+}
+
+func cancelImageCreation(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionARN string) error {
+	_, err := conn.CancelImageCreationWithContext(ctx, &imagebuilder.CancelImageCreationInput{
+		ClientToken:          aws.String(id.UniqueId()),
+		ImageBuildVersionArn: aws.String(imageBuildVersionARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func deleteImage(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionARN string) error {
+	log.Printf("[DEBUG] Deleting Image Builder Image: %s", imageBuildVersionARN)
+	_, err := conn.DeleteImageWithContext(ctx, &imagebuilder.DeleteImageInput{
+		ImageBuildVersionArn: aws.String(imageBuildVersionARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// imageBuildFailureDiagnostic returns an error diagnostic for a failed image build,
+// pointing at the build's CloudWatch Logs log group.
+func imageBuildFailureDiagnostic(client *conns.AWSClient, imageBuildVersionARN string, image *imagebuilder.Image, err error) diag.Diagnostic {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("waiting for Image Builder Image (%s) to become available: %s", imageBuildVersionARN, err),
+	}
+
+	if image == nil || image.Name == nil {
+		return diagnostic
+	}
+
+	logGroupName := imageBuildLogGroupName(aws.StringValue(image.Name))
+	detail := fmt.Sprintf("Build logs are written to the CloudWatch Logs log group %q, log stream %q, unless the infrastructure configuration sends them to S3.", logGroupName, aws.StringValue(image.Version))
+
+	if client.Partition == names.StandardPartitionID {
+		detail = fmt.Sprintf("%s\n\nView the logs at %s", detail, imageBuildLogGroupConsoleURL(client.Region, logGroupName))
+	}
+
+	diagnostic.Detail = detail
+
+	return diagnostic
+}
+
+func imageBuildLogGroupName(imageName string) string {
+	return "/aws/imagebuilder/" + imageName
+}
+
+func imageBuildLogGroupConsoleURL(region, logGroupName string) string {
+	// The CloudWatch console double-escapes log group names, with "$" in place of "%".
+	return fmt.Sprintf("https://%[1]s.console.aws.amazon.com/cloudwatch/home?region=%[1]s#logsV2:log-groups/log-group/%[2]s", region, strings.ReplaceAll(url.QueryEscape(url.QueryEscape(logGroupName)), "%", "$"))
 }
 
 func flattenOutputResources(apiObject *imagebuilder.OutputResources) map[string]interface{} {
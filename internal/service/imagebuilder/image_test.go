@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -348,6 +349,49 @@ func TestAccImageBuilderImage_workflows(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImage_rebuildOnChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig_rebuildOnChange(rName, "aws_imagebuilder_image_recipe.test.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "imagebuilder", regexache.MustCompile(fmt.Sprintf("image/%s/1.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttr(resourceName, "rebuild_on_change", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rebuild_on_change"},
+			},
+			{
+				Config: testAccImageConfig_rebuildOnChange(rName, "aws_imagebuilder_image_recipe.test2.arn"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "imagebuilder", regexache.MustCompile(fmt.Sprintf("image/%s/2.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "image_recipe_arn", "aws_imagebuilder_image_recipe.test2", names.AttrARN),
+					resource.TestMatchResourceAttr(resourceName, names.AttrVersion, regexache.MustCompile(`2.0.0/[1-9][0-9]*`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImageDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn(ctx)
@@ -587,6 +631,28 @@ resource "aws_imagebuilder_image" "test" {
 `)
 }
 
+func testAccImageConfig_rebuildOnChange(rName, imageRecipeARN string) string {
+	return acctest.ConfigCompose(
+		testAccImageBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test2" {
+  component {
+    component_arn = data.aws_imagebuilder_component.update-linux.arn
+  }
+
+  name         = %[1]q
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = "2.0.0"
+}
+
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = %[2]s
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  rebuild_on_change                = true
+}
+`, rName, imageRecipeARN))
+}
+
 func testAccImageConfig_workflows(rName string) string {
 	return acctest.ConfigCompose(
 		testAccImageBaseConfig(rName),
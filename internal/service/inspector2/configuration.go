@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	configurationStatusFailed  = "FAILED"
+	configurationStatusPending = "PENDING"
+	configurationStatusSuccess = "SUCCESS"
+)
+
+// @SDKResource("aws_inspector2_configuration", name="Configuration")
+func resourceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPut,
+		ReadWithoutTimeout:   resourceConfigurationRead,
+		UpdateWithoutTimeout: resourceConfigurationPut,
+		DeleteWithoutTimeout: resourceConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ec2_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"ec2_configuration", "ecr_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scan_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Ec2ScanMode](),
+						},
+						"scan_mode_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ecr_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"ec2_configuration", "ecr_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pull_date_rescan_duration": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EcrPullDateRescanDuration](),
+						},
+						"rescan_duration": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EcrRescanDuration](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	input := &inspector2.UpdateConfigurationInput{}
+
+	if v, ok := d.GetOk("ec2_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Ec2Configuration = expandEC2Configuration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("ecr_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EcrConfiguration = expandECRConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.UpdateConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Inspector2 Configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitConfigurationUpdated(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 Configuration (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	output, err := findConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Inspector2 Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("ec2_configuration", flattenEC2ConfigurationState(output.Ec2Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ec2_configuration: %s", err)
+	}
+	if err := d.Set("ecr_configuration", flattenECRConfigurationState(output.EcrConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ecr_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	// Restore the service defaults.
+	input := &inspector2.UpdateConfigurationInput{
+		Ec2Configuration: &awstypes.Ec2Configuration{
+			ScanMode: awstypes.Ec2ScanModeEc2SsmAgentBased,
+		},
+		EcrConfiguration: &awstypes.EcrConfiguration{
+			RescanDuration: awstypes.EcrRescanDurationLifetime,
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting Inspector2 Configuration: %s", d.Id())
+	_, err := conn.UpdateConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting Inspector2 Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConfigurationUpdated(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 Configuration (%s) reset: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguration(ctx context.Context, conn *inspector2.Client) (*inspector2.GetConfigurationOutput, error) {
+	input := &inspector2.GetConfigurationInput{}
+
+	output, err := conn.GetConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusConfiguration(ctx context.Context, conn *inspector2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.EcrConfiguration; v != nil && v.RescanDurationState != nil {
+			switch v.RescanDurationState.Status {
+			case awstypes.EcrRescanDurationStatusFailed:
+				return output, configurationStatusFailed, nil
+			case awstypes.EcrRescanDurationStatusPending:
+				return output, configurationStatusPending, nil
+			}
+		}
+
+		if v := output.Ec2Configuration; v != nil && v.ScanModeState != nil && v.ScanModeState.ScanModeStatus == awstypes.Ec2ScanModeStatusPending {
+			return output, configurationStatusPending, nil
+		}
+
+		return output, configurationStatusSuccess, nil
+	}
+}
+
+func waitConfigurationUpdated(ctx context.Context, conn *inspector2.Client, timeout time.Duration) (*inspector2.GetConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{configurationStatusPending},
+		Target:                    []string{configurationStatusSuccess},
+		Refresh:                   statusConfiguration(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                time.Second * 5,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.GetConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEC2Configuration(tfMap map[string]interface{}) *awstypes.Ec2Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.Ec2Configuration{}
+
+	if v, ok := tfMap["scan_mode"].(string); ok && v != "" {
+		apiObject.ScanMode = awstypes.Ec2ScanMode(v)
+	}
+
+	return apiObject
+}
+
+func expandECRConfiguration(tfMap map[string]interface{}) *awstypes.EcrConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EcrConfiguration{}
+
+	if v, ok := tfMap["pull_date_rescan_duration"].(string); ok && v != "" {
+		apiObject.PullDateRescanDuration = awstypes.EcrPullDateRescanDuration(v)
+	}
+
+	if v, ok := tfMap["rescan_duration"].(string); ok && v != "" {
+		apiObject.RescanDuration = awstypes.EcrRescanDuration(v)
+	}
+
+	return apiObject
+}
+
+func flattenEC2ConfigurationState(apiObject *awstypes.Ec2ConfigurationState) []interface{} {
+	if apiObject == nil || apiObject.ScanModeState == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"scan_mode":        apiObject.ScanModeState.ScanMode,
+		"scan_mode_status": apiObject.ScanModeState.ScanModeStatus,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenECRConfigurationState(apiObject *awstypes.EcrConfigurationState) []interface{} {
+	if apiObject == nil || apiObject.RescanDurationState == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"pull_date_rescan_duration": apiObject.RescanDurationState.PullDateRescanDuration,
+		"rescan_duration":           apiObject.RescanDurationState.RescanDuration,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationConfig_ec2("EC2_HYBRID"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ec2_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ec2_configuration.0.scan_mode", "EC2_HYBRID"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_configuration.0.scan_mode_status", "SUCCESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationConfig_ec2("EC2_SSM_AGENT_BASED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ec2_configuration.0.scan_mode", "EC2_SSM_AGENT_BASED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfiguration_ecr(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationConfig_ecr("DAYS_30", "DAYS_14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ecr_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ecr_configuration.0.pull_date_rescan_duration", "DAYS_14"),
+					resource.TestCheckResourceAttr(resourceName, "ecr_configuration.0.rescan_duration", "DAYS_30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_configuration" {
+				continue
+			}
+
+			output, err := tfinspector2.FindConfiguration(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if v := output.Ec2Configuration; v != nil && v.ScanModeState != nil && v.ScanModeState.ScanMode != "EC2_SSM_AGENT_BASED" {
+				return fmt.Errorf("Inspector2 Configuration %s EC2 scan mode not reset", rs.Primary.ID)
+			}
+
+			if v := output.EcrConfiguration; v != nil && v.RescanDurationState != nil && v.RescanDurationState.RescanDuration != "LIFETIME" {
+				return fmt.Errorf("Inspector2 Configuration %s ECR rescan duration not reset", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		_, err := tfinspector2.FindConfiguration(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccConfigurationConfig_base() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = ["EC2", "ECR"]
+}
+`
+}
+
+func testAccConfigurationConfig_ec2(scanMode string) string {
+	return acctest.ConfigCompose(testAccConfigurationConfig_base(), fmt.Sprintf(`
+resource "aws_inspector2_configuration" "test" {
+  ec2_configuration {
+    scan_mode = %[1]q
+  }
+
+  depends_on = [aws_inspector2_enabler.test]
+}
+`, scanMode))
+}
+
+func testAccConfigurationConfig_ecr(rescanDuration, pullDateRescanDuration string) string {
+	return acctest.ConfigCompose(testAccConfigurationConfig_base(), fmt.Sprintf(`
+resource "aws_inspector2_configuration" "test" {
+  ecr_configuration {
+    rescan_duration           = %[1]q
+    pull_date_rescan_duration = %[2]q
+  }
+
+  depends_on = [aws_inspector2_enabler.test]
+}
+`, rescanDuration, pullDateRescanDuration))
+}
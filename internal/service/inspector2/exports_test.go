@@ -5,6 +5,10 @@ package inspector2
 
 // Exports for use in tests only.
 var (
+	ResourceConfiguration = resourceConfiguration
+
+	FindConfiguration = findConfiguration
+
 	EnablerID      = enablerID
 	ParseEnablerID = parseEnablerID
 )
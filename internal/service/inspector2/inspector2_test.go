@@ -13,6 +13,10 @@ func TestAccInspector2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"Configuration": {
+			acctest.CtBasic: testAccConfiguration_basic,
+			"ecr":           testAccConfiguration_ecr,
+		},
 		"Enabler": {
 			acctest.CtBasic:                      testAccEnabler_basic,
 			"accountID":                          testAccEnabler_accountID,
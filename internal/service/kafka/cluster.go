@@ -54,6 +54,7 @@ func resourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return semver.LessThan(new.(string), old.(string))
 			}),
+			resourceClusterStorageCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -808,6 +809,31 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    types.StorageMode(d.Get("storage_mode").(string)),
+		}
+
+		output, err := conn.UpdateStorage(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Cluster (%s) storage mode: %s", d.Id(), err)
+		}
+
+		clusterOperationARN := aws.ToString(output.ClusterOperationArn)
+
+		if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Cluster (%s) operation (%s) complete: %s", d.Id(), clusterOperationARN, err)
+		}
+
+		// refresh the current_version attribute after each update
+		if err := refreshClusterVersion(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("number_of_broker_nodes") {
 		input := &kafka.UpdateBrokerCountInput{
 			ClusterArn:                aws.String(d.Id()),
@@ -1136,6 +1162,16 @@ func statusClusterOperationState(ctx context.Context, conn *kafka.Client, arn st
 			return nil, "", err
 		}
 
+		// Storage mode and broker type changes can take hours, so surface progress in the logs.
+		if n := len(output.OperationSteps); n > 0 {
+			step := output.OperationSteps[n-1]
+			var status string
+			if step.StepInfo != nil {
+				status = aws.ToString(step.StepInfo.StepStatus)
+			}
+			log.Printf("[DEBUG] MSK Cluster operation (%s) step %d: %s (%s)", arn, n, aws.ToString(step.StepName), status)
+		}
+
 		return output, aws.ToString(output.OperationState), nil
 	}
 }
@@ -1203,6 +1239,32 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Client, arn
 	return nil, err
 }
 
+func resourceClusterStorageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	instanceType := d.Get("broker_node_group_info.0.instance_type").(string)
+
+	if d.Id() != "" && d.HasChange("broker_node_group_info.0.instance_type") {
+		if o, n := d.GetChange("broker_node_group_info.0.instance_type"); isExpressBrokerInstanceType(o.(string)) != isExpressBrokerInstanceType(n.(string)) {
+			return fmt.Errorf("broker_node_group_info.0.instance_type cannot be changed between Express and Standard broker types (%s to %s)", o, n)
+		}
+	}
+
+	if storageMode := types.StorageMode(d.Get("storage_mode").(string)); storageMode == types.StorageModeTiered {
+		if isExpressBrokerInstanceType(instanceType) {
+			return fmt.Errorf("storage_mode %s is not supported with Express broker type %s", storageMode, instanceType)
+		}
+
+		if instanceType == instanceTypeKafkaT3Small {
+			return fmt.Errorf("storage_mode %s is not supported with broker type %s", storageMode, instanceType)
+		}
+	}
+
+	return nil
+}
+
+func isExpressBrokerInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, instanceTypeExpressPrefix)
+}
+
 func clusterUUIDFromARN(clusterARN string) (string, error) {
 	parsedARN, err := arn.Parse(clusterARN)
 	if err != nil {
@@ -980,7 +980,7 @@ func TestAccKafkaCluster_openMonitoring(t *testing.T) {
 
 func TestAccKafkaCluster_storageMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var cluster types.ClusterInfo
+	var cluster1, cluster2 types.ClusterInfo
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_msk_cluster.test"
 
@@ -993,11 +993,49 @@ func TestAccKafkaCluster_storageMode(t *testing.T) {
 			{
 				Config: testAccClusterConfig_storageMode(rName, "TIERED", "2.8.2.tiered"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "kafka", regexache.MustCompile(`cluster/.+$`)),
 					resource.TestCheckResourceAttr(resourceName, "storage_mode", "TIERED"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"current_version",
+				},
+			},
+			{
+				Config: testAccClusterConfig_storageMode(rName, "LOCAL", "2.8.2.tiered"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode", "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaCluster_storageModeInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KafkaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_storageModeInstanceType(rName, "TIERED", "express.m7g.large"),
+				ExpectError: regexache.MustCompile(`storage_mode TIERED is not supported with Express broker type`),
+			},
+			{
+				Config:      testAccClusterConfig_storageModeInstanceType(rName, "TIERED", "kafka.t3.small"),
+				ExpectError: regexache.MustCompile(`storage_mode TIERED is not supported with broker type`),
+			},
 		},
 	})
 }
@@ -1967,6 +2005,23 @@ resource "aws_msk_cluster" "test" {
 `, rName, storageMode, kafkaVersion))
 }
 
+func testAccClusterConfig_storageModeInstanceType(rName, storageMode, instanceType string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  storage_mode           = %[2]q
+  kafka_version          = "3.6.0"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    instance_type   = %[3]q
+    security_groups = [aws_security_group.test.id]
+  }
+}
+`, rName, storageMode, instanceType))
+}
+
 func testAccClusterConfig_numberOfBrokerNodes(rName string, brokerCount int) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
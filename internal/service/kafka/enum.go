@@ -10,6 +10,11 @@ const (
 	clusterOperationStateUpdateInProgress = "UPDATE_IN_PROGRESS"
 )
 
+const (
+	instanceTypeExpressPrefix = "express."
+	instanceTypeKafkaT3Small  = "kafka.t3.small"
+)
+
 type publicAccessType string
 
 const (
@@ -175,6 +175,24 @@ func resourceReplicator() *schema.Resource {
 											},
 										},
 									},
+									"topic_name_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrType: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													Default:          types.ReplicationTopicNameConfigurationTypePrefixedWithSourceClusterAlias,
+													ValidateDiagFunc: enum.Validate[types.ReplicationTopicNameConfigurationType](),
+												},
+											},
+										},
+									},
 									"topics_to_exclude": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -579,6 +597,10 @@ func flattenTopicReplication(apiObject *types.TopicReplication) map[string]inter
 		tfMap["starting_position"] = []interface{}{flattenReplicationStartingPosition(v)}
 	}
 
+	if v := apiObject.TopicNameConfiguration; v != nil {
+		tfMap["topic_name_configuration"] = []interface{}{flattenReplicationTopicNameConfiguration(v)}
+	}
+
 	if v := apiObject.TopicsToReplicate; v != nil {
 		tfMap["topics_to_replicate"] = v
 	}
@@ -604,6 +626,20 @@ func flattenReplicationStartingPosition(apiObject *types.ReplicationStartingPosi
 	return tfMap
 }
 
+func flattenReplicationTopicNameConfiguration(apiObject *types.ReplicationTopicNameConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Type; v != "" {
+		tfMap[names.AttrType] = v
+	}
+
+	return tfMap
+}
+
 func flattenKafkaClusterDescriptions(apiObjects []types.KafkaClusterDescription) []interface{} { // nosemgrep:ci.kafka-in-func-name
 	if len(apiObjects) == 0 {
 		return nil
@@ -799,6 +835,10 @@ func expandTopicReplication(tfMap map[string]interface{}) *types.TopicReplicatio
 		apiObject.StartingPosition = expandReplicationStartingPosition(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["topic_name_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TopicNameConfiguration = expandReplicationTopicNameConfiguration(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["topics_to_replicate"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.TopicsToReplicate = flex.ExpandStringValueSet(v)
 	}
@@ -820,6 +860,16 @@ func expandReplicationStartingPosition(tfMap map[string]interface{}) *types.Repl
 	return apiObject
 }
 
+func expandReplicationTopicNameConfiguration(tfMap map[string]interface{}) *types.ReplicationTopicNameConfiguration {
+	apiObject := &types.ReplicationTopicNameConfiguration{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok {
+		apiObject.Type = types.ReplicationTopicNameConfigurationType(v)
+	}
+
+	return apiObject
+}
+
 func expandKafkaClusters(tfList []interface{}) []types.KafkaCluster { // nosemgrep:ci.kafka-in-func-name
 	if len(tfList) == 0 {
 		return nil
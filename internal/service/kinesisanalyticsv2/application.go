@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -136,6 +137,21 @@ func resourceApplication() *schema.Resource {
 								},
 								ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
 							},
+							"application_system_rollback_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"rollback_enabled": {
+											Type:     schema.TypeBool,
+											Required: true,
+										},
+									},
+								},
+								ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
+							},
 							"environment_properties": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -747,6 +763,7 @@ func resourceApplication() *schema.Resource {
 								},
 								ConflictsWith: []string{
 									"application_configuration.0.application_snapshot_configuration",
+									"application_configuration.0.application_system_rollback_configuration",
 									"application_configuration.0.environment_properties",
 									"application_configuration.0.flink_application_configuration",
 									"application_configuration.0.run_configuration",
@@ -855,6 +872,10 @@ func resourceApplication() *schema.Resource {
 					Required:     true,
 					ValidateFunc: verify.ValidARN,
 				},
+				"snapshot_before_update": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				"start_application": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -959,6 +980,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		currentApplicationVersionID := int64(d.Get("version_id").(int))
 		updateApplication := false
 
+		if _, ok := d.GetOk("snapshot_before_update"); ok {
+			if err := createApplicationSnapshotBeforeUpdate(ctx, conn, applicationName, currentApplicationVersionID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		input := &kinesisanalyticsv2.UpdateApplicationInput{
 			ApplicationName: aws.String(applicationName),
 		}
@@ -978,6 +1005,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 				updateApplication = true
 			}
 
+			if d.HasChange("application_configuration.0.application_system_rollback_configuration") {
+				applicationConfigurationUpdate.ApplicationSystemRollbackConfigurationUpdate = expandApplicationSystemRollbackConfigurationUpdate(d.Get("application_configuration.0.application_system_rollback_configuration").([]interface{}))
+
+				updateApplication = true
+			}
+
 			if d.HasChange("application_configuration.0.environment_properties") {
 				applicationConfigurationUpdate.EnvironmentPropertyUpdates = expandEnvironmentPropertyUpdates(d.Get("application_configuration.0.environment_properties").([]interface{}))
 
@@ -1554,6 +1587,41 @@ func stopApplication(ctx context.Context, conn *kinesisanalyticsv2.Client, input
 	return nil
 }
 
+// createApplicationSnapshotBeforeUpdate takes a snapshot of a running application so that the upcoming update can be reverted.
+// Snapshots can only be taken of running applications with snapshots enabled; otherwise this is a no-op.
+func createApplicationSnapshotBeforeUpdate(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName string, applicationVersionID int64, timeout time.Duration) error {
+	application, err := findApplicationDetailByName(ctx, conn, applicationName)
+
+	if err != nil {
+		return fmt.Errorf("reading Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if application.ApplicationStatus != awstypes.ApplicationStatusRunning {
+		return nil
+	}
+
+	if v := application.ApplicationConfigurationDescription; v == nil || v.ApplicationSnapshotConfigurationDescription == nil || !aws.ToBool(v.ApplicationSnapshotConfigurationDescription.SnapshotsEnabled) {
+		return nil
+	}
+
+	snapshotName := sdkid.PrefixedUniqueId(fmt.Sprintf("%s-version-%d-", applicationName, applicationVersionID))
+	input := &kinesisanalyticsv2.CreateApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application (%s) snapshot (%s) before update", applicationName, snapshotName)
+	if _, err := conn.CreateApplicationSnapshot(ctx, input); err != nil {
+		return fmt.Errorf("creating Kinesis Analytics v2 Application (%s) snapshot (%s): %w", applicationName, snapshotName, err)
+	}
+
+	if _, err := waitSnapshotCreated(ctx, conn, applicationName, snapshotName, timeout); err != nil {
+		return fmt.Errorf("waiting for Kinesis Analytics v2 Application (%s) snapshot (%s) create: %w", applicationName, snapshotName, err)
+	}
+
+	return nil
+}
+
 func findApplicationDetailByName(ctx context.Context, conn *kinesisanalyticsv2.Client, name string) (*awstypes.ApplicationDetail, error) {
 	input := &kinesisanalyticsv2.DescribeApplicationInput{
 		ApplicationName: aws.String(name),
@@ -1834,6 +1902,18 @@ func expandApplicationConfiguration(vApplicationConfiguration []interface{}) *aw
 		applicationConfiguration.ApplicationSnapshotConfiguration = applicationSnapshotConfiguration
 	}
 
+	if vApplicationSystemRollbackConfiguration, ok := mApplicationConfiguration["application_system_rollback_configuration"].([]interface{}); ok && len(vApplicationSystemRollbackConfiguration) > 0 && vApplicationSystemRollbackConfiguration[0] != nil {
+		applicationSystemRollbackConfiguration := &awstypes.ApplicationSystemRollbackConfiguration{}
+
+		mApplicationSystemRollbackConfiguration := vApplicationSystemRollbackConfiguration[0].(map[string]interface{})
+
+		if vRollbackEnabled, ok := mApplicationSystemRollbackConfiguration["rollback_enabled"].(bool); ok {
+			applicationSystemRollbackConfiguration.RollbackEnabled = aws.Bool(vRollbackEnabled)
+		}
+
+		applicationConfiguration.ApplicationSystemRollbackConfiguration = applicationSystemRollbackConfiguration
+	}
+
 	if vEnvironmentProperties, ok := mApplicationConfiguration["environment_properties"].([]interface{}); ok && len(vEnvironmentProperties) > 0 && vEnvironmentProperties[0] != nil {
 		environmentProperties := &awstypes.EnvironmentProperties{}
 
@@ -2099,6 +2179,22 @@ func expandApplicationSnapshotConfigurationUpdate(vApplicationSnapshotConfigurat
 	return applicationSnapshotConfigurationUpdate
 }
 
+func expandApplicationSystemRollbackConfigurationUpdate(vApplicationSystemRollbackConfiguration []interface{}) *awstypes.ApplicationSystemRollbackConfigurationUpdate {
+	if len(vApplicationSystemRollbackConfiguration) == 0 || vApplicationSystemRollbackConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationSystemRollbackConfigurationUpdate := &awstypes.ApplicationSystemRollbackConfigurationUpdate{}
+
+	mApplicationSystemRollbackConfiguration := vApplicationSystemRollbackConfiguration[0].(map[string]interface{})
+
+	if vRollbackEnabled, ok := mApplicationSystemRollbackConfiguration["rollback_enabled"].(bool); ok {
+		applicationSystemRollbackConfigurationUpdate.RollbackEnabledUpdate = aws.Bool(vRollbackEnabled)
+	}
+
+	return applicationSystemRollbackConfigurationUpdate
+}
+
 func expandCloudWatchLoggingOptions(vCloudWatchLoggingOptions []interface{}) []awstypes.CloudWatchLoggingOption {
 	if len(vCloudWatchLoggingOptions) == 0 || vCloudWatchLoggingOptions[0] == nil {
 		return nil
@@ -2717,6 +2813,14 @@ func flattenApplicationConfigurationDescription(applicationConfigurationDescript
 		mApplicationConfiguration["application_snapshot_configuration"] = []interface{}{mApplicationSnapshotConfiguration}
 	}
 
+	if applicationSystemRollbackConfigurationDescription := applicationConfigurationDescription.ApplicationSystemRollbackConfigurationDescription; applicationSystemRollbackConfigurationDescription != nil {
+		mApplicationSystemRollbackConfiguration := map[string]interface{}{
+			"rollback_enabled": aws.ToBool(applicationSystemRollbackConfigurationDescription.RollbackEnabled),
+		}
+
+		mApplicationConfiguration["application_system_rollback_configuration"] = []interface{}{mApplicationSystemRollbackConfiguration}
+	}
+
 	if environmentPropertyDescriptions := applicationConfigurationDescription.EnvironmentPropertyDescriptions; environmentPropertyDescriptions != nil && len(environmentPropertyDescriptions.PropertyGroupDescriptions) > 0 {
 		mEnvironmentProperties := map[string]interface{}{}
 
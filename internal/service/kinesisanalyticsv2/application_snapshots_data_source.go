@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisanalyticsv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_kinesisanalyticsv2_application_snapshots", name="Application Snapshots")
+func dataSourceApplicationSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_version_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"runtime_environment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApplicationSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisAnalyticsV2Client(ctx)
+
+	applicationName := d.Get("application_name").(string)
+	input := &kinesisanalyticsv2.ListApplicationSnapshotsInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	output, err := findSnapshotSummaries(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Analytics v2 Application (%s) Snapshots: %s", applicationName, err)
+	}
+
+	d.SetId(applicationName)
+	if err := d.Set("snapshots", flattenSnapshotSummaries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snapshots: %s", err)
+	}
+
+	return diags
+}
+
+func findSnapshotSummaries(ctx context.Context, conn *kinesisanalyticsv2.Client, input *kinesisanalyticsv2.ListApplicationSnapshotsInput) ([]awstypes.SnapshotDetails, error) {
+	var output []awstypes.SnapshotDetails
+
+	pages := kinesisanalyticsv2.NewListApplicationSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SnapshotSummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenSnapshotSummaries(apiObjects []awstypes.SnapshotDetails) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"application_version_id": aws.ToInt64(apiObject.ApplicationVersionId),
+			"runtime_environment":    apiObject.RuntimeEnvironment,
+			"snapshot_name":          aws.ToString(apiObject.SnapshotName),
+			"snapshot_status":        apiObject.SnapshotStatus,
+		}
+
+		if v := apiObject.SnapshotCreationTimestamp; v != nil {
+			tfMap["snapshot_creation_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
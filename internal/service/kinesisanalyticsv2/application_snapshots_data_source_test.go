@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisanalyticsv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisAnalyticsV2ApplicationSnapshotsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kinesisanalyticsv2_application_snapshots.test"
+	resourceName := "aws_kinesisanalyticsv2_application_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisAnalyticsV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationSnapshotsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_name", resourceName, "application_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.application_version_id", resourceName, "application_version_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.0.runtime_environment", "FLINK-1_11"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.snapshot_creation_timestamp", resourceName, "snapshot_creation_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.snapshot_name", resourceName, "snapshot_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.0.snapshot_status", "READY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationSnapshotsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationSnapshotConfig_basic(rName), `
+data "aws_kinesisanalyticsv2_application_snapshots" "test" {
+  application_name = aws_kinesisanalyticsv2_application_snapshot.test.application_name
+}
+`)
+}
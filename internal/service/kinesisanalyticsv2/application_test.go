@@ -1710,6 +1710,47 @@ func TestAccKinesisAnalyticsV2Application_FlinkApplicationStartApplication_onUpd
 	})
 }
 
+func TestAccKinesisAnalyticsV2Application_FlinkApplication_systemRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisAnalyticsV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_flinkSystemRollback(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_system_rollback_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_system_rollback_configuration.0.rollback_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_before_update", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snapshot_before_update"},
+			},
+			{
+				Config: testAccApplicationConfig_flinkSystemRollback(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_system_rollback_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_system_rollback_configuration.0.rollback_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisAnalyticsV2Application_FlinkApplication_updateRunning(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.ApplicationDetail
@@ -4501,6 +4542,25 @@ resource "aws_kinesisanalyticsv2_application" "test" {
 `, rName, runtimeEnvironment))
 }
 
+func testAccApplicationConfig_flinkSystemRollback(rName string, rollbackEnabled bool) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_18"
+  service_execution_role = aws_iam_role.test[0].arn
+  snapshot_before_update = true
+
+  application_configuration {
+    application_system_rollback_configuration {
+      rollback_enabled = %[2]t
+    }
+  }
+}
+`, rName, rollbackEnabled))
+}
+
 func testAccApplicationConfig_basicSQL(rName string) string {
 	return acctest.ConfigCompose(
 		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_launchwizard_deployment", name="Deployment")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffValidateSpecifications,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_pattern_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"resource_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"specifications": {
+				Type:      schema.TypeMap,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &launchwizard.CreateDeploymentInput{
+		DeploymentPatternName: aws.String(d.Get("deployment_pattern_name").(string)),
+		Name:                  aws.String(name),
+		Specifications:        flex.ExpandStringValueMap(d.Get("specifications").(map[string]interface{})),
+		WorkloadName:          aws.String(d.Get("workload_name").(string)),
+	}
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Launch Wizard Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+
+	if _, err := waitDeploymentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Launch Wizard Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, deployment.DeploymentArn)
+	if deployment.CreatedAt != nil {
+		d.Set(names.AttrCreatedAt, aws.ToTime(deployment.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreatedAt, nil)
+	}
+	d.Set("deployment_pattern_name", deployment.PatternName)
+	d.Set(names.AttrName, deployment.Name)
+	d.Set("resource_group", deployment.ResourceGroup)
+	d.Set(names.AttrStatus, deployment.Status)
+	d.Set("workload_name", deployment.WorkloadName)
+
+	// Secret values are masked in the API response, so only keys that are returned unmasked are refreshed.
+	specifications := d.Get("specifications").(map[string]interface{})
+	for k, v := range deployment.Specifications {
+		if _, ok := specifications[k]; ok && v != maskedSpecificationValue {
+			specifications[k] = v
+		}
+	}
+	d.Set("specifications", specifications)
+
+	return diags
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Launch Wizard Deployment: %s", d.Id())
+	_, err := conn.DeleteDeployment(ctx, &launchwizard.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Launch Wizard Deployment (%s): %s", d.Id(), err)
+	}
+
+	// Wait for the deployment's underlying stacks to be removed so that resources it depends on
+	// (for example the VPC and subnets passed in specifications) can be destroyed afterwards.
+	if _, err := waitDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Launch Wizard Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// Launch Wizard returns secret specification values (e.g. passwords) masked.
+const maskedSpecificationValue = "****"
+
+func customizeDiffValidateSpecifications(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("deployment_pattern_name", "specifications", "workload_name") {
+		return nil
+	}
+
+	if !d.NewValueKnown("deployment_pattern_name") || !d.NewValueKnown("specifications") || !d.NewValueKnown("workload_name") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	workloadName, patternName := d.Get("workload_name").(string), d.Get("deployment_pattern_name").(string)
+	pattern, err := findWorkloadDeploymentPatternByTwoPartKey(ctx, conn, workloadName, patternName)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("deployment pattern (%s) not found for Launch Wizard workload (%s)", patternName, workloadName)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Launch Wizard workload (%s) deployment pattern (%s): %w", workloadName, patternName, err)
+	}
+
+	return validateSpecifications(flex.ExpandStringValueMap(d.Get("specifications").(map[string]interface{})), pattern.Specifications)
+}
+
+// validateSpecifications checks the configured specifications against the fields a deployment pattern accepts.
+func validateSpecifications(specifications map[string]string, fields []awstypes.DeploymentSpecificationsField) error {
+	var diagErrs []error
+
+	known := make(map[string]awstypes.DeploymentSpecificationsField, len(fields))
+	for _, field := range fields {
+		known[aws.ToString(field.Name)] = field
+	}
+
+	for k := range specifications {
+		if _, ok := known[k]; !ok {
+			diagErrs = append(diagErrs, fmt.Errorf("specification %q is not supported by the deployment pattern", k))
+		}
+	}
+
+	for name, field := range known {
+		if !specificationFieldApplies(specifications, field.Conditionals) {
+			continue
+		}
+
+		v, ok := specifications[name]
+
+		if !ok {
+			if strings.EqualFold(aws.ToString(field.Required), "Yes") {
+				diagErrs = append(diagErrs, fmt.Errorf("specification %q is required", name))
+			}
+			continue
+		}
+
+		if len(field.AllowedValues) > 0 && !slices.Contains(field.AllowedValues, v) {
+			// Specifications are sensitive, so the value is not included in the error.
+			diagErrs = append(diagErrs, fmt.Errorf("specification %q must be one of: %s", name, strings.Join(field.AllowedValues, ", ")))
+		}
+	}
+
+	return errors.Join(diagErrs...)
+}
+
+// specificationFieldApplies returns whether all of a specification field's conditionals are satisfied.
+func specificationFieldApplies(specifications map[string]string, conditionals []awstypes.DeploymentConditionalField) bool {
+	for _, conditional := range conditionals {
+		v := specifications[aws.ToString(conditional.Name)]
+
+		switch comparator := aws.ToString(conditional.Comparator); comparator {
+		case "Equal":
+			if v != aws.ToString(conditional.Value) {
+				return false
+			}
+		case "NotEqual":
+			if v == aws.ToString(conditional.Value) {
+				return false
+			}
+		default:
+			// Don't validate fields whose conditions can't be evaluated.
+			return false
+		}
+	}
+
+	return true
+}
+
+func findDeploymentByID(ctx context.Context, conn *launchwizard.Client, id string) (*awstypes.DeploymentData, error) {
+	input := &launchwizard.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Deployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Deployment.Status; status == awstypes.DeploymentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Deployment, nil
+}
+
+func findWorkloadDeploymentPatternByTwoPartKey(ctx context.Context, conn *launchwizard.Client, workloadName, patternName string) (*awstypes.WorkloadDeploymentPatternData, error) {
+	input := &launchwizard.GetWorkloadDeploymentPatternInput{
+		DeploymentPatternName: aws.String(patternName),
+		WorkloadName:          aws.String(workloadName),
+	}
+
+	output, err := conn.GetWorkloadDeploymentPattern(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WorkloadDeploymentPattern == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WorkloadDeploymentPattern, nil
+}
+
+func findDeploymentEvents(ctx context.Context, conn *launchwizard.Client, id string) ([]awstypes.DeploymentEventDataSummary, error) {
+	input := &launchwizard.ListDeploymentEventsInput{
+		DeploymentId: aws.String(id),
+	}
+	var output []awstypes.DeploymentEventDataSummary
+
+	pages := launchwizard.NewListDeploymentEventsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DeploymentEvents...)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *launchwizard.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentCreated(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStatusCreating, awstypes.DeploymentStatusInProgress, awstypes.DeploymentStatusValidating),
+		Target:     enum.Slice(awstypes.DeploymentStatusCompleted),
+		Refresh:    statusDeployment(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		if output.Status == awstypes.DeploymentStatusFailed {
+			tfresource.SetLastError(err, deploymentFailureEventsError(ctx, conn, id))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentDeleted(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStatusCompleted, awstypes.DeploymentStatusDeleteInitiating, awstypes.DeploymentStatusDeleteInProgress, awstypes.DeploymentStatusFailed),
+		Target:     []string{},
+		Refresh:    statusDeployment(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		if output.Status == awstypes.DeploymentStatusDeleteFailed {
+			tfresource.SetLastError(err, deploymentFailureEventsError(ctx, conn, id))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentFailureEventsError returns the reasons for a deployment's failed events.
+func deploymentFailureEventsError(ctx context.Context, conn *launchwizard.Client, id string) error {
+	events, err := findDeploymentEvents(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("listing events: %w", err)
+	}
+
+	var diagErrs []error
+
+	for _, event := range events {
+		if event.Status == awstypes.EventStatusFailed {
+			diagErrs = append(diagErrs, fmt.Errorf("%s: %s", aws.ToString(event.Name), aws.ToString(event.StatusReason)))
+		}
+	}
+
+	return errors.Join(diagErrs...)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateSpecifications(t *testing.T) {
+	t.Parallel()
+
+	fields := []awstypes.DeploymentSpecificationsField{
+		{
+			Name:     aws.String("KeyPairName"),
+			Required: aws.String("Yes"),
+		},
+		{
+			Name:          aws.String("EnableEbsVolumeEncryption"),
+			Required:      aws.String("No"),
+			AllowedValues: []string{"Yes", "No"},
+		},
+		{
+			Name:     aws.String("KmsKeyId"),
+			Required: aws.String("Yes"),
+			Conditionals: []awstypes.DeploymentConditionalField{
+				{
+					Name:       aws.String("EnableEbsVolumeEncryption"),
+					Comparator: aws.String("Equal"),
+					Value:      aws.String("Yes"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		specifications map[string]string
+		expectedErr    *regexp.Regexp
+	}{
+		{
+			name: "valid",
+			specifications: map[string]string{
+				"KeyPairName": "example",
+			},
+		},
+		{
+			name: "missing required",
+			specifications: map[string]string{
+				"EnableEbsVolumeEncryption": "No",
+			},
+			expectedErr: regexache.MustCompile(`specification "KeyPairName" is required`),
+		},
+		{
+			name: "unsupported",
+			specifications: map[string]string{
+				"KeyPairName": "example",
+				"Unsupported": "value",
+			},
+			expectedErr: regexache.MustCompile(`specification "Unsupported" is not supported`),
+		},
+		{
+			name: "not allowed value",
+			specifications: map[string]string{
+				"KeyPairName":               "example",
+				"EnableEbsVolumeEncryption": "Maybe",
+			},
+			expectedErr: regexache.MustCompile(`^specification "EnableEbsVolumeEncryption" must be one of: Yes, No$`),
+		},
+		{
+			name: "conditional required",
+			specifications: map[string]string{
+				"KeyPairName":               "example",
+				"EnableEbsVolumeEncryption": "Yes",
+			},
+			expectedErr: regexache.MustCompile(`specification "KmsKeyId" is required`),
+		},
+		{
+			name: "conditional satisfied",
+			specifications: map[string]string{
+				"KeyPairName":               "example",
+				"EnableEbsVolumeEncryption": "Yes",
+				"KmsKeyId":                  "alias/example",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tflaunchwizard.ValidateSpecifications(testCase.specifications, fields)
+
+			if testCase.expectedErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedErr.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestAccLaunchWizardDeployment_invalidSpecifications(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfig_invalidSpecifications(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`specification "TfAccTestUnsupported" is not supported`),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Launch Wizard Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_invalidSpecifications(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = "SAP"
+  deployment_pattern_name = "SapHanaSingle"
+
+  specifications = {
+    TfAccTestUnsupported = "value"
+  }
+}
+`, rName)
+}
@@ -27,7 +27,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceDeployment,
+			TypeName: "aws_launchwizard_deployment",
+			Name:     "Deployment",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -49,6 +49,10 @@ func TestAccLicenseManagerGrant_serial(t *testing.T) {
 			acctest.CtBasic: testAccGrantsDataSource_basic,
 			"empty":         testAccGrantsDataSource_noMatch,
 		},
+		"received_grants_data_source": {
+			acctest.CtBasic: testAccReceivedGrantsDataSource_basic,
+			"empty":         testAccReceivedGrantsDataSource_noMatch,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
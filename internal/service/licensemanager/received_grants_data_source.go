@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	namevaluesfiltersv2 "github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters/v2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_licensemanager_received_grants", name="Received Grants")
+func dataSourceReceivedGrants() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReceivedGrantsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFilter: namevaluesfilters.Schema(),
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_operations": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"grant_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"home_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPrincipal: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReceivedGrantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LicenseManagerClient(ctx)
+
+	input := &licensemanager.ListReceivedGrantsInput{}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = namevaluesfiltersv2.New(v.(*schema.Set)).LicenseManagerFilters()
+	}
+
+	grants, err := findReceivedGrants(ctx, conn, input, tfslices.PredicateTrue[*awstypes.Grant]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading License Manager Received Grants: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, tfslices.ApplyToAll(grants, func(v awstypes.Grant) string {
+		return aws.ToString(v.GrantArn)
+	}))
+	if err := d.Set("grants", flattenReceivedGrants(grants)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting grants: %s", err)
+	}
+
+	return diags
+}
+
+func flattenReceivedGrants(apiObjects []awstypes.Grant) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"allowed_operations":   flex.FlattenStringyValueSet(apiObject.GrantedOperations),
+			"grant_arn":            aws.ToString(apiObject.GrantArn),
+			"home_region":          aws.ToString(apiObject.HomeRegion),
+			"license_arn":          aws.ToString(apiObject.LicenseArn),
+			names.AttrName:         aws.ToString(apiObject.GrantName),
+			"parent_arn":           aws.ToString(apiObject.ParentArn),
+			names.AttrPrincipal:    aws.ToString(apiObject.GranteePrincipalArn),
+			names.AttrStatus:       string(apiObject.GrantStatus),
+			names.AttrStatusReason: aws.ToString(apiObject.StatusReason),
+			names.AttrVersion:      aws.ToString(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
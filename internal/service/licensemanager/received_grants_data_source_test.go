@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccReceivedGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_licensemanager_received_grants.test"
+	resourceName := "aws_licensemanager_grant_accepter.test"
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+	principal := envvar.SkipIfEmpty(t, principalKey, envVarPrincipalKeyError)
+	homeRegion := envvar.SkipIfEmpty(t, homeRegionKey, envVarHomeRegionError)
+
+	providers := make(map[string]*schema.Provider)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             acctest.CheckWithNamedProviders(testAccCheckGrantAccepterDestroyWithProvider(ctx), providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceivedGrantsDataSourceConfig_basic(licenseARN, rName, principal, homeRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resourceName, "grant_arn"),
+					resource.TestCheckResourceAttr(datasourceName, "grants.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.grant_arn", resourceName, "grant_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.home_region", resourceName, "home_region"),
+					resource.TestCheckResourceAttr(datasourceName, "grants.0.license_arn", licenseARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.principal", resourceName, names.AttrPrincipal),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.status", resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(datasourceName, "grants.0.version", resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func testAccReceivedGrantsDataSource_noMatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_licensemanager_received_grants.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceivedGrantsDataSourceConfig_noMatch(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(datasourceName, "grants.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccReceivedGrantsDataSourceConfig_basic(licenseARN, rName, principal, homeRegion string) string {
+	return acctest.ConfigCompose(testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion), `
+data "aws_licensemanager_received_grants" "test" {
+  filter {
+    name   = "LicenseArn"
+    values = [aws_licensemanager_grant_accepter.test.license_arn]
+  }
+}
+`)
+}
+
+func testAccReceivedGrantsDataSourceConfig_noMatch() string {
+	return `
+data "aws_licensemanager_received_grants" "test" {
+  filter {
+    name = "LicenseIssuerName"
+    values = [
+      "No Match"
+    ]
+  }
+}
+`
+}
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_licensemanager_grants",
 			Name:     "Grants",
 		},
+		{
+			Factory:  dataSourceReceivedGrants,
+			TypeName: "aws_licensemanager_received_grants",
+			Name:     "Received Grants",
+		},
 		{
 			Factory:  dataSourceReceivedLicense,
 			TypeName: "aws_licensemanager_received_license",
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_automated_discovery_configuration", name="Automated Discovery Configuration")
+func resourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable_organization_members": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AutoEnableMode](),
+			},
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sensitivity_inspection_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AutomatedDiscoveryStatus](),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: awstypes.AutomatedDiscoveryStatus(d.Get(names.AttrStatus).(string)),
+	}
+
+	if v, ok := d.GetOk("auto_enable_organization_members"); ok {
+		input.AutoEnableOrganizationMembers = awstypes.AutoEnableMode(v.(string))
+	}
+
+	_, err := conn.UpdateAutomatedDiscoveryConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie Automated Discovery Configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return append(diags, resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	output, err := findAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Automated Discovery Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_enable_organization_members", output.AutoEnableOrganizationMembers)
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	d.Set("sensitivity_inspection_template_id", output.SensitivityInspectionTemplateId)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Macie Automated Discovery Configuration: %s", d.Id())
+	_, err := conn.UpdateAutomatedDiscoveryConfiguration(ctx, &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: awstypes.AutomatedDiscoveryStatusDisabled,
+	})
+
+	if isMacieNotEnabledError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAutomatedDiscoveryConfiguration(ctx context.Context, conn *macie2.Client) (*macie2.GetAutomatedDiscoveryConfigurationOutput, error) {
+	input := &macie2.GetAutomatedDiscoveryConfigurationInput{}
+
+	output, err := conn.GetAutomatedDiscoveryConfiguration(ctx, input)
+
+	if isMacieNotEnabledError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func isMacieNotEnabledError(err error) bool {
+	return errs.IsA[*awstypes.ResourceNotFoundException](err) ||
+		errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled")
+}
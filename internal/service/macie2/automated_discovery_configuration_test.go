@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomatedDiscoveryConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(string(awstypes.AutomatedDiscoveryStatusEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "classification_scope_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitivity_inspection_template_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AutomatedDiscoveryStatusEnabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(string(awstypes.AutomatedDiscoveryStatusDisabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AutomatedDiscoveryStatusDisabled)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomatedDiscoveryConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(string(awstypes.AutomatedDiscoveryStatusEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmacie2.ResourceAutomatedDiscoveryConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_automated_discovery_configuration" {
+				continue
+			}
+
+			output, err := tfmacie2.FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == awstypes.AutomatedDiscoveryStatusDisabled {
+				continue
+			}
+
+			return fmt.Errorf("Macie Automated Discovery Configuration %s still enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		_, err := tfmacie2.FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, status)
+}
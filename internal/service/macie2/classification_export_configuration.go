@@ -9,12 +9,18 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -31,6 +37,8 @@ func resourceClassificationExportConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceClassificationExportConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"s3_destination": {
 				Type:         schema.TypeList,
@@ -165,6 +173,66 @@ func resourceClassificationExportConfigurationDelete(ctx context.Context, d *sch
 	return diags
 }
 
+// resourceClassificationExportConfigurationCustomizeDiff verifies the S3 bucket and KMS key at plan time.
+// Macie requires an enabled symmetric encryption KMS key in the same Region as the bucket.
+// Validation is skipped if the bucket doesn't exist yet or can't be read.
+func resourceClassificationExportConfigurationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("s3_destination") {
+		return nil
+	}
+
+	if !d.NewValueKnown("s3_destination.0.bucket_name") || !d.NewValueKnown("s3_destination.0.kms_key_arn") {
+		return nil
+	}
+
+	bucketName, kmsKeyARN := d.Get("s3_destination.0.bucket_name").(string), d.Get("s3_destination.0.kms_key_arn").(string)
+	if bucketName == "" || kmsKeyARN == "" {
+		return nil
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+
+	bucketRegion, err := tfs3.FindBucketRegion(ctx, awsClient, bucketName)
+
+	// The bucket may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	// Insufficient permissions to verify the bucket are reported at apply time by Macie.
+	if err != nil {
+		log.Printf("[WARN] Unable to verify S3 Bucket (%s) for Macie classification export configuration: %s", bucketName, err)
+		return nil
+	}
+
+	if keyARN, err := arn.Parse(kmsKeyARN); err == nil && keyARN.Region != bucketRegion {
+		return fmt.Errorf("s3_destination.kms_key_arn: KMS Key (%s) must be in the same Region as S3 Bucket (%s): %s", kmsKeyARN, bucketName, bucketRegion)
+	}
+
+	key, err := tfkms.FindKeyByID(ctx, awsClient.KMSClient(ctx), kmsKeyARN, func(o *kms.Options) {
+		o.Region = bucketRegion
+	})
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("s3_destination.kms_key_arn: KMS Key (%s) not found", kmsKeyARN)
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to verify KMS Key (%s) for Macie classification export configuration: %s", kmsKeyARN, err)
+		return nil
+	}
+
+	if key.KeyState != kmstypes.KeyStateEnabled {
+		return fmt.Errorf("s3_destination.kms_key_arn: KMS Key (%s) must be enabled, is %s", kmsKeyARN, key.KeyState)
+	}
+
+	if key.KeySpec != kmstypes.KeySpecSymmetricDefault {
+		return fmt.Errorf("s3_destination.kms_key_arn: KMS Key (%s) must be a symmetric encryption key, is %s", kmsKeyARN, key.KeySpec)
+	}
+
+	return nil
+}
+
 func expandClassificationExportConfiguration(tfMap map[string]interface{}) *awstypes.S3Destination {
 	if tfMap == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -63,6 +64,26 @@ func testAccClassificationExportConfiguration_basic(t *testing.T) {
 	})
 }
 
+func testAccClassificationExportConfiguration_asymmetricKey(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationExportConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationExportConfigurationConfig_asymmetricKeyBase(),
+			},
+			{
+				Config:      testAccClassificationExportConfigurationConfig_asymmetricKey(),
+				ExpectError: regexache.MustCompile(`must be a symmetric encryption key`),
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationExportConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
@@ -230,3 +251,32 @@ resource "aws_macie2_classification_export_configuration" "test" {
 }
 `, prefix)
 }
+
+func testAccClassificationExportConfigurationConfig_asymmetricKeyBase() string {
+	return `
+resource "aws_kms_key" "test" {
+  customer_master_key_spec = "RSA_2048"
+  deletion_window_in_days  = 7
+  key_usage                = "ENCRYPT_DECRYPT"
+}
+
+resource "aws_s3_bucket" "test" {
+  force_destroy = true
+}
+
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccClassificationExportConfigurationConfig_asymmetricKey() string {
+	return acctest.ConfigCompose(testAccClassificationExportConfigurationConfig_asymmetricKeyBase(), `
+resource "aws_macie2_classification_export_configuration" "test" {
+  depends_on = [aws_macie2_account.test]
+
+  s3_destination {
+    bucket_name = aws_s3_bucket.test.bucket
+    kms_key_arn = aws_kms_key.test.arn
+  }
+}
+`)
+}
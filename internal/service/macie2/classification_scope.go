@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_classification_scope", name="Classification Scope")
+func resourceClassificationScope() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClassificationScopeCreate,
+		ReadWithoutTimeout:   resourceClassificationScopeRead,
+		UpdateWithoutTimeout: resourceClassificationScopeUpdate,
+		DeleteWithoutTimeout: resourceClassificationScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excludes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClassificationScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	// Each account has exactly one classification scope, created when Macie is enabled.
+	scope, err := findClassificationScope(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope: %s", err)
+	}
+
+	d.SetId(aws.ToString(scope.Id))
+
+	if err := updateClassificationScope(ctx, conn, d.Id(), expandClassificationScopeBucketNames(d.Get("s3").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClassificationScopeRead(ctx, d, meta)...)
+}
+
+func resourceClassificationScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	output, err := findClassificationScopeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Classification Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set("s3", flattenS3ClassificationScope(output.S3)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting s3: %s", err)
+	}
+
+	return diags
+}
+
+func resourceClassificationScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	if err := updateClassificationScope(ctx, conn, d.Id(), expandClassificationScopeBucketNames(d.Get("s3").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClassificationScopeRead(ctx, d, meta)...)
+}
+
+func resourceClassificationScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	// The classification scope can't be deleted, clear the exclusion list instead.
+	log.Printf("[DEBUG] Deleting Macie Classification Scope: %s", d.Id())
+	err := updateClassificationScope(ctx, conn, d.Id(), []string{})
+
+	if isMacieNotEnabledError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateClassificationScope(ctx context.Context, conn *macie2.Client, id string, bucketNames []string) error {
+	input := &macie2.UpdateClassificationScopeInput{
+		Id: aws.String(id),
+		S3: &awstypes.S3ClassificationScopeUpdate{
+			Excludes: &awstypes.S3ClassificationScopeExclusionUpdate{
+				BucketNames: bucketNames,
+				Operation:   awstypes.ClassificationScopeUpdateOperationReplace,
+			},
+		},
+	}
+
+	_, err := conn.UpdateClassificationScope(ctx, input)
+
+	return err
+}
+
+func findClassificationScope(ctx context.Context, conn *macie2.Client) (*awstypes.ClassificationScopeSummary, error) {
+	input := &macie2.ListClassificationScopesInput{}
+	var output []awstypes.ClassificationScopeSummary
+
+	pages := macie2.NewListClassificationScopesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isMacieNotEnabledError(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ClassificationScopes...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findClassificationScopeByID(ctx context.Context, conn *macie2.Client, id string) (*macie2.GetClassificationScopeOutput, error) {
+	input := &macie2.GetClassificationScopeInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetClassificationScope(ctx, input)
+
+	if isMacieNotEnabledError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandClassificationScopeBucketNames(tfList []interface{}) []string {
+	bucketNames := []string{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return bucketNames
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["bucket_names"].(*schema.Set); ok && v.Len() > 0 {
+			bucketNames = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return bucketNames
+}
+
+func flattenS3ClassificationScope(apiObject *awstypes.S3ClassificationScope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Excludes; v != nil {
+		tfMap["excludes"] = []interface{}{
+			map[string]interface{}{
+				"bucket_names": v.BucketNames,
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
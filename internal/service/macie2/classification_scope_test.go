@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccClassificationScope_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_classification_scope.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationScopeDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationScopeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "automated-sensitive-data-discovery"),
+					resource.TestCheckResourceAttr(resourceName, "s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.excludes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.excludes.0.bucket_names.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "s3.0.excludes.0.bucket_names.*", rName+"-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "s3.0.excludes.0.bucket_names.*", rName+"-2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationScopeConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.excludes.0.bucket_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "s3.0.excludes.0.bucket_names.*", rName+"-3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClassificationScopeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_classification_scope" {
+				continue
+			}
+
+			output, err := tfmacie2.FindClassificationScopeByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.S3 == nil || output.S3.Excludes == nil || len(output.S3.Excludes.BucketNames) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Macie Classification Scope %s still has excluded buckets", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClassificationScopeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
+
+		_, err := tfmacie2.FindClassificationScopeByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccClassificationScopeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_classification_scope" "test" {
+  s3 {
+    excludes {
+      bucket_names = ["%[1]s-1", "%[1]s-2"]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
+
+func testAccClassificationScopeConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_classification_scope" "test" {
+  s3 {
+    excludes {
+      bucket_names = ["%[1]s-3"]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
@@ -6,13 +6,17 @@ package macie2
 // Exports for use in tests only.
 var (
 	ResourceAccount                           = resourceAccount
+	ResourceAutomatedDiscoveryConfiguration   = resourceAutomatedDiscoveryConfiguration
 	ResourceClassificationExportConfiguration = resourceClassificationExportConfiguration
 	ResourceClassificationJob                 = resourceClassificationJob
+	ResourceClassificationScope               = resourceClassificationScope
 	ResourceCustomDataIdentifier              = resourceCustomDataIdentifier
 	ResourceFindingsFilter                    = resourceFindingsFilter
 	ResourceInvitationAccepter                = resourceInvitationAccepter
 	ResourceMember                            = resourceMember
 	ResourceOrganizationAdminAccount          = resourceOrganizationAdminAccount
 
-	FindMemberByID = findMemberByID
+	FindAutomatedDiscoveryConfiguration = findAutomatedDiscoveryConfiguration
+	FindClassificationScopeByID         = findClassificationScopeByID
+	FindMemberByID                      = findMemberByID
 )
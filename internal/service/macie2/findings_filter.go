@@ -7,13 +7,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -43,6 +46,8 @@ func resourceFindingsFilter() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceFindingsFilterCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"finding_criteria": {
 				Type:     schema.TypeList,
@@ -57,8 +62,9 @@ func resourceFindingsFilter() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrField: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validFindingsFilterField,
 									},
 									"eq_exact_match": {
 										Type:     schema.TypeSet,
@@ -288,6 +294,78 @@ func resourceFindingsFilterDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// Fields that can be used to filter findings.
+// See https://docs.aws.amazon.com/macie/latest/user/findings-filter-fields.html.
+var (
+	findingsFilterFields = []string{
+		"accountId",
+		"archived",
+		"category",
+		"count",
+		"createdAt",
+		"id",
+		"region",
+		"sample",
+		"severity.description",
+		"severity.score",
+		"type",
+		"updatedAt",
+	}
+	findingsFilterFieldPrefixes = []string{
+		"classificationDetails.",
+		"policyDetails.",
+		"resourcesAffected.s3Bucket.",
+		"resourcesAffected.s3Object.",
+	}
+)
+
+func validFindingsFilterField(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if slices.Contains(findingsFilterFields, value) {
+		return
+	}
+
+	for _, prefix := range findingsFilterFieldPrefixes {
+		if strings.HasPrefix(value, prefix) && len(value) > len(prefix) {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not a supported findings filter field, expected one of %s or a field starting with one of %s", k, value, strings.Join(findingsFilterFields, ", "), strings.Join(findingsFilterFieldPrefixes, ", ")))
+	return
+}
+
+func resourceFindingsFilterCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v := d.GetRawConfig().GetAttr("finding_criteria")
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	v = v.Index(cty.NumberIntVal(0)).GetAttr("criterion")
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	// Dates are only converted for the updatedAt field, any other field must use an integer value.
+	for _, v := range v.AsValueSlice() {
+		field := v.GetAttr(names.AttrField)
+		if !field.IsKnown() || field.IsNull() || field.AsString() == "updatedAt" {
+			continue
+		}
+
+		for _, condition := range []string{"lt", "lte", "gt", "gte"} {
+			if v := v.GetAttr(condition); v.IsKnown() && !v.IsNull() {
+				if _, err := strconv.ParseInt(v.AsString(), 10, 64); err != nil {
+					return fmt.Errorf("finding_criteria.criterion: condition %q for field %q must be an integer, dates are only supported for the updatedAt field", condition, field.AsString())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandFindingCriteriaFilter(findingCriterias []interface{}) (*awstypes.FindingCriteria, error) {
 	if len(findingCriterias) == 0 {
 		return nil, nil
@@ -407,6 +407,29 @@ func testAccFindingsFilter_withTags(t *testing.T) {
 	})
 }
 
+func testAccFindingsFilter_invalidCriteria(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFindingsFilterDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFindingsFilterConfig_criterionGTE("severityScore", "1"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`is not a supported findings filter field`),
+			},
+			{
+				Config:      testAccFindingsFilterConfig_criterionGTE("createdAt", "2020-01-01T00:00:00Z"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`dates are only supported for the updatedAt field`),
+			},
+		},
+	})
+}
+
 func testAccCheckFindingsFilterExists(ctx context.Context, resourceName string, macie2Session *macie2.GetFindingsFilterOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -600,3 +623,18 @@ resource "aws_macie2_findings_filter" "test" {
 }
 `, description, action, position)
 }
+
+func testAccFindingsFilterConfig_criterionGTE(field, gte string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_findings_filter" "test" {
+  action = "ARCHIVE"
+
+  finding_criteria {
+    criterion {
+      field = %[1]q
+      gte   = %[2]q
+    }
+  }
+}
+`, field, gte)
+}
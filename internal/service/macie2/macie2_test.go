@@ -20,8 +20,13 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			acctest.CtDisappears:           testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			acctest.CtBasic:      testAccAutomatedDiscoveryConfiguration_basic,
+			acctest.CtDisappears: testAccAutomatedDiscoveryConfiguration_disappears,
+		},
 		"ClassificationExportConfiguration": {
-			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
+			acctest.CtBasic:  testAccClassificationExportConfiguration_basic,
+			"asymmetric_key": testAccClassificationExportConfiguration_asymmetricKey,
 		},
 		"ClassificationJob": {
 			acctest.CtBasic:      testAccClassificationJob_basic,
@@ -33,6 +38,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"tags":               testAccClassificationJob_WithTags,
 			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
 		},
+		"ClassificationScope": {
+			acctest.CtBasic: testAccClassificationScope_basic,
+		},
 		"CustomDataIdentifier": {
 			acctest.CtBasic:      testAccCustomDataIdentifier_basic,
 			"name_generated":     testAccCustomDataIdentifier_Name_Generated,
@@ -49,6 +57,7 @@ func TestAccMacie2_serial(t *testing.T) {
 			"complete":           testAccFindingsFilter_complete,
 			"date":               testAccFindingsFilter_WithDate,
 			"number":             testAccFindingsFilter_WithNumber,
+			"invalid_criteria":   testAccFindingsFilter_invalidCriteria,
 			"tags":               testAccFindingsFilter_withTags,
 		},
 		"OrganizationAdminAccount": {
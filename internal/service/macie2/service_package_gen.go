@@ -33,6 +33,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_macie2_account",
 			Name:     "Account",
 		},
+		{
+			Factory:  resourceAutomatedDiscoveryConfiguration,
+			TypeName: "aws_macie2_automated_discovery_configuration",
+			Name:     "Automated Discovery Configuration",
+		},
 		{
 			Factory:  resourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
@@ -44,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Classification Job",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceClassificationScope,
+			TypeName: "aws_macie2_classification_scope",
+			Name:     "Classification Scope",
+		},
 		{
 			Factory:  resourceCustomDataIdentifier,
 			TypeName: "aws_macie2_custom_data_identifier",
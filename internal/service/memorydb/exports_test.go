@@ -5,10 +5,11 @@ package memorydb
 
 // Exports for use in tests only.
 var (
-	ResourceACL            = resourceACL
-	ResourceCluster        = resourceCluster
-	ResourceParameterGroup = resourceParameterGroup
-	ResourceSnapshot       = resourceSnapshot
-	ResourceSubnetGroup    = resourceSubnetGroup
-	ResourceUser           = resourceUser
+	ResourceACL                = resourceACL
+	ResourceCluster            = resourceCluster
+	ResourceMultiRegionCluster = resourceMultiRegionCluster
+	ResourceParameterGroup     = resourceParameterGroup
+	ResourceSnapshot           = resourceSnapshot
+	ResourceSubnetGroup        = resourceSubnetGroup
+	ResourceUser               = resourceUser
 )
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_memorydb_multi_region_cluster", name="Multi Region Cluster")
+// @Tags(identifierAttribute="arn")
+func resourceMultiRegionCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiRegionClusterCreate,
+		ReadWithoutTimeout:   resourceMultiRegionClusterRead,
+		UpdateWithoutTimeout: resourceMultiRegionClusterUpdate,
+		DeleteWithoutTimeout: resourceMultiRegionClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(multiRegionClusterAvailableTimeout),
+			Update: schema.DefaultTimeout(multiRegionClusterAvailableTimeout),
+			Delete: schema.DefaultTimeout(multiRegionClusterDeletedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrEngineVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"multi_region_cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_region_cluster_name_suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"multi_region_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"update_strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.UpdateStrategy](),
+			},
+		},
+	}
+}
+
+func resourceMultiRegionClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
+
+	suffix := d.Get("multi_region_cluster_name_suffix").(string)
+	input := &memorydb.CreateMultiRegionClusterInput{
+		MultiRegionClusterNameSuffix: aws.String(suffix),
+		NodeType:                     aws.String(d.Get("node_type").(string)),
+		Tags:                         getTagsIn(ctx),
+		TLSEnabled:                   aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrEngine); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrEngineVersion); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_region_parameter_group_name"); ok {
+		input.MultiRegionParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("num_shards"); ok {
+		input.NumShards = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.CreateMultiRegionCluster(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MemoryDB Multi Region Cluster (%s): %s", suffix, err)
+	}
+
+	d.SetId(aws.ToString(output.MultiRegionCluster.MultiRegionClusterName))
+
+	if err := waitMultiRegionClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi Region Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMultiRegionClusterRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
+
+	cluster, err := FindMultiRegionClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Multi Region Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MemoryDB Multi Region Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, cluster.ARN)
+	d.Set(names.AttrDescription, cluster.Description)
+	d.Set(names.AttrEngine, cluster.Engine)
+	d.Set(names.AttrEngineVersion, cluster.EngineVersion)
+	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
+	d.Set("multi_region_parameter_group_name", cluster.MultiRegionParameterGroupName)
+	d.Set("node_type", cluster.NodeType)
+	d.Set("num_shards", cluster.NumberOfShards)
+	d.Set(names.AttrStatus, cluster.Status)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	return diags
+}
+
+func resourceMultiRegionClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "update_strategy") {
+		input := &memorydb.UpdateMultiRegionClusterInput{
+			MultiRegionClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrEngineVersion) {
+			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+		}
+
+		if d.HasChange("multi_region_parameter_group_name") {
+			input.MultiRegionParameterGroupName = aws.String(d.Get("multi_region_parameter_group_name").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &awstypes.ShardConfigurationRequest{
+				ShardCount: int32(d.Get("num_shards").(int)),
+			}
+		}
+
+		if v, ok := d.GetOk("update_strategy"); ok {
+			input.UpdateStrategy = awstypes.UpdateStrategy(v.(string))
+		}
+
+		_, err := conn.UpdateMultiRegionCluster(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MemoryDB Multi Region Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitMultiRegionClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi Region Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMultiRegionClusterRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
+
+	log.Printf("[DEBUG] Deleting MemoryDB Multi Region Cluster: (%s)", d.Id())
+	// Regional clusters are removed from the multi-Region cluster asynchronously after they are deleted.
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidMultiRegionClusterStateFault](ctx, multiRegionClusterRegionalClustersDeletedTimeout, func() (interface{}, error) {
+		return conn.DeleteMultiRegionCluster(ctx, &memorydb.DeleteMultiRegionClusterInput{
+			MultiRegionClusterName: aws.String(d.Id()),
+		})
+	})
+
+	if errs.IsA[*awstypes.MultiRegionClusterNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MemoryDB Multi Region Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := waitMultiRegionClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi Region Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
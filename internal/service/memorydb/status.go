@@ -46,6 +46,23 @@ func statusCluster(ctx context.Context, conn *memorydb.Client, clusterName strin
 	}
 }
 
+// statusMultiRegionCluster fetches the MemoryDB Multi Region Cluster and its status.
+func statusMultiRegionCluster(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindMultiRegionClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.ToString(cluster.Status), nil
+	}
+}
+
 // statusClusterParameterGroup fetches the MemoryDB Cluster and its parameter group status.
 func statusClusterParameterGroup(ctx context.Context, conn *memorydb.Client, clusterName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
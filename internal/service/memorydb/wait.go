@@ -22,6 +22,10 @@ const (
 
 	clusterSecurityGroupsActiveTimeout = 10 * time.Minute
 
+	multiRegionClusterAvailableTimeout               = 120 * time.Minute
+	multiRegionClusterDeletedTimeout                 = 120 * time.Minute
+	multiRegionClusterRegionalClustersDeletedTimeout = 10 * time.Minute
+
 	userActiveTimeout  = 5 * time.Minute
 	userDeletedTimeout = 5 * time.Minute
 
@@ -85,6 +89,34 @@ func waitClusterDeleted(ctx context.Context, conn *memorydb.Client, clusterId st
 	return err
 }
 
+// waitMultiRegionClusterAvailable waits for MemoryDB Multi Region Cluster to reach an active state after modifications.
+func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: statusMultiRegionCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterDeleted waits for MemoryDB Multi Region Cluster to be deleted.
+func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMultiRegionCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // waitClusterParameterGroupInSync waits for MemoryDB Cluster to come in sync
 // with a new parameter group.
 func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.Client, clusterId string) error {
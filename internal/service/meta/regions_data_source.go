@@ -5,14 +5,19 @@ package meta
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -49,6 +54,14 @@ func (d *dataSourceRegions) Schema(ctx context.Context, req datasource.SchemaReq
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"regions": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[regionModel](ctx),
+				Computed:   true,
+			},
+			"services": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrFilter: tfec2.CustomFiltersBlock(),
@@ -82,20 +95,90 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	// Service availability is looked up in the global infrastructure public parameters.
+	services := flex.ExpandFrameworkStringValueSet(ctx, data.Services)
+	serviceRegions := make(map[string][]string, len(services))
+	if len(services) > 0 {
+		ssmConn := d.Meta().SSMClient(ctx)
+
+		for _, service := range services {
+			regions, err := findServiceRegionNames(ctx, ssmConn, service)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading Regions for service (%s)", service), err.Error())
+
+				return
+			}
+
+			if len(regions) == 0 {
+				response.Diagnostics.AddWarning(
+					"Unknown service",
+					fmt.Sprintf("Service %q was not found in the AWS global infrastructure public parameters and is reported as unavailable in all Regions.", service),
+				)
+			}
+
+			serviceRegions[service] = regions
+		}
+	}
+
 	var names []string
+	var regions []*regionModel
 	for _, v := range output.Regions {
-		names = append(names, aws.ToString(v.RegionName))
+		name := aws.ToString(v.RegionName)
+		names = append(names, name)
+
+		availability := make(map[string]attr.Value, len(services))
+		for _, service := range services {
+			availability[service] = types.BoolValue(slices.Contains(serviceRegions[service], name))
+		}
+
+		regions = append(regions, &regionModel{
+			Name:                types.StringValue(name),
+			OptInStatus:         types.StringValue(aws.ToString(v.OptInStatus)),
+			ServiceAvailability: fwtypes.NewMapValueOfMust[types.Bool](ctx, availability),
+		})
 	}
 
 	data.ID = types.StringValue(d.Meta().Partition)
 	data.Names = flex.FlattenFrameworkStringValueSetLegacy(ctx, names)
+	data.Regions = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, regions)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceRegionsData struct {
-	AllRegions types.Bool   `tfsdk:"all_regions"`
-	Filters    types.Set    `tfsdk:"filter"`
-	ID         types.String `tfsdk:"id"`
-	Names      types.Set    `tfsdk:"names"`
+	AllRegions types.Bool                                   `tfsdk:"all_regions"`
+	Filters    types.Set                                    `tfsdk:"filter"`
+	ID         types.String                                 `tfsdk:"id"`
+	Names      types.Set                                    `tfsdk:"names"`
+	Regions    fwtypes.ListNestedObjectValueOf[regionModel] `tfsdk:"regions"`
+	Services   types.Set                                    `tfsdk:"services"`
+}
+
+type regionModel struct {
+	Name                types.String                   `tfsdk:"name"`
+	OptInStatus         types.String                   `tfsdk:"opt_in_status"`
+	ServiceAvailability fwtypes.MapValueOf[types.Bool] `tfsdk:"service_availability"`
+}
+
+func findServiceRegionNames(ctx context.Context, conn *ssm.Client, service string) ([]string, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path: aws.String(fmt.Sprintf("/aws/service/global-infrastructure/services/%s/regions", service)),
+	}
+	var output []string
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			output = append(output, aws.ToString(v.Value))
+		}
+	}
+
+	return output, nil
 }
@@ -88,6 +88,30 @@ func TestAccMetaRegionsDataSource_nonExistentRegion(t *testing.T) {
 	})
 }
 
+func TestAccMetaRegionsDataSource_services(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_regions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_services(acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "regions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.name", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.opt_in_status", "opt-in-not-required"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.service_availability.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.service_availability.ec2", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.service_availability.s3", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccRegionsDataSourceConfig_empty() string {
 	return `
 data "aws_regions" "test" {}
@@ -123,3 +147,16 @@ data "aws_regions" "test" {
 }
 `
 }
+
+func testAccRegionsDataSourceConfig_services(region string) string {
+	return fmt.Sprintf(`
+data "aws_regions" "test" {
+  services = ["ec2", "s3"]
+
+  filter {
+    name   = "region-name"
+    values = [%[1]q]
+  }
+}
+`, region)
+}
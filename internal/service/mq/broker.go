@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +39,18 @@ import (
 	"github.com/mitchellh/copystructure"
 )
 
+const (
+	dataReplicationRolePrimary = "PRIMARY"
+	dataReplicationRoleReplica = "REPLICA"
+)
+
+func dataReplicationRole_Values() []string {
+	return []string{
+		dataReplicationRolePrimary,
+		dataReplicationRoleReplica,
+	}
+}
+
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
 func resourceBroker() *schema.Resource {
@@ -126,6 +139,18 @@ func resourceBroker() *schema.Resource {
 				ForceNew:     true, // Can only be set on Create
 				ValidateFunc: verify.ValidARN,
 			},
+			"data_replication_promote_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.PromoteModeSwitchover,
+				ValidateDiagFunc: enum.Validate[types.PromoteMode](),
+			},
+			"data_replication_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dataReplicationRole_Values(), false),
+			},
 			"deployment_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -384,6 +409,28 @@ func resourceBroker() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if v := diff.GetRawConfig().GetAttr("data_replication_role"); !v.IsKnown() || v.IsNull() {
+					return nil
+				}
+
+				// A configured data_replication_mode that is pending reboot is suppressed, so use the configured value.
+				// The mode of a primary broker is typically not configured, as it is set when its replica is created.
+				var modes []string
+				if v := diff.GetRawConfig().GetAttr("data_replication_mode"); !v.IsKnown() {
+					return nil
+				} else if !v.IsNull() {
+					modes = append(modes, v.AsString())
+				} else {
+					modes = append(modes, diff.Get("data_replication_mode").(string), diff.Get("pending_data_replication_mode").(string))
+				}
+
+				if !slices.Contains(modes, string(types.DataReplicationModeCrdr)) {
+					return fmt.Errorf("data_replication_role: can only be configured when data_replication_mode is %s", types.DataReplicationModeCrdr)
+				}
+
 				return nil
 			},
 		),
@@ -446,19 +493,28 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	output, err := conn.CreateBroker(ctx, input)
+	outputCB, err := conn.CreateBroker(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s): %s", name, err)
 	}
 
-	d.SetId(aws.ToString(output.BrokerId))
-	d.Set(names.AttrARN, output.BrokerArn)
+	d.SetId(aws.ToString(outputCB.BrokerId))
+	d.Set(names.AttrARN, outputCB.BrokerArn)
 
-	if _, err := waitBrokerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	output, err := waitBrokerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) create: %s", d.Id(), err)
 	}
 
+	// The role of a new broker is determined by data_replication_primary_broker_arn.
+	if v, ok := d.GetOk("data_replication_role"); ok {
+		if role := brokerDataReplicationRole(output); role != v.(string) {
+			return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s): data_replication_role (%s) does not match the role of the created broker (%s)", d.Id(), v.(string), role)
+		}
+	}
+
 	return append(diags, resourceBrokerRead(ctx, d, meta)...)
 }
 
@@ -484,6 +540,7 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrAutoMinorVersionUpgrade, output.AutoMinorVersionUpgrade)
 	d.Set("broker_name", output.BrokerName)
 	d.Set("data_replication_mode", output.DataReplicationMode)
+	d.Set("data_replication_role", brokerDataReplicationRole(output))
 	d.Set("deployment_mode", output.DeploymentMode)
 	d.Set("engine_type", output.EngineType)
 	d.Set(names.AttrEngineVersion, output.EngineVersion)
@@ -659,6 +716,49 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	// A change of data replication mode takes effect on reboot, so the roles are switched afterwards.
+	if d.HasChange("data_replication_role") {
+		role := d.Get("data_replication_role").(string)
+		output, err := findBrokerByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s): %s", d.Id(), err)
+		}
+
+		// Both brokers in a pair may be updated in the same apply, in which case the first update
+		// has already switched the roles of both.
+		if metadata := output.DataReplicationMetadata; metadata == nil || aws.ToString(metadata.DataReplicationRole) != role {
+			input := &mq.PromoteInput{
+				BrokerId: aws.String(d.Id()),
+				Mode:     types.PromoteMode(d.Get("data_replication_promote_mode").(string)),
+			}
+			var optFns []func(*mq.Options)
+
+			// A primary broker is demoted by promoting its counterpart, which may be in another Region.
+			if role == dataReplicationRoleReplica {
+				if metadata == nil || metadata.DataReplicationCounterpart == nil {
+					return sdkdiag.AppendErrorf(diags, "demoting MQ Broker (%s): no data replication counterpart", d.Id())
+				}
+
+				counterpart := metadata.DataReplicationCounterpart
+				input.BrokerId = counterpart.BrokerId
+				optFns = append(optFns, func(o *mq.Options) {
+					o.Region = aws.ToString(counterpart.Region)
+				})
+			}
+
+			_, err := conn.Promote(ctx, input, optFns...)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "promoting MQ Broker (%s): %s", aws.ToString(input.BrokerId), err)
+			}
+
+			if _, err := waitBrokerDataReplicationRoleChanged(ctx, conn, d.Id(), role, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) data replication role change: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -731,7 +831,7 @@ func statusBrokerState(ctx context.Context, conn *mq.Client, id string) retry.St
 func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateCreationInProgress, types.BrokerStateRebootInProgress),
-		Target:  enum.Slice(types.BrokerStateRunning),
+		Target:  enum.Slice(types.BrokerStateRunning, types.BrokerStateReplica),
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
@@ -765,10 +865,71 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+func statusBrokerDataReplicationRole(ctx context.Context, conn *mq.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBrokerByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The role switch is complete once the broker is no longer in transition.
+		if state := output.BrokerState; state != types.BrokerStateRunning && state != types.BrokerStateReplica {
+			return output, string(state), nil
+		}
+
+		if output.DataReplicationMetadata == nil {
+			return output, "", nil
+		}
+
+		return output, aws.ToString(output.DataReplicationMetadata.DataReplicationRole), nil
+	}
+}
+
+func brokerDataReplicationRole(output *mq.DescribeBrokerOutput) string {
+	if v := output.DataReplicationMetadata; v != nil {
+		return aws.ToString(v.DataReplicationRole)
+	}
+
+	if v := output.PendingDataReplicationMetadata; v != nil {
+		return aws.ToString(v.DataReplicationRole)
+	}
+
+	return ""
+}
+
+func waitBrokerDataReplicationRoleChanged(ctx context.Context, conn *mq.Client, id, role string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	pending := []string{"", string(types.BrokerStateRebootInProgress)}
+	for _, v := range dataReplicationRole_Values() {
+		if v != role {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{role},
+		Timeout: timeout,
+		Refresh: statusBrokerDataReplicationRole(ctx, conn, id),
+		Delay:   30 * time.Second,
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateRebootInProgress),
-		Target:  enum.Slice(types.BrokerStateRunning),
+		Target:  enum.Slice(types.BrokerStateRunning, types.BrokerStateReplica),
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
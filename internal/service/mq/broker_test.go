@@ -1406,6 +1406,7 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data_replication_mode", ""),
 					resource.TestCheckResourceAttr(resourceName, "pending_data_replication_mode", string(types.DataReplicationModeCrdr)),
 					resource.TestCheckResourceAttrPair(resourceName, "data_replication_primary_broker_arn", primaryBrokerResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", "REPLICA"),
 				),
 			},
 			{
@@ -1413,7 +1414,7 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user", "data_replication_primary_broker_arn"},
+				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user", "data_replication_primary_broker_arn", "data_replication_promote_mode"},
 			},
 			{
 				// Preparation for destruction would require multiple configuration changes
@@ -1437,6 +1438,110 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_dataReplicationRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	var brokerAlternate mq.DescribeBrokerOutput
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+	primaryBrokerResourceName := "aws_mq_broker.primary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_dataReplicationMode(rName, testAccBrokerVersionNewer, string(types.DataReplicationModeCrdr)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					testAccCheckBrokerExistsWithProvider(ctx, primaryBrokerResourceName, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", "REPLICA"),
+				),
+			},
+			{
+				// Replication only becomes active once both brokers have been rebooted.
+				PreConfig: func() {
+					testAccRebootBrokerWithProvider(ctx, t, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers))
+					testAccRebootBrokerWithProvider(ctx, t, &broker, func() *schema.Provider { return acctest.Provider })
+				},
+				// The primary is updated first and is demoted by promoting its counterpart, so the replica
+				// is already primary when it is updated.
+				Config: testAccBrokerConfig_dataReplicationRole(rName, testAccBrokerVersionNewer, "REPLICA", "PRIMARY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					testAccCheckBrokerExistsWithProvider(ctx, primaryBrokerResourceName, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					testAccCheckBrokerDataReplicationRole(&broker, "PRIMARY"),
+					testAccCheckBrokerDataReplicationRole(&brokerAlternate, "REPLICA"),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", "PRIMARY"),
+					resource.TestCheckResourceAttr(primaryBrokerResourceName, "data_replication_role", "REPLICA"),
+				),
+			},
+			{
+				// Switch back. This time the broker updated first is promoted and the other is already a replica.
+				Config: testAccBrokerConfig_dataReplicationRole(rName, testAccBrokerVersionNewer, "PRIMARY", "REPLICA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					testAccCheckBrokerExistsWithProvider(ctx, primaryBrokerResourceName, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					testAccCheckBrokerDataReplicationRole(&broker, "REPLICA"),
+					testAccCheckBrokerDataReplicationRole(&brokerAlternate, "PRIMARY"),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_role", "REPLICA"),
+					resource.TestCheckResourceAttr(primaryBrokerResourceName, "data_replication_role", "PRIMARY"),
+				),
+			},
+			{
+				Config:   testAccBrokerConfig_dataReplicationRole(rName, testAccBrokerVersionNewer, "PRIMARY", "REPLICA"),
+				PlanOnly: true,
+			},
+			{
+				// As in TestAccMQBroker_dataReplicationMode, unpair and delete the primary out of band
+				// so that the remaining resources can be destroyed.
+				PreConfig: func() {
+					testAccUnpairBrokerWithProvider(ctx, t, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers))
+					testAccDeleteBrokerWithProvider(ctx, t, &brokerAlternate, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers))
+				},
+				Config:             testAccBrokerConfig_dataReplicationMode(rName, testAccBrokerVersionNewer, string(types.DataReplicationModeNone)),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_dataReplicationRoleRequiresCRDR(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrokerConfig_dataReplicationRoleWithoutMode(rName, testAccBrokerVersionNewer),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`data_replication_role: can only be configured when data_replication_mode is CRDR`),
+			},
+		},
+	})
+}
+
 func testAccCheckBrokerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).MQClient(ctx)
@@ -1561,6 +1666,36 @@ func testAccDeleteBrokerWithProvider(ctx context.Context, t *testing.T, broker *
 	}
 }
 
+func testAccRebootBrokerWithProvider(ctx context.Context, t *testing.T, broker *mq.DescribeBrokerOutput, providerF func() *schema.Provider) {
+	brokerID := aws.ToString(broker.BrokerId)
+	deadline := tfresource.NewDeadline(30 * time.Minute)
+	conn := providerF().Meta().(*conns.AWSClient).MQClient(ctx)
+
+	_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{BrokerId: aws.String(brokerID)})
+	if err != nil {
+		t.Fatalf("rebooting broker (%s): %s", brokerID, err)
+	}
+
+	_, err = tfmq.WaitBrokerRebooted(ctx, conn, brokerID, deadline.Remaining())
+	if err != nil {
+		t.Fatalf("waiting for broker (%s) reboot: %s", brokerID, err)
+	}
+}
+
+func testAccCheckBrokerDataReplicationRole(broker *mq.DescribeBrokerOutput, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if broker.DataReplicationMetadata == nil {
+			return fmt.Errorf("MQ Broker (%s) has no data replication metadata", aws.ToString(broker.BrokerId))
+		}
+
+		if got := aws.ToString(broker.DataReplicationMetadata.DataReplicationRole); got != role {
+			return fmt.Errorf("MQ Broker (%s) data replication role is %s, expected %s", aws.ToString(broker.BrokerId), got, role)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBrokerNotRecreated(before, after *mq.DescribeBrokerOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.ToString(before.BrokerId), aws.ToString(after.BrokerId); before != after {
@@ -1571,6 +1706,85 @@ func testAccCheckBrokerNotRecreated(before, after *mq.DescribeBrokerOutput) reso
 	}
 }
 
+func testAccBrokerConfig_dataReplicationRole(rName, version, primaryRole, replicaRole string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+resource "aws_security_group" "primary" {
+  provider = awsalternate
+
+  name = "%[1]s-primary"
+
+  tags = {
+    Name = "%[1]s-primary"
+  }
+}
+
+resource "aws_mq_broker" "primary" {
+  provider = awsalternate
+
+  apply_immediately     = true
+  broker_name           = "%[1]s-primary"
+  engine_type           = "ActiveMQ"
+  engine_version        = %[2]q
+  host_instance_type    = "mq.m5.large"
+  security_groups       = [aws_security_group.primary.id]
+  deployment_mode       = "ACTIVE_STANDBY_MULTI_AZ"
+  data_replication_role = %[3]q
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+  user {
+    username         = "Test-ReplicationUser"
+    password         = "TestTest1234"
+    replication_user = true
+  }
+}
+
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.m5.large"
+  security_groups    = [aws_security_group.test.id]
+  deployment_mode    = "ACTIVE_STANDBY_MULTI_AZ"
+
+  data_replication_mode               = "CRDR"
+  data_replication_primary_broker_arn = aws_mq_broker.primary.arn
+  data_replication_role               = %[4]q
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+  user {
+    username         = "Test-ReplicationUser"
+    password         = "TestTest1234"
+    replication_user = true
+  }
+}
+`, rName, version, primaryRole, replicaRole))
+}
+
 func testAccBrokerConfig_basic(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -1602,6 +1816,38 @@ resource "aws_mq_broker" "test" {
 `, rName, version)
 }
 
+func testAccBrokerConfig_dataReplicationRoleWithoutMode(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  security_groups         = [aws_security_group.test.id]
+  authentication_strategy = "simple"
+  storage_type            = "efs"
+  data_replication_role   = "PRIMARY"
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
 func testAccBrokerConfig_ebs(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -11,6 +11,7 @@ var (
 	ResourceResourcePolicy             = resourceResourcePolicy
 	ResourceRuleGroup                  = resourceRuleGroup
 	ResourceTLSInspectionConfiguration = newTLSInspectionConfigurationResource
+	ResourceVPCEndpointAssociation     = newVPCEndpointAssociationResource
 
 	FindFirewallByARN                   = findFirewallByARN
 	FindFirewallPolicyByARN             = findFirewallPolicyByARN
@@ -18,4 +19,5 @@ var (
 	FindResourcePolicyByARN             = findResourcePolicyByARN
 	FindRuleGroupByARN                  = findRuleGroupByARN
 	FindTLSInspectionConfigurationByARN = findTLSInspectionConfigurationByARN
+	FindVPCEndpointAssociationByARN     = findVPCEndpointAssociationByARN
 )
@@ -619,11 +619,7 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
-func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeRuleGroupOutput, error) {
-	input := &networkfirewall.DescribeRuleGroupInput{
-		RuleGroupArn: aws.String(arn),
-	}
-
+func findRuleGroup(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeRuleGroupInput) (*networkfirewall.DescribeRuleGroupOutput, error) {
 	output, err := conn.DescribeRuleGroup(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
@@ -644,6 +640,14 @@ func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn s
 	return output, nil
 }
 
+func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeRuleGroupOutput, error) {
+	input := &networkfirewall.DescribeRuleGroupInput{
+		RuleGroupArn: aws.String(arn),
+	}
+
+	return findRuleGroup(ctx, conn, input)
+}
+
 func statusRuleGroup(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findRuleGroupByARN(ctx, conn, arn)
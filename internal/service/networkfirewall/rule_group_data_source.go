@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_rule_group", name="Rule Group")
+// @Tags
+func dataSourceRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleGroupRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:         schema.TypeString,
+					AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"consumed_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:         schema.TypeString,
+					AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+					Optional:     true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+$`), "Must contain only alphanumeric characters and dash '-'"),
+					),
+				},
+				"number_of_associations": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				names.AttrTags: tftags.TagsSchemaComputed(),
+				names.AttrType: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					RequiredWith:     []string{names.AttrName},
+					ValidateDiagFunc: enum.Validate[awstypes.RuleGroupType](),
+				},
+				"update_token": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
+
+	input := &networkfirewall.DescribeRuleGroupInput{}
+	if v := d.Get(names.AttrARN).(string); v != "" {
+		input.RuleGroupArn = aws.String(v)
+	}
+	if v := d.Get(names.AttrName).(string); v != "" {
+		input.RuleGroupName = aws.String(v)
+	}
+	if v := d.Get(names.AttrType).(string); v != "" {
+		input.Type = awstypes.RuleGroupType(v)
+	}
+
+	output, err := findRuleGroup(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Rule Group: %s", err)
+	}
+
+	resp := output.RuleGroupResponse
+
+	d.SetId(aws.ToString(resp.RuleGroupArn))
+	d.Set(names.AttrARN, resp.RuleGroupArn)
+	d.Set("capacity", resp.Capacity)
+	d.Set("consumed_capacity", resp.ConsumedCapacity)
+	d.Set(names.AttrDescription, resp.Description)
+	d.Set(names.AttrName, resp.RuleGroupName)
+	d.Set("number_of_associations", resp.NumberOfAssociations)
+	d.Set(names.AttrType, resp.Type)
+	d.Set("update_token", output.UpdateToken)
+
+	setTagsOut(ctx, resp.Tags)
+
+	return diags
+}
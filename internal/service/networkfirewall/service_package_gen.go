@@ -27,6 +27,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newVPCEndpointAssociationResource,
+			Name:    "VPC Endpoint Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -49,6 +56,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_networkfirewall_resource_policy",
 			Name:     "Resource Policy",
 		},
+		{
+			Factory:  dataSourceRuleGroup,
+			TypeName: "aws_networkfirewall_rule_group",
+			Name:     "Rule Group",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	domainDataSourceResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_opensearch_domain_data_source", name="Domain Data Source")
+func resourceDomainDataSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainDataSourceCreate,
+		ReadWithoutTimeout:   resourceDomainDataSourceRead,
+		UpdateWithoutTimeout: resourceDomainDataSourceUpdate,
+		DeleteWithoutTimeout: resourceDomainDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_source_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_glue_data_catalog": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 80),
+					validation.StringMatch(regexache.MustCompile(`^[a-z][0-9a-z_]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and underscores"),
+				),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DataSourceStatus](),
+			},
+		},
+	}
+}
+
+func resourceDomainDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	name := d.Get(names.AttrName).(string)
+	id := errs.Must(flex.FlattenResourceId([]string{domainName, name}, domainDataSourceResourceIDPartCount, false))
+	input := &opensearch.AddDataSourceInput{
+		DataSourceType: expandDataSourceType(d.Get("data_source_type").([]interface{})),
+		DomainName:     aws.String(domainName),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.AddDataSource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Domain Data Source (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// Data sources are always added in the ACTIVE state.
+	if v, ok := d.GetOk(names.AttrStatus); ok && awstypes.DataSourceStatus(v.(string)) != awstypes.DataSourceStatusActive {
+		input := &opensearch.UpdateDataSourceInput{
+			DataSourceType: expandDataSourceType(d.Get("data_source_type").([]interface{})),
+			Description:    input.Description,
+			DomainName:     aws.String(domainName),
+			Name:           aws.String(name),
+			Status:         awstypes.DataSourceStatus(v.(string)),
+		}
+
+		_, err := conn.UpdateDataSource(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain Data Source (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDomainDataSourceRead(ctx, d, meta)...)
+}
+
+func resourceDomainDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), domainDataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+	output, err := findDomainDataSourceByTwoPartKey(ctx, conn, domainName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Domain Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Domain Data Source (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("data_source_type", flattenDataSourceType(output.DataSourceType)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_source_type: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrDomainName, domainName)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceDomainDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), domainDataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+	input := &opensearch.UpdateDataSourceInput{
+		DataSourceType: expandDataSourceType(d.Get("data_source_type").([]interface{})),
+		DomainName:     aws.String(domainName),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.DataSourceStatus(v.(string))
+	}
+
+	_, err = conn.UpdateDataSource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain Data Source (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDomainDataSourceRead(ctx, d, meta)...)
+}
+
+func resourceDomainDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), domainDataSourceResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting OpenSearch Domain Data Source: %s", d.Id())
+	_, err = conn.DeleteDataSource(ctx, &opensearch.DeleteDataSourceInput{
+		DomainName: aws.String(domainName),
+		Name:       aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain Data Source (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDomainDataSourceByTwoPartKey(ctx context.Context, conn *opensearch.Client, domainName, name string) (*opensearch.GetDataSourceOutput, error) {
+	input := &opensearch.GetDataSourceInput{
+		DomainName: aws.String(domainName),
+		Name:       aws.String(name),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDataSourceType(tfList []interface{}) awstypes.DataSourceType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["s3_glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject := &awstypes.DataSourceTypeMemberS3GlueDataCatalog{}
+
+		if v, ok := v[0].(map[string]interface{})[names.AttrRoleARN].(string); ok && v != "" {
+			apiObject.Value.RoleArn = aws.String(v)
+		}
+
+		return apiObject
+	}
+
+	return nil
+}
+
+func flattenDataSourceType(apiObject awstypes.DataSourceType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.DataSourceTypeMemberS3GlueDataCatalog:
+		tfMap["s3_glue_data_catalog"] = []interface{}{map[string]interface{}{
+			names.AttrRoleARN: aws.ToString(v.Value.RoleArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchDomainDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	rName := testAccRandomDataSourceName()
+	resourceName := "aws_opensearch_domain_data_source.test"
+	domainResourceName := "aws_opensearch_domain.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_basic(domainName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainDataSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_source_type.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_source_type.0.s3_glue_data_catalog.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source_type.0.s3_glue_data_catalog.0.role_arn", roleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomainName, domainResourceName, names.AttrDomainName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainDataSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	rName := testAccRandomDataSourceName()
+	resourceName := "aws_opensearch_domain_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_basic(domainName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainDataSourceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfopensearch.ResourceDomainDataSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainDataSource_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	rName := testAccRandomDataSourceName()
+	resourceName := "aws_opensearch_domain_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_update(domainName, rName, "description 1", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainDataSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainDataSourceConfig_update(domainName, rName, "description 2", "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainDataSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainDataSourceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
+
+		_, err := tfopensearch.FindDomainDataSourceByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccCheckDomainDataSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_domain_data_source" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
+
+			_, err := tfopensearch.FindDomainDataSourceByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Domain Data Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRandomDataSourceName() string {
+	return fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha))
+}
+
+func testAccDomainDataSourceConfig_base(domainName, rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_service_principal" "current" {
+  service_name = "opensearchservice"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[2]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.current.name
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonS3ReadOnlyAccess"
+}
+
+resource "aws_opensearch_domain" "test" {
+  domain_name    = %[1]q
+  engine_version = "OpenSearch_2.13"
+
+  cluster_config {
+    instance_type = "t3.small.search" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, domainName, rName)
+}
+
+func testAccDomainDataSourceConfig_basic(domainName, rName string) string {
+	return acctest.ConfigCompose(testAccDomainDataSourceConfig_base(domainName, rName), fmt.Sprintf(`
+resource "aws_opensearch_domain_data_source" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  name        = %[1]q
+
+  data_source_type {
+    s3_glue_data_catalog {
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccDomainDataSourceConfig_update(domainName, rName, description, status string) string {
+	return acctest.ConfigCompose(testAccDomainDataSourceConfig_base(domainName, rName), fmt.Sprintf(`
+resource "aws_opensearch_domain_data_source" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  name        = %[1]q
+  description = %[2]q
+  status      = %[3]q
+
+  data_source_type {
+    s3_glue_data_catalog {
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, description, status))
+}
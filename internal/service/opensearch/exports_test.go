@@ -5,6 +5,7 @@ package opensearch
 
 // Exports for use in tests only.
 var (
+	ResourceDomainDataSource          = resourceDomainDataSource
 	ResourceDomainSAMLOptions         = resourceDomainSAMLOptions
 	ResourceInboundConnectionAccepter = resourceInboundConnectionAccepter
 	ResourceOutboundConnection        = resourceOutboundConnection
@@ -13,6 +14,7 @@ var (
 	ResourceVPCEndpoint               = resourceVPCEndpoint
 
 	FindDomainByName                   = findDomainByName
+	FindDomainDataSourceByTwoPartKey   = findDomainDataSourceByTwoPartKey
 	FindPackageByID                    = findPackageByID
 	FindPackageAssociationByTwoPartKey = findPackageAssociationByTwoPartKey
 	FindVPCEndpointByID                = findVPCEndpointByID
@@ -42,6 +42,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceDomainDataSource,
+			TypeName: "aws_opensearch_domain_data_source",
+			Name:     "Domain Data Source",
+		},
 		{
 			Factory:  resourceDomainPolicy,
 			TypeName: "aws_opensearch_domain_policy",
@@ -76,9 +76,6 @@ func (r *resourceLifecyclePolicy) Schema(ctx context.Context, _ resource.SchemaR
 			},
 			"policy_version": schema.StringAttribute{
 				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			names.AttrType: schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.LifecyclePolicyType](),
@@ -187,6 +184,7 @@ func (r *resourceLifecyclePolicy) Update(ctx context.Context, req resource.Updat
 		}
 
 		in.ClientToken = aws.String(id.UniqueId())
+		in.PolicyVersion = state.PolicyVersion.ValueStringPointer()
 
 		out, err := conn.UpdateLifecyclePolicy(ctx, in)
 		if err != nil {
@@ -204,13 +202,15 @@ func (r *resourceLifecyclePolicy) Update(ctx context.Context, req resource.Updat
 			return
 		}
 
-		resp.Diagnostics.Append(flex.Flatten(ctx, out.LifecyclePolicyDetail, &state)...)
+		resp.Diagnostics.Append(flex.Flatten(ctx, out.LifecyclePolicyDetail, &plan)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	} else {
+		plan.PolicyVersion = state.PolicyVersion
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *resourceLifecyclePolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
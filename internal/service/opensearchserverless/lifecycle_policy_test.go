@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -123,6 +124,49 @@ func TestAccOpenSearchServerlessLifecyclePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessLifecyclePolicy_updatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lifecyclepolicy1, lifecyclepolicy2 types.LifecyclePolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckLifecyclePolicy(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_policy(rName, "81d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName, &lifecyclepolicy1),
+				),
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_policy(rName, "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName, &lifecyclepolicy2),
+					testAccCheckLifecyclePolicyVersionChanged(&lifecyclepolicy1, &lifecyclepolicy2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLifecyclePolicyVersionChanged(before, after *types.LifecyclePolicyDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(before.PolicyVersion) == aws.ToString(after.PolicyVersion) {
+			return fmt.Errorf("OpenSearch Serverless Lifecycle Policy (%s) policy version not changed", aws.ToString(after.Name))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
@@ -245,3 +289,23 @@ resource "aws_opensearchserverless_lifecycle_policy" "test" {
 }
 `, rName, description)
 }
+
+func testAccLifecyclePolicyConfig_policy(rName, retention string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_lifecycle_policy" "test" {
+  name   = %[1]q
+  type   = "retention"
+  policy = <<EOF
+{
+  "Rules": [
+    {
+      "ResourceType": "index",
+      "Resource": ["index/%[1]s/*"],
+      "MinIndexRetention": %[2]q
+    }
+  ]
+}
+EOF
+}
+`, rName, retention)
+}
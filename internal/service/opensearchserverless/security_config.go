@@ -82,6 +82,45 @@ func (r *resourceSecurityConfig) Schema(ctx context.Context, req resource.Schema
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"iam_identity_center_options": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"application_arn": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"application_description": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"application_name": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"group_attribute": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.IamIdentityCenterGroupAttribute](),
+						Optional:   true,
+						Computed:   true,
+					},
+					"instance_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"user_attribute": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.IamIdentityCenterUserAttribute](),
+						Optional:   true,
+						Computed:   true,
+					},
+				},
+			},
 			"saml_options": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
 					"group_attribute": schema.StringAttribute{
@@ -126,12 +165,13 @@ func (r *resourceSecurityConfig) Create(ctx context.Context, req resource.Create
 
 	input := opensearchserverless.CreateSecurityConfigInput{}
 	ignoreFieldOption := fwflex.WithIgnoredFieldNamesAppend("SamlOptions")
-	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, ignoreFieldOption)...)
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, ignoreFieldOption, fwflex.WithIgnoredFieldNamesAppend("IamIdentityCenterOptions"))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.IamIdentityCenterOptions = expandCreateIAMIdentityCenterOptions(ctx, plan.IAMIdentityCenterOptions, &resp.Diagnostics)
 	input.SamlOptions = expandSAMLOptions(ctx, plan.SamlOptions, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -155,10 +195,11 @@ func (r *resourceSecurityConfig) Create(ctx context.Context, req resource.Create
 	}
 
 	state := plan
-	resp.Diagnostics.Append(fwflex.Flatten(ctx, out.SecurityConfigDetail, &state)...)
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out.SecurityConfigDetail, &state, fwflex.WithIgnoredFieldNamesAppend("IamIdentityCenterOptions"))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.IAMIdentityCenterOptions = flattenIAMIdentityCenterOptions(ctx, out.SecurityConfigDetail.IamIdentityCenterOptions)
 	state.SamlOptions = flattenSAMLOptions(ctx, out.SecurityConfigDetail.SamlOptions)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -188,11 +229,12 @@ func (r *resourceSecurityConfig) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &state, fwflex.WithIgnoredFieldNamesAppend("SamlOptions"))...)
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &state, fwflex.WithIgnoredFieldNamesAppend("SamlOptions"), fwflex.WithIgnoredFieldNamesAppend("IamIdentityCenterOptions"))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	state.IAMIdentityCenterOptions = flattenIAMIdentityCenterOptions(ctx, out.IamIdentityCenterOptions)
 	state.SamlOptions = flattenSAMLOptions(ctx, out.SamlOptions)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -217,13 +259,16 @@ func (r *resourceSecurityConfig) Update(ctx context.Context, req resource.Update
 	if diff.HasChanges() {
 		input := opensearchserverless.UpdateSecurityConfigInput{}
 		flexIgnoreOption := fwflex.WithIgnoredFieldNamesAppend("SamlOptions")
-		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, flexIgnoreOption)...)
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, flexIgnoreOption, fwflex.WithIgnoredFieldNamesAppend("IamIdentityCenterOptions"))...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
 		input.ClientToken = aws.String(sdkid.UniqueId())
 		input.ConfigVersion = state.ConfigVersion.ValueStringPointer()
+		if !plan.IAMIdentityCenterOptions.Equal(state.IAMIdentityCenterOptions) {
+			input.IamIdentityCenterOptionsUpdates = expandUpdateIAMIdentityCenterOptions(ctx, plan.IAMIdentityCenterOptions, &resp.Diagnostics)
+		}
 		input.SamlOptions = expandSAMLOptions(ctx, plan.SamlOptions, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
@@ -235,10 +280,12 @@ func (r *resourceSecurityConfig) Update(ctx context.Context, req resource.Update
 			return
 		}
 
-		resp.Diagnostics.Append(fwflex.Flatten(ctx, out.SecurityConfigDetail, &plan, flexIgnoreOption)...)
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, out.SecurityConfigDetail, &plan, flexIgnoreOption, fwflex.WithIgnoredFieldNamesAppend("IamIdentityCenterOptions"))...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		plan.IAMIdentityCenterOptions = flattenIAMIdentityCenterOptions(ctx, out.SecurityConfigDetail.IamIdentityCenterOptions)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -272,7 +319,7 @@ func (r *resourceSecurityConfig) Delete(ctx context.Context, req resource.Delete
 func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, idSeparator)
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		err := fmt.Errorf("unexpected format for ID (%[1]s), expected saml/account-id/name", req.ID)
+		err := fmt.Errorf("unexpected format for ID (%[1]s), expected type/account-id/name", req.ID)
 		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
 		return
 	}
@@ -282,12 +329,22 @@ func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.I
 }
 
 type resourceSecurityConfigData struct {
-	ID            types.String                                    `tfsdk:"id"`
-	ConfigVersion types.String                                    `tfsdk:"config_version"`
-	Description   types.String                                    `tfsdk:"description"`
-	Name          types.String                                    `tfsdk:"name"`
-	SamlOptions   types.Object                                    `tfsdk:"saml_options"`
-	Type          fwtypes.StringEnum[awstypes.SecurityConfigType] `tfsdk:"type"`
+	ID                       types.String                                    `tfsdk:"id"`
+	ConfigVersion            types.String                                    `tfsdk:"config_version"`
+	Description              types.String                                    `tfsdk:"description"`
+	IAMIdentityCenterOptions types.Object                                    `tfsdk:"iam_identity_center_options"`
+	Name                     types.String                                    `tfsdk:"name"`
+	SamlOptions              types.Object                                    `tfsdk:"saml_options"`
+	Type                     fwtypes.StringEnum[awstypes.SecurityConfigType] `tfsdk:"type"`
+}
+
+type iamIdentityCenterOptions struct {
+	ApplicationARN         types.String                                                 `tfsdk:"application_arn"`
+	ApplicationDescription types.String                                                 `tfsdk:"application_description"`
+	ApplicationName        types.String                                                 `tfsdk:"application_name"`
+	GroupAttribute         fwtypes.StringEnum[awstypes.IamIdentityCenterGroupAttribute] `tfsdk:"group_attribute"`
+	InstanceARN            fwtypes.ARN                                                  `tfsdk:"instance_arn"`
+	UserAttribute          fwtypes.StringEnum[awstypes.IamIdentityCenterUserAttribute]  `tfsdk:"user_attribute"`
 }
 
 type samlOptions struct {
@@ -340,3 +397,55 @@ func flattenSAMLOptions(ctx context.Context, so *awstypes.SamlConfigOptions) typ
 
 	return types.ObjectValueMust(attributeTypes, attrs)
 }
+
+func expandCreateIAMIdentityCenterOptions(ctx context.Context, object types.Object, diags *diag.Diagnostics) *awstypes.CreateIamIdentityCenterConfigOptions {
+	if object.IsNull() || object.IsUnknown() {
+		return nil
+	}
+
+	var options iamIdentityCenterOptions
+	diags.Append(object.As(ctx, &options, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &awstypes.CreateIamIdentityCenterConfigOptions{
+		GroupAttribute: options.GroupAttribute.ValueEnum(),
+		InstanceArn:    options.InstanceARN.ValueStringPointer(),
+		UserAttribute:  options.UserAttribute.ValueEnum(),
+	}
+}
+
+func expandUpdateIAMIdentityCenterOptions(ctx context.Context, object types.Object, diags *diag.Diagnostics) *awstypes.UpdateIamIdentityCenterConfigOptions {
+	if object.IsNull() || object.IsUnknown() {
+		return nil
+	}
+
+	var options iamIdentityCenterOptions
+	diags.Append(object.As(ctx, &options, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &awstypes.UpdateIamIdentityCenterConfigOptions{
+		GroupAttribute: options.GroupAttribute.ValueEnum(),
+		UserAttribute:  options.UserAttribute.ValueEnum(),
+	}
+}
+
+func flattenIAMIdentityCenterOptions(ctx context.Context, apiObject *awstypes.IamIdentityCenterConfigOptions) types.Object {
+	if apiObject == nil {
+		return fwtypes.NewObjectValueOfNull[iamIdentityCenterOptions](ctx).ObjectValue
+	}
+
+	attributeTypes := fwtypes.AttributeTypesMust[iamIdentityCenterOptions](ctx)
+	attrs := map[string]attr.Value{}
+	attrs["application_arn"] = fwflex.StringToFramework(ctx, apiObject.ApplicationArn)
+	attrs["application_description"] = fwflex.StringToFramework(ctx, apiObject.ApplicationDescription)
+	attrs["application_name"] = fwflex.StringToFramework(ctx, apiObject.ApplicationName)
+	attrs["group_attribute"] = fwtypes.StringEnumValue(apiObject.GroupAttribute)
+	attrs["instance_arn"] = fwflex.StringToFrameworkARN(ctx, apiObject.InstanceArn)
+	attrs["user_attribute"] = fwtypes.StringEnumValue(apiObject.UserAttribute)
+
+	return types.ObjectValueMust(attributeTypes, attrs)
+}
@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -57,6 +59,31 @@ func (d *dataSourceSecurityConfig) Schema(ctx context.Context, req datasource.Sc
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"iam_identity_center_options": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"application_arn": schema.StringAttribute{
+						Computed: true,
+					},
+					"application_description": schema.StringAttribute{
+						Computed: true,
+					},
+					"application_name": schema.StringAttribute{
+						Computed: true,
+					},
+					"group_attribute": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.IamIdentityCenterGroupAttribute](),
+						Computed:   true,
+					},
+					"instance_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Computed:   true,
+					},
+					"user_attribute": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.IamIdentityCenterUserAttribute](),
+						Computed:   true,
+					},
+				},
+			},
 			"saml_options": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
 					"group_attribute": schema.StringAttribute{
@@ -107,6 +134,8 @@ func (d *dataSourceSecurityConfig) Read(ctx context.Context, req datasource.Read
 
 	data.Type = flex.StringValueToFramework(ctx, out.Type)
 
+	data.IAMIdentityCenterOptions = flattenIAMIdentityCenterOptions(ctx, out.IamIdentityCenterOptions)
+
 	samlOptions := flattenSAMLOptions(ctx, out.SamlOptions)
 	data.SamlOptions = samlOptions
 
@@ -114,11 +143,12 @@ func (d *dataSourceSecurityConfig) Read(ctx context.Context, req datasource.Read
 }
 
 type dataSourceSecurityConfigData struct {
-	ConfigVersion    types.String `tfsdk:"config_version"`
-	CreatedDate      types.String `tfsdk:"created_date"`
-	Description      types.String `tfsdk:"description"`
-	ID               types.String `tfsdk:"id"`
-	LastModifiedDate types.String `tfsdk:"last_modified_date"`
-	SamlOptions      types.Object `tfsdk:"saml_options"`
-	Type             types.String `tfsdk:"type"`
+	ConfigVersion            types.String `tfsdk:"config_version"`
+	CreatedDate              types.String `tfsdk:"created_date"`
+	Description              types.String `tfsdk:"description"`
+	IAMIdentityCenterOptions types.Object `tfsdk:"iam_identity_center_options"`
+	ID                       types.String `tfsdk:"id"`
+	LastModifiedDate         types.String `tfsdk:"last_modified_date"`
+	SamlOptions              types.Object `tfsdk:"saml_options"`
+	Type                     types.String `tfsdk:"type"`
 }
@@ -96,6 +96,51 @@ func TestAccOpenSearchServerlessSecurityConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessSecurityConfig_iamIdentityCenter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var securityconfig types.SecurityConfigDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_security_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckSecurityConfig(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfig_iamIdentityCenter(rName, "UserId", "GroupId"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigExists(ctx, resourceName, &securityconfig),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "iamidentitycenter"),
+					resource.TestCheckResourceAttrSet(resourceName, "iam_identity_center_options.application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "iam_identity_center_options.group_attribute", "GroupId"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_identity_center_options.instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttr(resourceName, "iam_identity_center_options.user_attribute", "UserId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityConfig_iamIdentityCenter(rName, "UserName", "GroupName"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigExists(ctx, resourceName, &securityconfig),
+					resource.TestCheckResourceAttr(resourceName, "iam_identity_center_options.group_attribute", "GroupName"),
+					resource.TestCheckResourceAttr(resourceName, "iam_identity_center_options.user_attribute", "UserName"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchServerlessSecurityConfig_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var securityconfig types.SecurityConfigDetail
@@ -217,3 +262,20 @@ resource "aws_opensearchserverless_security_config" "test" {
 }
 `, rName, samlOptions, description, sessionTimeout)
 }
+
+func testAccSecurityConfig_iamIdentityCenter(rName, userAttribute, groupAttribute string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_opensearchserverless_security_config" "test" {
+  name = %[1]q
+  type = "iamidentitycenter"
+
+  iam_identity_center_options {
+    instance_arn    = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+    user_attribute  = %[2]q
+    group_attribute = %[3]q
+  }
+}
+`, rName, userAttribute, groupAttribute)
+}
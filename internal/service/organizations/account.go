@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -52,6 +53,11 @@ func resourceAccount() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"close_on_deletion_confirmation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
 			"create_govcloud": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -110,7 +116,10 @@ func resourceAccount() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAccountCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -268,6 +277,16 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
 	close := d.Get("close_on_deletion").(bool)
+
+	// CustomizeDiff doesn't run on destroy, so the confirmation is enforced here.
+	if confirmation := d.Get("close_on_deletion_confirmation").(string); close && confirmation != d.Id() {
+		if confirmation == "" {
+			return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): close_on_deletion_confirmation must be set to the account ID", d.Id())
+		}
+
+		return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): close_on_deletion_confirmation (%s) does not match the account ID", d.Id(), confirmation)
+	}
+
 	var err error
 
 	if close {
@@ -282,7 +301,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 		})
 	}
 
-	if errs.IsA[*awstypes.AccountNotFoundException](err) {
+	if errs.IsA[*awstypes.AccountNotFoundException](err) || errs.IsA[*awstypes.AccountAlreadyClosedException](err) {
 		return diags
 	}
 
@@ -299,6 +318,35 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// resourceAccountCustomizeDiff checks that a configured close_on_deletion_confirmation matches the account ID.
+// The account ID is not known until the account has been created, so the confirmation can't be set until then.
+// resourceAccountDelete refuses to close the account without a matching confirmation.
+func resourceAccountCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("close_on_deletion") || !diff.NewValueKnown("close_on_deletion_confirmation") {
+		return nil
+	}
+
+	confirmation := diff.Get("close_on_deletion_confirmation").(string)
+
+	if diff.Id() == "" {
+		if confirmation != "" {
+			return errors.New("close_on_deletion_confirmation can't be set until the account has been created")
+		}
+
+		return nil
+	}
+
+	if !diff.Get("close_on_deletion").(bool) {
+		return nil
+	}
+
+	if confirmation != "" && confirmation != diff.Id() {
+		return fmt.Errorf("close_on_deletion_confirmation (%s) does not match the account ID (%s)", confirmation, diff.Id())
+	}
+
+	return nil
+}
+
 func resourceAccountImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if strings.Contains(d.Id(), "_") {
 		parts := strings.Split(d.Id(), "_")
@@ -9,7 +9,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -27,6 +30,7 @@ func testAccAccountImportStep(n string) resource.TestStep {
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
 			"close_on_deletion",
+			"close_on_deletion_confirmation",
 			"create_govcloud",
 			"govcloud_id",
 		},
@@ -81,6 +85,7 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 	}
 
 	var v awstypes.Account
+	variables := config.Variables{}
 	resourceName := "aws_organizations_account.test"
 	rInt := sdkacctest.RandInt()
 	name := fmt.Sprintf("tf_acctest_%d", rInt)
@@ -93,9 +98,11 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 		CheckDestroy:             testAccCheckAccountDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAccountConfig_closeOnDeletion(name, email),
+				Config:          testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: variables,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountSetCloseOnDeletionConfirmation(&v, variables),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, email),
 					resource.TestCheckResourceAttr(resourceName, "govcloud_id", ""),
@@ -108,6 +115,73 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 				),
 			},
 			testAccAccountImportStep(resourceName),
+			{
+				Config:          testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "close_on_deletion_confirmation", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccount_CloseOnDeletionConfirmation(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v awstypes.Account
+	variables := config.Variables{}
+	resourceName := "aws_organizations_account.test"
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: config.Variables{
+					"close_on_deletion_confirmation": config.StringVariable("123456789012"),
+				},
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`close_on_deletion_confirmation can't be set until the account has been created`),
+			},
+			{
+				Config:          testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountSetCloseOnDeletionConfirmation(&v, variables),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion_confirmation", ""),
+				),
+			},
+			{
+				Config:          testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "close_on_deletion_confirmation", resourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccAccountConfig_closeOnDeletion(name, email),
+				ConfigVariables: config.Variables{
+					"close_on_deletion_confirmation": config.StringVariable("123456789012"),
+				},
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`does not match the account ID`),
+			},
 		},
 	})
 }
@@ -121,6 +195,7 @@ func testAccAccount_ParentID(t *testing.T) {
 	}
 
 	var v awstypes.Account
+	variables := config.Variables{}
 	rInt := sdkacctest.RandInt()
 	name := fmt.Sprintf("tf_acctest_%d", rInt)
 	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
@@ -135,15 +210,18 @@ func testAccAccount_ParentID(t *testing.T) {
 		CheckDestroy:             testAccCheckAccountDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAccountConfig_parentId1(name, email),
+				Config:          testAccAccountConfig_parentId1(name, email),
+				ConfigVariables: variables,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountSetCloseOnDeletionConfirmation(&v, variables),
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName1, names.AttrID),
 				),
 			},
 			testAccAccountImportStep(resourceName),
 			{
-				Config: testAccAccountConfig_parentId2(name, email),
+				Config:          testAccAccountConfig_parentId2(name, email),
+				ConfigVariables: variables,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName2, names.AttrID),
@@ -162,6 +240,7 @@ func testAccAccount_Tags(t *testing.T) {
 	}
 
 	var v awstypes.Account
+	variables := config.Variables{}
 	rInt := sdkacctest.RandInt()
 	name := fmt.Sprintf("tf_acctest_%d", rInt)
 	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
@@ -174,16 +253,19 @@ func testAccAccount_Tags(t *testing.T) {
 		CheckDestroy:             testAccCheckAccountDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAccountConfig_tags1(name, email, acctest.CtKey1, acctest.CtValue1),
+				Config:          testAccAccountConfig_tags1(name, email, acctest.CtKey1, acctest.CtValue1),
+				ConfigVariables: variables,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountSetCloseOnDeletionConfirmation(&v, variables),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
 			},
 			testAccAccountImportStep(resourceName),
 			{
-				Config: testAccAccountConfig_tags2(name, email, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Config:          testAccAccountConfig_tags2(name, email, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				ConfigVariables: variables,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
@@ -192,7 +274,8 @@ func testAccAccount_Tags(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccAccountConfig_tags1(name, email, acctest.CtKey2, acctest.CtValue2),
+				Config:          testAccAccountConfig_tags1(name, email, acctest.CtKey2, acctest.CtValue2),
+				ConfigVariables: variables,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
@@ -235,6 +318,16 @@ func testAccAccount_govCloud(t *testing.T) {
 	})
 }
 
+// testAccCheckAccountSetCloseOnDeletionConfirmation sets the close_on_deletion_confirmation variable to the account's ID
+// so that subsequent steps confirm closure of the account on destroy.
+func testAccCheckAccountSetCloseOnDeletionConfirmation(v *awstypes.Account, variables config.Variables) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		variables["close_on_deletion_confirmation"] = config.StringVariable(aws.ToString(v.Id))
+
+		return nil
+	}
+}
+
 func testAccCheckAccountDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
@@ -291,18 +384,28 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+// testAccAccountConfig_closeOnDeletionConfirmationBase declares the variable that confirms closure of the account on destroy.
+// See testAccCheckAccountSetCloseOnDeletionConfirmation.
+const testAccAccountConfig_closeOnDeletionConfirmationBase = `
+variable "close_on_deletion_confirmation" {
+  type    = string
+  default = null
+}
+`
+
 func testAccAccountConfig_closeOnDeletion(name, email string) string {
-	return fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccAccountConfig_closeOnDeletionConfirmationBase, fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
-  name              = %[1]q
-  email             = %[2]q
-  close_on_deletion = true
+  name                           = %[1]q
+  email                          = %[2]q
+  close_on_deletion              = true
+  close_on_deletion_confirmation = var.close_on_deletion_confirmation
 }
-`, name, email)
+`, name, email))
 }
 
 func testAccAccountConfig_parentId1(name, email string) string {
-	return fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccAccountConfig_closeOnDeletionConfirmationBase, fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
 
 resource "aws_organizations_organizational_unit" "test1" {
@@ -316,16 +419,17 @@ resource "aws_organizations_organizational_unit" "test2" {
 }
 
 resource "aws_organizations_account" "test" {
-  name              = %[1]q
-  email             = %[2]q
-  parent_id         = aws_organizations_organizational_unit.test1.id
-  close_on_deletion = true
+  name                           = %[1]q
+  email                          = %[2]q
+  parent_id                      = aws_organizations_organizational_unit.test1.id
+  close_on_deletion              = true
+  close_on_deletion_confirmation = var.close_on_deletion_confirmation
 }
-`, name, email)
+`, name, email))
 }
 
 func testAccAccountConfig_parentId2(name, email string) string {
-	return fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccAccountConfig_closeOnDeletionConfirmationBase, fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
 
 resource "aws_organizations_organizational_unit" "test1" {
@@ -339,41 +443,44 @@ resource "aws_organizations_organizational_unit" "test2" {
 }
 
 resource "aws_organizations_account" "test" {
-  name              = %[1]q
-  email             = %[2]q
-  parent_id         = aws_organizations_organizational_unit.test2.id
-  close_on_deletion = true
+  name                           = %[1]q
+  email                          = %[2]q
+  parent_id                      = aws_organizations_organizational_unit.test2.id
+  close_on_deletion              = true
+  close_on_deletion_confirmation = var.close_on_deletion_confirmation
 }
-`, name, email)
+`, name, email))
 }
 
 func testAccAccountConfig_tags1(name, email, tagKey1, tagValue1 string) string {
-	return fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccAccountConfig_closeOnDeletionConfirmationBase, fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
-  name              = %[1]q
-  email             = %[2]q
-  close_on_deletion = true
+  name                           = %[1]q
+  email                          = %[2]q
+  close_on_deletion              = true
+  close_on_deletion_confirmation = var.close_on_deletion_confirmation
 
   tags = {
     %[3]q = %[4]q
   }
 }
-`, name, email, tagKey1, tagValue1)
+`, name, email, tagKey1, tagValue1))
 }
 
 func testAccAccountConfig_tags2(name, email, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
-	return fmt.Sprintf(`
+	return acctest.ConfigCompose(testAccAccountConfig_closeOnDeletionConfirmationBase, fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
-  name              = %[1]q
-  email             = %[2]q
-  close_on_deletion = true
+  name                           = %[1]q
+  email                          = %[2]q
+  close_on_deletion              = true
+  close_on_deletion_confirmation = var.close_on_deletion_confirmation
 
   tags = {
     %[3]q = %[4]q
     %[5]q = %[6]q
   }
 }
-`, name, email, tagKey1, tagValue1, tagKey2, tagValue2)
+`, name, email, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
 func testAccAccountConfig_govCloud(name, email string) string {
@@ -5,8 +5,21 @@ package organizations
 
 import (
 	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 const (
 	organizationFinalizationTimeout = 4 * time.Minute
 )
+
+// Policy types not yet modeled in the AWS SDK for Go v2.
+const (
+	policyTypeDeclarativePolicyEC2  awstypes.PolicyType = "DECLARATIVE_POLICY_EC2"
+	policyTypeResourceControlPolicy awstypes.PolicyType = "RESOURCE_CONTROL_POLICY"
+)
+
+func policyTypeValues() []string {
+	return append(enum.Values[awstypes.PolicyType](), enum.Slice(policyTypeDeclarativePolicyEC2, policyTypeResourceControlPolicy)...)
+}
@@ -38,11 +38,12 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource_delegatedAdministrator": testAccOrganizationDataSource_delegatedAdministrator,
 		},
 		"Account": {
-			acctest.CtBasic:   testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
-			"GovCloud":        testAccAccount_govCloud,
+			acctest.CtBasic:               testAccAccount_basic,
+			"CloseOnDeletion":             testAccAccount_CloseOnDeletion,
+			"CloseOnDeletionConfirmation": testAccAccount_CloseOnDeletionConfirmation,
+			"ParentId":                    testAccAccount_ParentID,
+			"Tags":                        testAccAccount_Tags,
+			"GovCloud":                    testAccAccount_govCloud,
 		},
 		"OrganizationalUnit": {
 			acctest.CtBasic:                      testAccOrganizationalUnit_basic,
@@ -66,6 +67,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"Type_Backup":            testAccPolicy_type_Backup,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
+			"Type_RCP":               testAccPolicy_type_RCP,
+			"Type_DeclarativeEC2":    testAccPolicy_type_DeclarativeEC2,
+			"ContentInvalid":         testAccPolicy_contentInvalid,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
 		},
 		"PolicyAttachment": {
@@ -5,6 +5,7 @@ package organizations
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -12,11 +13,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -65,15 +66,18 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          awstypes.PolicyTypeServiceControlPolicy,
-				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      awstypes.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice(policyTypeValues(), false),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -211,6 +215,40 @@ func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// policyContentRequiredElements maps each policy type to the top-level element its content must contain.
+var policyContentRequiredElements = map[awstypes.PolicyType]string{
+	awstypes.PolicyTypeAiservicesOptOutPolicy: "services",
+	awstypes.PolicyTypeBackupPolicy:           "plans",
+	awstypes.PolicyTypeServiceControlPolicy:   "Statement",
+	awstypes.PolicyTypeTagPolicy:              "tags",
+	policyTypeDeclarativePolicyEC2:            "ec2_attributes",
+	policyTypeResourceControlPolicy:           "Statement",
+}
+
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(names.AttrContent) || !diff.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	policyType := awstypes.PolicyType(diff.Get(names.AttrType).(string))
+	element, ok := policyContentRequiredElements[policyType]
+
+	if !ok {
+		return nil
+	}
+
+	var content map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(diff.Get(names.AttrContent).(string)), &content); err != nil {
+		return fmt.Errorf("%s content must be a JSON object: %w", policyType, err)
+	}
+
+	if _, ok := content[element]; !ok {
+		return fmt.Errorf("%s content must contain a top-level %q element", policyType, element)
+	}
+
+	return nil
+}
+
 func findPolicyByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Policy, error) {
 	input := &organizations.DescribePolicyInput{
 		PolicyId: aws.String(id),
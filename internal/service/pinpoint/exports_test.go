@@ -5,11 +5,14 @@ package pinpoint
 
 // Exports for use in tests only.
 var (
-	ResourceApp           = resourceApp
-	ResourceEmailChannel  = resourceEmailChannel
-	ResourceEmailTemplate = newResourceEmailTemplate
-	ResourceEventStream   = resourceEventStream
-	ResourceSMSChannel    = resourceSMSChannel
+	ResourceApp                      = resourceApp
+	ResourceEmailChannel             = resourceEmailChannel
+	ResourceEmailTemplate            = newResourceEmailTemplate
+	ResourceEventStream              = resourceEventStream
+	ResourceInAppTemplate            = newInAppTemplateResource
+	ResourceJourney                  = newJourneyResource
+	ResourceRecommenderConfiguration = newRecommenderConfigurationResource
+	ResourceSMSChannel               = resourceSMSChannel
 
 	FindADMChannelByApplicationId             = findADMChannelByApplicationId
 	FindAPNSChannelByApplicationId            = findAPNSChannelByApplicationId
@@ -23,6 +26,9 @@ var (
 	FindGCMChannelByApplicationId             = findGCMChannelByApplicationId
 	FindSMSChannelByApplicationId             = findSMSChannelByApplicationId
 	FindEmailTemplateByName                   = findEmailTemplateByName
+	FindInAppTemplateByName                   = findInAppTemplateByName
+	FindJourneyByTwoPartKey                   = findJourneyByTwoPartKey
+	FindRecommenderConfigurationByID          = findRecommenderConfigurationByID
 )
 
 const (
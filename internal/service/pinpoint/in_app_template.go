@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpoint_in_app_template", name="In-App Template")
+// @Tags(identifierAttribute="arn")
+func newInAppTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &inAppTemplateResource{}
+
+	return r, nil
+}
+
+type inAppTemplateResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*inAppTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pinpoint_in_app_template"
+}
+
+func (r *inAppTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	buttonConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[inAppMessageButtonModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"android":        overrideButtonConfigurationBlock(ctx),
+				"default_config": defaultButtonConfigurationBlock(ctx),
+				"ios":            overrideButtonConfigurationBlock(ctx),
+				"web":            overrideButtonConfigurationBlock(ctx),
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"template_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"in_app_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inAppTemplateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_config": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"layout": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Layout](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrContent: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[inAppMessageContentModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 5),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"background_color": schema.StringAttribute{
+										Optional: true,
+									},
+									"image_url": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"body_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[inAppMessageBodyConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"alignment": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Alignment](),
+													Required:   true,
+												},
+												"body": schema.StringAttribute{
+													Required: true,
+												},
+												"text_color": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"header_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[inAppMessageHeaderConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"alignment": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Alignment](),
+													Required:   true,
+												},
+												names.AttrHeader: schema.StringAttribute{
+													Required: true,
+												},
+												"text_color": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"primary_btn":   buttonConfigurationBlock,
+									"secondary_btn": buttonConfigurationBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func defaultButtonConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[defaultButtonConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"background_color": schema.StringAttribute{
+					Optional: true,
+				},
+				"border_radius": schema.Int64Attribute{
+					Optional: true,
+				},
+				"button_action": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ButtonAction](),
+					Required:   true,
+				},
+				"link": schema.StringAttribute{
+					Optional: true,
+				},
+				"text": schema.StringAttribute{
+					Required: true,
+				},
+				"text_color": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func overrideButtonConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[overrideButtonConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"button_action": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ButtonAction](),
+					Required:   true,
+				},
+				"link": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *inAppTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data inAppTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	name := data.TemplateName.ValueString()
+	input := &pinpoint.CreateInAppTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNameSuffix("Request"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.InAppTemplateRequest.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateInAppTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Pinpoint In-App Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateCreateMessageBody.Arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inAppTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data inAppTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	output, err := findInAppTemplateByName(ctx, conn, data.TemplateName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Pinpoint In-App Template (%s)", data.TemplateName.ValueString()), err.Error())
+
+		return
+	}
+
+	var template inAppTemplateModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &template)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.InAppTemplate = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &template)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inAppTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new inAppTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	if !new.InAppTemplate.Equal(old.InAppTemplate) {
+		input := &pinpoint.UpdateInAppTemplateInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, fwflex.WithFieldNameSuffix("Request"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateInAppTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Pinpoint In-App Template (%s)", new.TemplateName.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *inAppTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data inAppTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	_, err := conn.DeleteInAppTemplate(ctx, &pinpoint.DeleteInAppTemplateInput{
+		TemplateName: fwflex.StringFromFramework(ctx, data.TemplateName),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Pinpoint In-App Template (%s)", data.TemplateName.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *inAppTemplateResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("template_name"), request, response)
+}
+
+func (r *inAppTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findInAppTemplateByName(ctx context.Context, conn *pinpoint.Client, name string) (*awstypes.InAppTemplateResponse, error) {
+	input := &pinpoint.GetInAppTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	output, err := conn.GetInAppTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.InAppTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.InAppTemplateResponse, nil
+}
+
+type inAppTemplateResourceModel struct {
+	ARN           types.String                                        `tfsdk:"arn"`
+	InAppTemplate fwtypes.ListNestedObjectValueOf[inAppTemplateModel] `tfsdk:"in_app_template"`
+	Tags          tftags.Map                                          `tfsdk:"tags"`
+	TagsAll       tftags.Map                                          `tfsdk:"tags_all"`
+	TemplateName  types.String                                        `tfsdk:"template_name"`
+}
+
+type inAppTemplateModel struct {
+	Content             fwtypes.ListNestedObjectValueOf[inAppMessageContentModel] `tfsdk:"content"`
+	CustomConfig        fwtypes.MapValueOf[types.String]                          `tfsdk:"custom_config"`
+	Layout              fwtypes.StringEnum[awstypes.Layout]                       `tfsdk:"layout"`
+	TemplateDescription types.String                                              `tfsdk:"description"`
+}
+
+type inAppMessageContentModel struct {
+	BackgroundColor types.String                                                   `tfsdk:"background_color"`
+	BodyConfig      fwtypes.ListNestedObjectValueOf[inAppMessageBodyConfigModel]   `tfsdk:"body_config"`
+	HeaderConfig    fwtypes.ListNestedObjectValueOf[inAppMessageHeaderConfigModel] `tfsdk:"header_config"`
+	ImageUrl        types.String                                                   `tfsdk:"image_url"`
+	PrimaryBtn      fwtypes.ListNestedObjectValueOf[inAppMessageButtonModel]       `tfsdk:"primary_btn"`
+	SecondaryBtn    fwtypes.ListNestedObjectValueOf[inAppMessageButtonModel]       `tfsdk:"secondary_btn"`
+}
+
+type inAppMessageBodyConfigModel struct {
+	Alignment fwtypes.StringEnum[awstypes.Alignment] `tfsdk:"alignment"`
+	Body      types.String                           `tfsdk:"body"`
+	TextColor types.String                           `tfsdk:"text_color"`
+}
+
+type inAppMessageHeaderConfigModel struct {
+	Alignment fwtypes.StringEnum[awstypes.Alignment] `tfsdk:"alignment"`
+	Header    types.String                           `tfsdk:"header"`
+	TextColor types.String                           `tfsdk:"text_color"`
+}
+
+type inAppMessageButtonModel struct {
+	Android       fwtypes.ListNestedObjectValueOf[overrideButtonConfigurationModel] `tfsdk:"android"`
+	DefaultConfig fwtypes.ListNestedObjectValueOf[defaultButtonConfigurationModel]  `tfsdk:"default_config"`
+	IOS           fwtypes.ListNestedObjectValueOf[overrideButtonConfigurationModel] `tfsdk:"ios"`
+	Web           fwtypes.ListNestedObjectValueOf[overrideButtonConfigurationModel] `tfsdk:"web"`
+}
+
+type defaultButtonConfigurationModel struct {
+	BackgroundColor types.String                              `tfsdk:"background_color"`
+	BorderRadius    types.Int64                               `tfsdk:"border_radius"`
+	ButtonAction    fwtypes.StringEnum[awstypes.ButtonAction] `tfsdk:"button_action"`
+	Link            types.String                              `tfsdk:"link"`
+	Text            types.String                              `tfsdk:"text"`
+	TextColor       types.String                              `tfsdk:"text_color"`
+}
+
+type overrideButtonConfigurationModel struct {
+	ButtonAction fwtypes.StringEnum[awstypes.ButtonAction] `tfsdk:"button_action"`
+	Link         types.String                              `tfsdk:"link"`
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointInAppTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_in_app_template.test"
+	var v awstypes.InAppTemplateResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInAppTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInAppTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.layout", "TOP_BANNER"),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.content.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.content.0.body_config.0.body", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.content.0.primary_btn.0.default_config.0.button_action", "CLOSE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        rName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "template_name",
+			},
+		},
+	})
+}
+
+func TestAccPinpointInAppTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_in_app_template.test"
+	var v awstypes.InAppTemplateResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInAppTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInAppTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceInAppTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointInAppTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_in_app_template.test"
+	var v awstypes.InAppTemplateResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInAppTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInAppTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.content.0.body_config.0.body", "Hello"),
+				),
+			},
+			{
+				Config: testAccInAppTemplateConfig_basic(rName, "Goodbye"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "in_app_template.0.content.0.body_config.0.body", "Goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointInAppTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_in_app_template.test"
+	var v awstypes.InAppTemplateResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInAppTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInAppTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccInAppTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInAppTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInAppTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_in_app_template" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindInAppTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint In-App Template %s still exists", rs.Primary.Attributes["template_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInAppTemplateExists(ctx context.Context, n string, v *awstypes.InAppTemplateResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindInAppTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccInAppTemplateConfig_basic(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_in_app_template" "test" {
+  template_name = %[1]q
+
+  in_app_template {
+    layout = "TOP_BANNER"
+
+    content {
+      background_color = "#FFFFFF"
+
+      body_config {
+        alignment  = "CENTER"
+        body       = %[2]q
+        text_color = "#000000"
+      }
+
+      primary_btn {
+        default_config {
+          button_action = "CLOSE"
+          text          = "Close"
+        }
+      }
+    }
+  }
+}
+`, rName, body)
+}
+
+func testAccInAppTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_in_app_template" "test" {
+  template_name = %[1]q
+
+  in_app_template {
+    layout = "TOP_BANNER"
+
+    content {
+      body_config {
+        alignment  = "CENTER"
+        body       = "Hello"
+        text_color = "#000000"
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpoint_journey", name="Journey")
+func newJourneyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &journeyResource{}
+
+	return r, nil
+}
+
+type journeyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*journeyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pinpoint_journey"
+}
+
+func (r *journeyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"activities": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"journey_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"refresh_frequency": schema.StringAttribute{
+				Optional: true,
+			},
+			"start_activity": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.State](),
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[journeyScheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"end_time": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						names.AttrStartTime: schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						"timezone": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *journeyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data journeyResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Activities.IsNull() || data.Activities.IsUnknown() {
+		return
+	}
+
+	activities, err := expandJourneyActivities(data.Activities.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("activities"), "Invalid Journey Activities", err.Error())
+
+		return
+	}
+
+	if data.StartActivity.IsNull() || data.StartActivity.IsUnknown() {
+		return
+	}
+
+	if startActivity := data.StartActivity.ValueString(); !hasActivity(activities, startActivity) {
+		response.Diagnostics.AddAttributeError(path.Root("start_activity"), "Invalid Journey Start Activity", fmt.Sprintf("activity %q is not defined in activities", startActivity))
+	}
+}
+
+func (r *journeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data journeyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	writeRequest, diags := data.expandWriteJourneyRequest(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &pinpoint.CreateJourneyInput{
+		ApplicationId:       fwflex.StringFromFramework(ctx, data.ApplicationID),
+		WriteJourneyRequest: writeRequest,
+	}
+
+	output, err := conn.CreateJourney(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Pinpoint Journey (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	journey := output.JourneyResponse
+	data.JourneyID = fwflex.StringToFramework(ctx, journey.Id)
+	data.State = fwtypes.StringEnumValue(journey.State)
+	data.setID()
+	data.ARN = types.StringValue(r.journeyARN(data.ApplicationID.ValueString(), data.JourneyID.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *journeyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data journeyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	output, err := findJourneyByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.JourneyID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Pinpoint Journey (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = types.StringValue(r.journeyARN(data.ApplicationID.ValueString(), data.JourneyID.ValueString()))
+
+	// Keep the configured document if it describes the same activities.
+	if old, err := expandJourneyActivities(data.Activities.ValueString()); err != nil || !reflect.DeepEqual(old, output.Activities) {
+		activities, err := flattenJourneyActivities(output.Activities)
+
+		if err != nil {
+			response.Diagnostics.AddError("flattening activities", err.Error())
+
+			return
+		}
+
+		data.Activities = jsontypes.NewNormalizedValue(activities)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *journeyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new journeyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	writeRequest, diags := new.expandWriteJourneyRequest(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pinpoint.UpdateJourneyInput{
+		ApplicationId:       fwflex.StringFromFramework(ctx, new.ApplicationID),
+		JourneyId:           fwflex.StringFromFramework(ctx, new.JourneyID),
+		WriteJourneyRequest: writeRequest,
+	}
+
+	output, err := conn.UpdateJourney(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Pinpoint Journey (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.State = fwtypes.StringEnumValue(output.JourneyResponse.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *journeyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data journeyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	_, err := conn.DeleteJourney(ctx, &pinpoint.DeleteJourneyInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		JourneyId:     fwflex.StringFromFramework(ctx, data.JourneyID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Pinpoint Journey (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findJourneyByTwoPartKey(ctx context.Context, conn *pinpoint.Client, applicationID, journeyID string) (*awstypes.JourneyResponse, error) {
+	input := &pinpoint.GetJourneyInput{
+		ApplicationId: aws.String(applicationID),
+		JourneyId:     aws.String(journeyID),
+	}
+
+	output, err := conn.GetJourney(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JourneyResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JourneyResponse, nil
+}
+
+// expandJourneyActivities decodes a journey's activities document.
+// Unknown fields are rejected so that typos are caught at plan time.
+func expandJourneyActivities(s string) (map[string]awstypes.Activity, error) {
+	var activities map[string]awstypes.Activity
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&activities); err != nil {
+		return nil, err
+	}
+
+	if len(activities) == 0 {
+		return nil, fmt.Errorf("at least one activity must be defined")
+	}
+
+	for k, v := range activities {
+		if v == (awstypes.Activity{}) {
+			return nil, fmt.Errorf("activity %q does not define an activity type", k)
+		}
+	}
+
+	return activities, nil
+}
+
+func hasActivity(activities map[string]awstypes.Activity, key string) bool {
+	_, ok := activities[key]
+
+	return ok
+}
+
+func flattenJourneyActivities(activities map[string]awstypes.Activity) (string, error) {
+	b, err := json.Marshal(activities)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(tfjson.RemoveEmptyFields(b)), nil
+}
+
+type journeyResourceModel struct {
+	Activities       jsontypes.Normalized                                  `tfsdk:"activities" autoflex:"-"`
+	ApplicationID    types.String                                          `tfsdk:"application_id"`
+	ARN              types.String                                          `tfsdk:"arn"`
+	ID               types.String                                          `tfsdk:"id" autoflex:"-"`
+	JourneyID        types.String                                          `tfsdk:"journey_id"`
+	Name             types.String                                          `tfsdk:"name"`
+	RefreshFrequency types.String                                          `tfsdk:"refresh_frequency"`
+	Schedule         fwtypes.ListNestedObjectValueOf[journeyScheduleModel] `tfsdk:"schedule"`
+	StartActivity    types.String                                          `tfsdk:"start_activity"`
+	State            fwtypes.StringEnum[awstypes.State]                    `tfsdk:"state"`
+}
+
+const (
+	journeyResourceIDPartCount = 2
+)
+
+func (data *journeyResourceModel) InitFromID() error {
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), journeyResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.JourneyID = types.StringValue(parts[1])
+
+	return nil
+}
+
+// journeyARN returns the ARN of the specified journey. JourneyResponse doesn't include it.
+func (r *journeyResource) journeyARN(applicationID, journeyID string) string {
+	return arn.ARN{
+		Partition: r.Meta().Partition,
+		Service:   "mobiletargeting",
+		Region:    r.Meta().Region,
+		AccountID: r.Meta().AccountID,
+		Resource:  fmt.Sprintf("apps/%s/journeys/%s", applicationID, journeyID),
+	}.String()
+}
+
+func (data *journeyResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(intflex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.JourneyID.ValueString()}, journeyResourceIDPartCount, false)))
+}
+
+func (data *journeyResourceModel) expandWriteJourneyRequest(ctx context.Context) (*awstypes.WriteJourneyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &awstypes.WriteJourneyRequest{}
+	diags.Append(fwflex.Expand(ctx, data, apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	activities, err := expandJourneyActivities(data.Activities.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(path.Root("activities"), "Invalid Journey Activities", err.Error())
+
+		return nil, diags
+	}
+
+	apiObject.Activities = activities
+
+	return apiObject, diags
+}
+
+type journeyScheduleModel struct {
+	EndTime   timetypes.RFC3339 `tfsdk:"end_time"`
+	StartTime timetypes.RFC3339 `tfsdk:"start_time"`
+	Timezone  types.String      `tfsdk:"timezone"`
+}
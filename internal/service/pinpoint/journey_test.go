@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointJourney_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+	var v awstypes.JourneyResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName, "PT1H"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "activities"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_pinpoint_app.test", names.AttrApplicationID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "journey_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "start_activity", "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DRAFT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+	var v awstypes.JourneyResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName, "PT1H"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceJourney, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+	var v awstypes.JourneyResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName, "PT1H"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccJourneyConfig_schedule(rName, "PT2H"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_invalidStartActivity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJourneyConfig_startActivity(rName, "missing"),
+				ExpectError: regexache.MustCompile(`activity "missing" is not defined in activities`),
+			},
+		},
+	})
+}
+
+func testAccCheckJourneyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_journey" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["journey_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Journey %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJourneyExists(ctx context.Context, n string, v *awstypes.JourneyResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["journey_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJourneyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccJourneyConfig_basic(rName, waitFor string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "first"
+
+  activities = jsonencode({
+    first = {
+      Description = "first wait"
+      Wait = {
+        WaitTime = {
+          WaitFor = %[2]q
+        }
+        NextActivity = "second"
+      }
+    }
+    second = {
+      Wait = {
+        WaitTime = {
+          WaitFor = "PT1H"
+        }
+      }
+    }
+  })
+}
+`, rName, waitFor))
+}
+
+func testAccJourneyConfig_schedule(rName, waitFor string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "first"
+
+  activities = jsonencode({
+    first = {
+      Description = "first wait"
+      Wait = {
+        WaitTime = {
+          WaitFor = %[2]q
+        }
+        NextActivity = "second"
+      }
+    }
+    second = {
+      Wait = {
+        WaitTime = {
+          WaitFor = "PT1H"
+        }
+      }
+    }
+  })
+
+  schedule {
+    timezone = "UTC"
+  }
+}
+`, rName, waitFor))
+}
+
+func testAccJourneyConfig_startActivity(rName, startActivity string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = %[2]q
+
+  activities = jsonencode({
+    first = {
+      Wait = {
+        WaitTime = {
+          WaitFor = "PT1H"
+        }
+      }
+    }
+  })
+}
+`, rName, startActivity))
+}
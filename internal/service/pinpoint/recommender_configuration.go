@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpoint_recommender_configuration", name="Recommender Configuration")
+func newRecommenderConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recommenderConfigurationResource{}
+
+	return r, nil
+}
+
+type recommenderConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*recommenderConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pinpoint_recommender_configuration"
+}
+
+func (r *recommenderConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAttributes: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"creation_date": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_modified_date": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"recommendation_provider_id_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PINPOINT_ENDPOINT_ID", "PINPOINT_USER_ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recommendation_provider_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"recommendation_provider_uri": schema.StringAttribute{
+				Required: true,
+			},
+			"recommendation_transformer_uri": schema.StringAttribute{
+				Optional: true,
+			},
+			"recommendations_display_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 25),
+				},
+			},
+			"recommendations_per_message": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+		},
+	}
+}
+
+func (r *recommenderConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommenderConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	input := &pinpoint.CreateRecommenderConfigurationInput{
+		CreateRecommenderConfiguration: &awstypes.CreateRecommenderConfigurationShape{},
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input.CreateRecommenderConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateRecommenderConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Pinpoint Recommender Configuration", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.RecommenderConfigurationResponse, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommenderConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommenderConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	output, err := findRecommenderConfigurationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Pinpoint Recommender Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommenderConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new recommenderConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	input := &pinpoint.UpdateRecommenderConfigurationInput{
+		RecommenderId:                  fwflex.StringFromFramework(ctx, new.ID),
+		UpdateRecommenderConfiguration: &awstypes.UpdateRecommenderConfigurationShape{},
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input.UpdateRecommenderConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateRecommenderConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Pinpoint Recommender Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.RecommenderConfigurationResponse, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recommenderConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recommenderConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointClient(ctx)
+
+	_, err := conn.DeleteRecommenderConfiguration(ctx, &pinpoint.DeleteRecommenderConfigurationInput{
+		RecommenderId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Pinpoint Recommender Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findRecommenderConfigurationByID(ctx context.Context, conn *pinpoint.Client, id string) (*awstypes.RecommenderConfigurationResponse, error) {
+	input := &pinpoint.GetRecommenderConfigurationInput{
+		RecommenderId: aws.String(id),
+	}
+
+	output, err := conn.GetRecommenderConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RecommenderConfigurationResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RecommenderConfigurationResponse, nil
+}
+
+type recommenderConfigurationResourceModel struct {
+	Attributes                    fwtypes.MapValueOf[types.String] `tfsdk:"attributes"`
+	CreationDate                  types.String                     `tfsdk:"creation_date"`
+	Description                   types.String                     `tfsdk:"description"`
+	ID                            types.String                     `tfsdk:"id"`
+	LastModifiedDate              types.String                     `tfsdk:"last_modified_date"`
+	Name                          types.String                     `tfsdk:"name"`
+	RecommendationProviderIdType  types.String                     `tfsdk:"recommendation_provider_id_type"`
+	RecommendationProviderRoleArn fwtypes.ARN                      `tfsdk:"recommendation_provider_role_arn"`
+	RecommendationProviderUri     types.String                     `tfsdk:"recommendation_provider_uri"`
+	RecommendationTransformerUri  types.String                     `tfsdk:"recommendation_transformer_uri"`
+	RecommendationsDisplayName    types.String                     `tfsdk:"recommendations_display_name"`
+	RecommendationsPerMessage     types.Int64                      `tfsdk:"recommendations_per_message"`
+}
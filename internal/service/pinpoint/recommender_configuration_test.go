@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// An Amazon Personalize campaign is required and is expensive to train, so one must be provided.
+const envVarRecommenderProviderURI = "PINPOINT_RECOMMENDER_PROVIDER_URI"
+
+func TestAccPinpointRecommenderConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerURI := acctest.SkipIfEnvVarNotSet(t, envVarRecommenderProviderURI)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_recommender_configuration.test"
+	var v awstypes.RecommenderConfigurationResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommenderConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommenderConfigurationConfig_basic(rName, providerURI, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommenderConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "recommendation_provider_id_type", "PINPOINT_ENDPOINT_ID"),
+					resource.TestCheckResourceAttrPair(resourceName, "recommendation_provider_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "recommendation_provider_uri", providerURI),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommenderConfigurationConfig_basic(rName, providerURI, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommenderConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointRecommenderConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerURI := acctest.SkipIfEnvVarNotSet(t, envVarRecommenderProviderURI)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_recommender_configuration.test"
+	var v awstypes.RecommenderConfigurationResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommenderConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommenderConfigurationConfig_basic(rName, providerURI, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommenderConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceRecommenderConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommenderConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_recommender_configuration" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindRecommenderConfigurationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Recommender Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommenderConfigurationExists(ctx context.Context, n string, v *awstypes.RecommenderConfigurationResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindRecommenderConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecommenderConfigurationConfig_basic(rName, providerURI, description string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "pinpoint.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "personalize:DescribeSolution",
+        "personalize:DescribeCampaign",
+        "personalize:GetRecommendations",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_pinpoint_recommender_configuration" "test" {
+  name                             = %[1]q
+  description                      = %[3]q
+  recommendation_provider_role_arn = aws_iam_role.test.arn
+  recommendation_provider_uri      = %[2]q
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, providerURI, description)
+}
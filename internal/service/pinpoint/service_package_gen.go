@@ -20,6 +20,21 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newInAppTemplateResource,
+			Name:    "In-App Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newJourneyResource,
+			Name:    "Journey",
+		},
+		{
+			Factory: newRecommenderConfigurationResource,
+			Name:    "Recommender Configuration",
+		},
 		{
 			Factory: newResourceEmailTemplate,
 			Name:    "Email Template",
@@ -5,7 +5,9 @@ package pricing
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pricing"
@@ -14,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -24,6 +28,11 @@ func dataSourceProduct() *schema.Resource {
 		ReadWithoutTimeout: dataSourceProductRead,
 
 		Schema: map[string]*schema.Schema{
+			"allow_multiple_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"filters": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -34,6 +43,12 @@ func dataSourceProduct() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.FilterTypeTermMatch,
+							ValidateDiagFunc: enum.Validate[types.FilterType](),
+						},
 						names.AttrValue: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -45,6 +60,80 @@ func dataSourceProduct() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAttributes: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"json": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"on_demand": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effective_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"offer_term_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"price_dimensions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_range": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrDescription: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"end_range": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"price_per_unit": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rate_code": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrUnit: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"product_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sku": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"service_code": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -67,25 +156,147 @@ func dataSourceProductRead(ctx context.Context, d *schema.ResourceData, meta int
 		m := v.(map[string]interface{})
 		input.Filters = append(input.Filters, types.Filter{
 			Field: aws.String(m[names.AttrField].(string)),
-			Type:  types.FilterTypeTermMatch,
+			Type:  types.FilterType(m[names.AttrType].(string)),
 			Value: aws.String(m[names.AttrValue].(string)),
 		})
 	}
 
-	output, err := conn.GetProducts(ctx, input)
+	priceList, err := findProducts(ctx, conn, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Pricing Products: %s", err)
 	}
 
-	if numberOfElements := len(output.PriceList); numberOfElements == 0 {
+	allowMultipleResults := d.Get("allow_multiple_results").(bool)
+
+	if numberOfElements := len(priceList); numberOfElements == 0 {
 		return sdkdiag.AppendErrorf(diags, "Pricing product query did not return any elements")
-	} else if numberOfElements > 1 {
+	} else if numberOfElements > 1 && !allowMultipleResults {
 		return sdkdiag.AppendErrorf(diags, "Pricing product query not precise enough. Returned %d elements", numberOfElements)
 	}
 
+	results := make([]interface{}, 0, len(priceList))
+	for _, v := range priceList {
+		tfMap, err := flattenPriceListItem(v)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing Pricing product: %s", err)
+		}
+
+		results = append(results, tfMap)
+	}
+
 	d.SetId(fmt.Sprintf("%d", create.StringHashcode(fmt.Sprintf("%#v", input))))
-	d.Set("result", output.PriceList[0])
+	if len(priceList) == 1 {
+		d.Set("result", priceList[0])
+	} else {
+		d.Set("result", "")
+	}
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
 
 	return diags
 }
+
+func findProducts(ctx context.Context, conn *pricing.Client, input *pricing.GetProductsInput) ([]string, error) {
+	var output []string
+
+	pages := pricing.NewGetProductsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PriceList...)
+	}
+
+	return output, nil
+}
+
+// priceListItem is the subset of a Price List API product document that is surfaced as attributes.
+type priceListItem struct {
+	Product struct {
+		Attributes    map[string]string `json:"attributes"`
+		ProductFamily string            `json:"productFamily"`
+		SKU           string            `json:"sku"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]priceListTerm `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+type priceListTerm struct {
+	EffectiveDate   string                             `json:"effectiveDate"`
+	OfferTermCode   string                             `json:"offerTermCode"`
+	PriceDimensions map[string]priceListPriceDimension `json:"priceDimensions"`
+}
+
+type priceListPriceDimension struct {
+	BeginRange   string            `json:"beginRange"`
+	Description  string            `json:"description"`
+	EndRange     string            `json:"endRange"`
+	PricePerUnit map[string]string `json:"pricePerUnit"`
+	RateCode     string            `json:"rateCode"`
+	Unit         string            `json:"unit"`
+}
+
+func flattenPriceListItem(v string) (map[string]interface{}, error) {
+	var item priceListItem
+
+	if err := json.Unmarshal([]byte(v), &item); err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrAttributes: item.Product.Attributes,
+		"json":               v,
+		"on_demand":          flattenPriceListTerms(item.Terms.OnDemand),
+		"product_family":     item.Product.ProductFamily,
+		"sku":                item.Product.SKU,
+	}
+
+	return tfMap, nil
+}
+
+func flattenPriceListTerms(apiObjects map[string]priceListTerm) []interface{} {
+	// Sort by key so that the ordering is stable between reads.
+	keys := tfmaps.Keys(apiObjects)
+	slices.Sort(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		apiObject := apiObjects[k]
+
+		tfList = append(tfList, map[string]interface{}{
+			"effective_date":   apiObject.EffectiveDate,
+			"offer_term_code":  apiObject.OfferTermCode,
+			"price_dimensions": flattenPriceListPriceDimensions(apiObject.PriceDimensions),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPriceListPriceDimensions(apiObjects map[string]priceListPriceDimension) []interface{} {
+	keys := tfmaps.Keys(apiObjects)
+	slices.Sort(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		apiObject := apiObjects[k]
+
+		tfList = append(tfList, map[string]interface{}{
+			"begin_range":         apiObject.BeginRange,
+			names.AttrDescription: apiObject.Description,
+			"end_range":           apiObject.EndRange,
+			"price_per_unit":      apiObject.PricePerUnit,
+			"rate_code":           apiObject.RateCode,
+			names.AttrUnit:        apiObject.Unit,
+		})
+	}
+
+	return tfList
+}
@@ -4,8 +4,10 @@
 package pricing_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -27,6 +29,43 @@ func TestAccPricingProductDataSource_ec2(t *testing.T) {
 				Config: testAccProductDataSourceConfig_ec2,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					acctest.CheckResourceAttrIsJSONString(dataSourceName, "result"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.sku"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.product_family", "Compute Instance"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.attributes.operatingSystem", "Linux"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "results.0.json", dataSourceName, "result"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.on_demand.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.on_demand.0.price_dimensions.0.price_per_unit.USD"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.on_demand.0.price_dimensions.0.unit", "Hrs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPricingProductDataSource_multipleResults(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_pricing_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.APSouth1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PricingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProductDataSourceConfig_multipleResults(false),
+				ExpectError: regexache.MustCompile(`Pricing product query not precise enough`),
+			},
+			{
+				Config: testAccProductDataSourceConfig_multipleResults(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", ""),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "results.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.sku"),
+					acctest.CheckResourceAttrIsJSONString(dataSourceName, "results.0.json"),
 				),
 			},
 		},
@@ -128,3 +167,50 @@ data "aws_pricing_product" "test" {
   }
 }
 `
+
+func testAccProductDataSourceConfig_multipleResults(allowMultipleResults bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_pricing_product" "test" {
+  service_code           = "AmazonEC2"
+  allow_multiple_results = %[1]t
+
+  filters {
+    field = "instanceFamily"
+    value = "Compute optimized"
+  }
+
+  filters {
+    field = "operatingSystem"
+    value = "Linux"
+  }
+
+  filters {
+    field = "location"
+    value = data.aws_region.current.description
+  }
+
+  filters {
+    field = "preInstalledSw"
+    type  = "TERM_MATCH"
+    value = "NA"
+  }
+
+  filters {
+    field = "licenseModel"
+    value = "No License required"
+  }
+
+  filters {
+    field = "tenancy"
+    value = "Shared"
+  }
+
+  filters {
+    field = "capacitystatus"
+    value = "Used"
+  }
+}
+`, allowMultipleResults)
+}
@@ -313,6 +313,38 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"multi_az_secondary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"node_role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"private_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"public_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -711,6 +743,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	} else {
 		d.Set("multi_az", v)
 	}
+	if err := d.Set("multi_az_secondary", flattenSecondaryClusterInfo(rsc.MultiAZSecondary)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting multi_az_secondary: %s", err)
+	}
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set(names.AttrPreferredMaintenanceWindow, rsc.PreferredMaintenanceWindow)
@@ -960,14 +995,18 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			MultiAZ:           aws.Bool(multiAZ),
 		}
 
-		_, err := conn.ModifyCluster(ctx, input)
+		// The cluster may still be resizing or recovering from an earlier modification.
+		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidClusterStateFault](ctx, clusterInvalidClusterStateFaultTimeout,
+			func() (interface{}, error) {
+				return conn.ModifyCluster(ctx, input)
+			})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "modifying Redshift Cluster (%s) multi-AZ: %s", d.Id(), err)
 		}
 
-		if _, err = waitClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", d.Id(), err)
+		if _, err = waitClusterMultiAZUpdated(ctx, conn, d.Id(), multiAZ, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) multi-AZ update: %s", d.Id(), err)
 		}
 
 		if !multiAZ {
@@ -1175,6 +1214,22 @@ func flattenClusterNodes(apiObjects []awstypes.ClusterNode) []interface{} {
 	return tfList
 }
 
+func flattenSecondaryClusterInfo(apiObject *awstypes.SecondaryClusterInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cluster_nodes": flattenClusterNodes(apiObject.ClusterNodes),
+	}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap[names.AttrAvailabilityZone] = aws.ToString(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func clusterAvailabilityZoneRelocationStatus(cluster *awstypes.Cluster) (bool, error) {
 	// AvailabilityZoneRelocation is not returned by the API, and AvailabilityZoneRelocationStatus is not implemented as Const at this time.
 	switch availabilityZoneRelocationStatus := aws.ToString(cluster.AvailabilityZoneRelocationStatus); availabilityZoneRelocationStatus {
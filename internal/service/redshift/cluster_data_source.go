@@ -160,6 +160,38 @@ func dataSourceCluster() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"multi_az_secondary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"node_role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"private_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"public_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -278,6 +310,9 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	} else {
 		d.Set("multi_az", v)
 	}
+	if err := d.Set("multi_az_secondary", flattenSecondaryClusterInfo(rsc.MultiAZSecondary)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting multi_az_secondary: %s", err)
+	}
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set(names.AttrPreferredMaintenanceWindow, rsc.PreferredMaintenanceWindow)
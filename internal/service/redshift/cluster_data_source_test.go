@@ -143,6 +143,8 @@ func TestAccRedshiftClusterDataSource_multiAZEnabled(t *testing.T) {
 				Config: testAccClusterDataSourceConfig_multiAZEnabled(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az_secondary.#", resourceName, "multi_az_secondary.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az_secondary.0.availability_zone", resourceName, "multi_az_secondary.0.availability_zone"),
 				),
 			},
 		},
@@ -50,6 +50,7 @@ func TestAccRedshiftCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "maintenance_track_name", "current"),
 					resource.TestCheckResourceAttr(resourceName, "manual_snapshot_retention_period", "-1"),
 					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "iam_roles.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", acctest.Ct0),
 				),
@@ -892,6 +893,9 @@ func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_az_secondary.0.availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.0.cluster_nodes.#", acctest.Ct2),
 				),
 			},
 			{
@@ -910,6 +914,41 @@ func TestAccRedshiftCluster_multiAZ(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftCluster_multiAZConvert(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_multiAZ(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccClusterConfig_multiAZ(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "multi_az_secondary.0.cluster_nodes.#", acctest.Ct2),
 				),
 			},
 		},
@@ -20,9 +20,11 @@ const (
 // https://docs.aws.amazon.com/redshift/latest/mgmt/working-with-clusters.html#rs-mgmt-cluster-status.
 
 const (
-	clusterStatusAvailable = "available"
-	clusterStatusModifying = "modifying"
-	clusterStatusRebooting = "rebooting"
+	clusterStatusAvailable  = "available"
+	clusterStatusModifying  = "modifying"
+	clusterStatusRebooting  = "rebooting"
+	clusterStatusRecovering = "recovering"
+	clusterStatusResizing   = "resizing"
 )
 
 const (
@@ -5,6 +5,7 @@ package redshift
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
@@ -60,6 +61,33 @@ func statusCluster(ctx context.Context, conn *redshift.Client, id string) retry.
 	}
 }
 
+// statusClusterMultiAZ returns the cluster's Multi-AZ state once the cluster is available, otherwise the cluster status.
+func statusClusterMultiAZ(ctx context.Context, conn *redshift.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.ToString(output.ClusterStatus); status != clusterStatusAvailable {
+			return output, status, nil
+		}
+
+		multiAZ, err := clusterMultiAZStatus(output)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(multiAZ), nil
+	}
+}
+
 func statusClusterAqua(ctx context.Context, conn *redshift.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findClusterByID(ctx, conn, id)
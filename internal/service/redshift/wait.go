@@ -6,6 +6,7 @@ package redshift
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -80,6 +81,28 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Client, id string, t
 	return nil, err
 }
 
+// waitClusterMultiAZUpdated waits for a Multi-AZ conversion to complete.
+// Converting a cluster to or from Multi-AZ may resize the cluster and recover its secondary compute nodes.
+func waitClusterMultiAZUpdated(ctx context.Context, conn *redshift.Client, id string, multiAZ bool, timeout time.Duration) (*awstypes.Cluster, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{clusterStatusModifying, clusterStatusRebooting, clusterStatusRecovering, clusterStatusResizing, strconv.FormatBool(!multiAZ)},
+		Target:     []string{strconv.FormatBool(multiAZ)},
+		Refresh:    statusClusterMultiAZ(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Client, id string) (*awstypes.Cluster, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: clusterAvailabilityZoneRelocationStatus_PendingValues(),
@@ -5,19 +5,21 @@ package redshiftserverless
 
 // Exports for use in tests only.
 var (
-	ResourceCustomDomainAssociation = newCustomDomainAssociationResource
-	ResourceEndpointAccess          = resourceEndpointAccess
-	ResourceNamespace               = resourceNamespace
-	ResourceResourcePolicy          = resourceResourcePolicy
-	ResourceSnapshot                = resourceSnapshot
-	ResourceUsageLimit              = resourceUsageLimit
-	ResourceWorkgroup               = resourceWorkgroup
+	ResourceCustomDomainAssociation   = newCustomDomainAssociationResource
+	ResourceEndpointAccess            = resourceEndpointAccess
+	ResourceNamespace                 = resourceNamespace
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceSnapshot                  = resourceSnapshot
+	ResourceSnapshotCopyConfiguration = newSnapshotCopyConfigurationResource
+	ResourceUsageLimit                = resourceUsageLimit
+	ResourceWorkgroup                 = resourceWorkgroup
 
-	FindCustomDomainAssociationByTwoPartKey = findCustomDomainAssociationByTwoPartKey
-	FindEndpointAccessByName                = findEndpointAccessByName
-	FindNamespaceByName                     = findNamespaceByName
-	FindResourcePolicyByARN                 = findResourcePolicyByARN
-	FindSnapshotByName                      = findSnapshotByName
-	FindUsageLimitByName                    = findUsageLimitByName
-	FindWorkgroupByName                     = findWorkgroupByName
+	FindCustomDomainAssociationByTwoPartKey   = findCustomDomainAssociationByTwoPartKey
+	FindEndpointAccessByName                  = findEndpointAccessByName
+	FindNamespaceByName                       = findNamespaceByName
+	FindResourcePolicyByARN                   = findResourcePolicyByARN
+	FindSnapshotByName                        = findSnapshotByName
+	FindSnapshotCopyConfigurationByTwoPartKey = findSnapshotCopyConfigurationByTwoPartKey
+	FindUsageLimitByName                      = findUsageLimitByName
+	FindWorkgroupByName                       = findWorkgroupByName
 )
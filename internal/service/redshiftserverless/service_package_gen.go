@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newSnapshotCopyConfigurationsDataSource,
+			Name:    "Snapshot Copy Configurations",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -24,6 +29,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newCustomDomainAssociationResource,
 			Name:    "Custom Domain Association",
 		},
+		{
+			Factory: newSnapshotCopyConfigurationResource,
+			Name:    "Snapshot Copy Configuration",
+		},
 	}
 }
 
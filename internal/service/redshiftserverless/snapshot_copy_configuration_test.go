@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessSnapshotCopyConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SnapshotCopyConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_copy_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_retention_period"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessSnapshotCopyConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SnapshotCopyConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshiftserverless.ResourceSnapshotCopyConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessSnapshotCopyConfiguration_retentionPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SnapshotCopyConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_retentionPeriod(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSnapshotCopyConfigurationConfig_retentionPeriod(rName, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotCopyConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshiftserverless_snapshot_copy_configuration" {
+				continue
+			}
+
+			_, err := tfredshiftserverless.FindSnapshotCopyConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["namespace_name"], rs.Primary.Attributes["snapshot_copy_configuration_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Serverless Snapshot Copy Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotCopyConfigurationExists(ctx context.Context, n string, v *awstypes.SnapshotCopyConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		output, err := tfredshiftserverless.FindSnapshotCopyConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["namespace_name"], rs.Primary.Attributes["snapshot_copy_configuration_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSnapshotCopyConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_snapshot_copy_configuration" "test" {
+  namespace_name     = aws_redshiftserverless_namespace.test.namespace_name
+  destination_region = %[1]q
+}
+`, acctest.AlternateRegion()))
+}
+
+func testAccSnapshotCopyConfigurationConfig_retentionPeriod(rName string, retentionPeriod int) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_snapshot_copy_configuration" "test" {
+  namespace_name            = aws_redshiftserverless_namespace.test.namespace_name
+  destination_region        = %[1]q
+  snapshot_retention_period = %[2]d
+}
+`, acctest.AlternateRegion(), retentionPeriod))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Snapshot Copy Configurations")
+func newSnapshotCopyConfigurationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &snapshotCopyConfigurationsDataSource{}, nil
+}
+
+type snapshotCopyConfigurationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*snapshotCopyConfigurationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_redshiftserverless_snapshot_copy_configurations"
+}
+
+func (d *snapshotCopyConfigurationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+			},
+			"snapshot_copy_configurations": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[snapshotCopyConfigurationModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrARN:                    types.StringType,
+						"destination_kms_key_id":         types.StringType,
+						"destination_region":             types.StringType,
+						"namespace_name":                 types.StringType,
+						"snapshot_copy_configuration_id": types.StringType,
+						"snapshot_retention_period":      types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *snapshotCopyConfigurationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data snapshotCopyConfigurationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RedshiftServerlessClient(ctx)
+
+	namespaceName := data.NamespaceName.ValueString()
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{
+		NamespaceName: fwflex.StringFromFramework(ctx, data.NamespaceName),
+	}
+
+	output, err := findSnapshotCopyConfigurations(ctx, conn, input, tfslices.PredicateTrue[*awstypes.SnapshotCopyConfiguration]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Redshift Serverless Snapshot Copy Configurations (%s)", namespaceName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SnapshotCopyConfigurations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(namespaceName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type snapshotCopyConfigurationsDataSourceModel struct {
+	ID                         types.String                                                    `tfsdk:"id"`
+	NamespaceName              types.String                                                    `tfsdk:"namespace_name"`
+	SnapshotCopyConfigurations fwtypes.ListNestedObjectValueOf[snapshotCopyConfigurationModel] `tfsdk:"snapshot_copy_configurations"`
+}
+
+type snapshotCopyConfigurationModel struct {
+	DestinationKMSKeyID          types.String `tfsdk:"destination_kms_key_id"`
+	DestinationRegion            types.String `tfsdk:"destination_region"`
+	NamespaceName                types.String `tfsdk:"namespace_name"`
+	SnapshotCopyConfigurationARN types.String `tfsdk:"arn"`
+	SnapshotCopyConfigurationID  types.String `tfsdk:"snapshot_copy_configuration_id"`
+	SnapshotRetentionPeriod      types.Int64  `tfsdk:"snapshot_retention_period"`
+}
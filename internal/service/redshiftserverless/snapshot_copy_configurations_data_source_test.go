@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessSnapshotCopyConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_redshiftserverless_snapshot_copy_configurations.test"
+	resourceName := "aws_redshiftserverless_snapshot_copy_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfigurationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_copy_configurations.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_configurations.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_configurations.0.destination_region", resourceName, "destination_region"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_configurations.0.namespace_name", resourceName, "namespace_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_configurations.0.snapshot_copy_configuration_id", resourceName, "snapshot_copy_configuration_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_configurations.0.snapshot_retention_period", resourceName, "snapshot_retention_period"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSnapshotCopyConfigurationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyConfigurationConfig_basic(rName), `
+data "aws_redshiftserverless_snapshot_copy_configurations" "test" {
+  namespace_name = aws_redshiftserverless_snapshot_copy_configuration.test.namespace_name
+}
+`)
+}
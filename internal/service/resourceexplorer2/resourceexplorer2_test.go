@@ -13,6 +13,10 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultViewAssociation": {
+			acctest.CtBasic:      testAccDefaultViewAssociation_basic,
+			acctest.CtDisappears: testAccDefaultViewAssociation_disappears,
+		},
 		"Index": {
 			acctest.CtBasic:      testAccIndex_basic,
 			acctest.CtDisappears: testAccIndex_disappears,
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceDefaultViewAssociation,
+			Name:    "Default View Association",
+		},
 		{
 			Factory: newResourceIndex,
 			Name:    "Index",
@@ -157,12 +157,12 @@ func resourceBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
-func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string) (string, error) {
+func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (string, error) {
 	input := &s3.GetBucketPolicyInput{
 		Bucket: aws.String(bucket),
 	}
 
-	output, err := conn.GetBucketPolicy(ctx, input)
+	output, err := conn.GetBucketPolicy(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchBucketPolicy) {
 		return "", &retry.NotFoundError{
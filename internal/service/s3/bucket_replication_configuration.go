@@ -5,9 +5,18 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -17,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -36,7 +46,28 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"bidirectional": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRole: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrBucket: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -308,6 +339,10 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"validate_prerequisites": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -325,6 +360,16 @@ func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema
 		},
 	}
 
+	var pairedInput *s3.PutBucketReplicationInput
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 {
+		var err error
+		pairedInput, err = expandPairedReplicationInput(ctx, meta.(*conns.AWSClient), bucket, input.ReplicationConfiguration, v.([]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Replication Configuration: %s", bucket, err)
+		}
+	}
+
 	if v, ok := d.GetOk("token"); ok {
 		input.Token = aws.String(v.(string))
 	}
@@ -365,6 +410,21 @@ func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Replication Configuration (%s) create: %s", d.Id(), err)
 	}
 
+	if pairedInput != nil {
+		if err := putPairedReplicationConfiguration(ctx, meta.(*conns.AWSClient), pairedInput, bucket); err != nil {
+			// Roll back the source bucket's configuration so that both buckets are configured, or neither is.
+			if _, err := conn.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
+				Bucket: aws.String(bucket),
+			}); err != nil {
+				log.Printf("[WARN] Deleting S3 Bucket Replication Configuration (%s): %s", bucket, err)
+			}
+
+			d.SetId("")
+
+			return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) paired Replication Configuration: %s", aws.ToString(pairedInput.Bucket), err)
+		}
+	}
+
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -390,6 +450,32 @@ func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.R
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 {
+		pairedBucket, err := replicationDestinationBucket(rc.Rules)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
+		}
+
+		paired, err := findPairedReplicationConfiguration(ctx, meta.(*conns.AWSClient), pairedBucket)
+
+		switch {
+		case tfresource.NotFound(err):
+			// The paired configuration has been removed outside of Terraform; plan to re-create it.
+			log.Printf("[WARN] S3 Bucket (%s) paired Replication Configuration not found", pairedBucket)
+			d.Set("bidirectional", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) paired Replication Configuration: %s", pairedBucket, err)
+		default:
+			if err := d.Set("bidirectional", []interface{}{map[string]interface{}{
+				"destination_bucket": pairedBucket,
+				names.AttrRole:       aws.ToString(paired.Role),
+			}}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting bidirectional: %s", err)
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -409,10 +495,38 @@ func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema
 		input.Token = aws.String(v.(string))
 	}
 
-	_, err := conn.PutBucketReplication(ctx, input)
+	var pairedInput *s3.PutBucketReplicationInput
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 {
+		var err error
+		pairedInput, err = expandPairedReplicationInput(ctx, meta.(*conns.AWSClient), d.Id(), input.ReplicationConfiguration, v.([]interface{}))
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("bidirectional", "validate_prerequisites") {
+		_, err := conn.PutBucketReplication(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Replication Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if pairedInput != nil {
+		if err := putPairedReplicationConfiguration(ctx, meta.(*conns.AWSClient), pairedInput, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Bucket (%s) paired Replication Configuration: %s", aws.ToString(pairedInput.Bucket), err)
+		}
+	} else if d.HasChange("bidirectional") {
+		o, _ := d.GetChange("bidirectional")
+
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			if pairedBucket := v[0].(map[string]interface{})["destination_bucket"].(string); pairedBucket != "" {
+				if err := deletePairedReplicationConfiguration(ctx, meta.(*conns.AWSClient), pairedBucket, d.Id()); err != nil {
+					return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) paired Replication Configuration: %s", pairedBucket, err)
+				}
+			}
+		}
 	}
 
 	return append(diags, resourceBucketReplicationConfigurationRead(ctx, d, meta)...)
@@ -422,6 +536,14 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if pairedBucket := v.([]interface{})[0].(map[string]interface{})["destination_bucket"].(string); pairedBucket != "" {
+			if err := deletePairedReplicationConfiguration(ctx, meta.(*conns.AWSClient), pairedBucket, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) paired Replication Configuration: %s", pairedBucket, err)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting S3 Bucket Replication Configuration: %s", d.Id())
 	_, err := conn.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
 		Bucket: aws.String(d.Id()),
@@ -446,12 +568,12 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	return diags
 }
 
-func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
+func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (*types.ReplicationConfiguration, error) {
 	input := &s3.GetBucketReplicationInput{
 		Bucket: aws.String(bucket),
 	}
 
-	output, err := conn.GetBucketReplication(ctx, input)
+	output, err := conn.GetBucketReplication(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeReplicationConfigurationNotFound) {
 		return nil, &retry.NotFoundError{
@@ -471,6 +593,534 @@ func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket s
 	return output.ReplicationConfiguration, nil
 }
 
+func resourceBucketReplicationConfigurationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap[names.AttrDestination].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+		destination := v[0].(map[string]interface{})
+
+		metricsEnabled := false
+		if v, ok := destination["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			metricsEnabled = v[0].(map[string]interface{})[names.AttrStatus].(string) == string(types.MetricsStatusEnabled)
+		}
+
+		if metricsEnabled {
+			if v, ok := tfMap[names.AttrFilter].([]interface{}); !ok || len(v) == 0 {
+				return fmt.Errorf("rule.%d.destination.metrics with status %s requires rule.%[1]d.filter to be configured", i, types.MetricsStatusEnabled)
+			}
+		}
+
+		if v, ok := destination["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v[0].(map[string]interface{})[names.AttrStatus].(string) == string(types.ReplicationTimeStatusEnabled) && !metricsEnabled {
+				return fmt.Errorf("rule.%d.destination.metrics must be enabled when rule.%[1]d.destination.replication_time is enabled", i)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 {
+		if !d.NewValueKnown(names.AttrRule) {
+			return nil
+		}
+
+		if _, err := replicationDestinationBucket(expandReplicationRules(ctx, d.Get(names.AttrRule).([]interface{}))); err != nil {
+			return err
+		}
+	}
+
+	if !d.Get("validate_prerequisites").(bool) || !d.HasChanges(names.AttrBucket, names.AttrRole, names.AttrRule, "bidirectional", "validate_prerequisites") {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrBucket) || !d.NewValueKnown(names.AttrRole) || !d.NewValueKnown(names.AttrRule) || !d.NewValueKnown("bidirectional") {
+		return nil
+	}
+
+	// In bidirectional mode, objects are also replicated from the destination bucket back to the source bucket.
+	var pairedRole string
+	if v, ok := d.GetOk("bidirectional"); ok && len(v.([]interface{})) > 0 {
+		pairedRole = d.Get(names.AttrRole).(string)
+		if v := d.Get("bidirectional.0.role").(string); v != "" {
+			pairedRole = v
+		}
+	}
+
+	return validateReplicationPrerequisites(ctx, meta.(*conns.AWSClient), d.Get(names.AttrBucket).(string), d.Get(names.AttrRole).(string), pairedRole, expandReplicationRules(ctx, d.Get(names.AttrRule).([]interface{})))
+}
+
+// validateReplicationPrerequisites verifies that versioning is enabled on the source and destination buckets and
+// that no destination bucket policy explicitly denies replication by the specified role.
+// If pairedRole is set, it also verifies that the source bucket policy doesn't deny replication back to the source bucket.
+// It is called during planning, so roles and buckets that do not yet exist, or whose configuration cannot be read, are skipped.
+func validateReplicationPrerequisites(ctx context.Context, awsClient *conns.AWSClient, bucket, role, pairedRole string, rules []types.ReplicationRule) error {
+	if err := validateReplicationRole(ctx, awsClient, role); err != nil {
+		// The role may be created in the same apply.
+		if !errs.IsA[*iamtypes.NoSuchEntityException](err) {
+			return err
+		}
+
+		log.Printf("[WARN] %s", err)
+	}
+
+	if err := validateReplicationBucketVersioning(ctx, awsClient, bucket); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if rule.Destination == nil {
+			continue
+		}
+
+		if v := aws.ToString(rule.Destination.Account); v != "" && v != awsClient.AccountID {
+			// Cross-account destination buckets cannot be inspected.
+			continue
+		}
+
+		destinationBucket, err := bucketNameFromARN(aws.ToString(rule.Destination.Bucket))
+
+		if err != nil {
+			return err
+		}
+
+		if err := validateReplicationBucketVersioning(ctx, awsClient, destinationBucket); err != nil {
+			return err
+		}
+
+		if err := validateReplicationBucketPolicy(ctx, awsClient, destinationBucket, role); err != nil {
+			return err
+		}
+	}
+
+	if pairedRole != "" {
+		if err := validateReplicationBucketPolicy(ctx, awsClient, bucket, pairedRole); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateReplicationBucketPolicy verifies that the bucket policy of the specified replication destination bucket
+// doesn't explicitly deny replication by the specified role.
+func validateReplicationBucketPolicy(ctx context.Context, awsClient *conns.AWSClient, bucket, role string) error {
+	optFns, err := bucketRegionOptFns(ctx, awsClient, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to determine S3 Bucket (%s) region: %s", bucket, err)
+		return nil
+	}
+
+	policy, err := findBucketPolicy(ctx, awsClient.S3Client(ctx), bucket, optFns...)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) policy: %s", bucket, err)
+		return nil
+	}
+
+	denied, err := bucketPolicyDeniesReplication(policy, role)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to parse S3 Bucket (%s) policy: %s", bucket, err)
+		return nil
+	}
+
+	if denied {
+		return fmt.Errorf("S3 Bucket (%s) policy denies replication by role (%s)", bucket, role)
+	}
+
+	return nil
+}
+
+func validateReplicationBucketVersioning(ctx context.Context, awsClient *conns.AWSClient, bucket string) error {
+	optFns, err := bucketRegionOptFns(ctx, awsClient, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to determine S3 Bucket (%s) region: %s", bucket, err)
+		return nil
+	}
+
+	output, err := awsClient.S3Client(ctx).GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	}, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) versioning: %s", bucket, err)
+		return nil
+	}
+
+	if output.Status != types.BucketVersioningStatusEnabled {
+		return fmt.Errorf("versioning must be enabled on S3 Bucket (%s) for replication", bucket)
+	}
+
+	return nil
+}
+
+// validateReplicationRole verifies that the specified IAM role exists and can be assumed by Amazon S3.
+func validateReplicationRole(ctx context.Context, awsClient *conns.AWSClient, roleARN string) error {
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return err
+	}
+
+	name := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+	output, err := awsClient.IAMClient(ctx).GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(name),
+	})
+
+	if errs.IsA[*iamtypes.NoSuchEntityException](err) {
+		return fmt.Errorf("IAM Role (%s) not found: %w", roleARN, err)
+	}
+
+	if err != nil {
+		// The caller may not have permission to read the role.
+		log.Printf("[WARN] Unable to read IAM Role (%s): %s", roleARN, err)
+		return nil
+	}
+
+	document, err := url.QueryUnescape(aws.ToString(output.Role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return fmt.Errorf("decoding IAM Role (%s) trust policy: %w", roleARN, err)
+	}
+
+	if !strings.Contains(document, "s3.amazonaws.com") {
+		return fmt.Errorf("IAM Role (%s) trust policy does not allow Amazon S3 (s3.amazonaws.com) to assume the role", roleARN)
+	}
+
+	return nil
+}
+
+// bucketPolicyDeniesReplication returns whether the bucket policy contains an unconditional explicit deny
+// of object replication for the specified principal.
+func bucketPolicyDeniesReplication(policy, principal string) (bool, error) {
+	var document struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+
+	type statement struct {
+		Action    any    `json:"Action"`
+		Condition any    `json:"Condition"`
+		Effect    string `json:"Effect"`
+		Principal any    `json:"Principal"`
+	}
+
+	var statements []statement
+	if err := json.Unmarshal(document.Statement, &statements); err != nil {
+		var v statement
+		if err := json.Unmarshal(document.Statement, &v); err != nil {
+			return false, err
+		}
+		statements = []statement{v}
+	}
+
+	for _, v := range statements {
+		if v.Effect != "Deny" || v.Condition != nil {
+			continue
+		}
+
+		principalMatches := false
+		switch p := v.Principal.(type) {
+		case string:
+			principalMatches = p == "*"
+		case map[string]any:
+			principalMatches = slices.ContainsFunc(stringOrSlice(p["AWS"]), func(s string) bool {
+				return s == "*" || s == principal
+			})
+		}
+
+		if !principalMatches {
+			continue
+		}
+
+		if slices.ContainsFunc(stringOrSlice(v.Action), func(s string) bool {
+			switch strings.ToLower(s) {
+			case "*", "s3:*", "s3:replicate*", "s3:replicateobject":
+				return true
+			}
+			return false
+		}) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func stringOrSlice(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
+
+func bucketNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.Resource, nil
+}
+
+// replicationDestinationBucket returns the name of the single destination bucket of the specified rules.
+func replicationDestinationBucket(rules []types.ReplicationRule) (string, error) {
+	var bucket string
+
+	for _, rule := range rules {
+		if rule.Destination == nil {
+			continue
+		}
+
+		v := aws.ToString(rule.Destination.Bucket)
+
+		if bucket != "" && v != bucket {
+			return "", errors.New("bidirectional replication requires all rules to have the same destination bucket")
+		}
+
+		bucket = v
+	}
+
+	if bucket == "" {
+		return "", errors.New("bidirectional replication requires a destination bucket")
+	}
+
+	return bucketNameFromARN(bucket)
+}
+
+func bucketRegionOptFns(ctx context.Context, awsClient *conns.AWSClient, bucket string) ([]func(*s3.Options), error) {
+	region, err := findBucketRegion(ctx, awsClient, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []func(*s3.Options){
+		func(o *s3.Options) {
+			o.Region = region
+		},
+	}, nil
+}
+
+// expandPairedReplicationInput returns the replication configuration for the destination bucket that mirrors
+// the source bucket's configuration, replicating objects and replica modifications back to the source bucket.
+func expandPairedReplicationInput(ctx context.Context, awsClient *conns.AWSClient, bucket string, config *types.ReplicationConfiguration, tfList []interface{}) (*s3.PutBucketReplicationInput, error) {
+	pairedBucket, err := replicationDestinationBucket(config.Rules)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role := aws.ToString(config.Role)
+	if tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})[names.AttrRole].(string); ok && v != "" {
+			role = v
+		}
+	}
+
+	if err := validateReplicationRole(ctx, awsClient, role); err != nil {
+		return nil, err
+	}
+
+	bucketARN := arn.ARN{
+		Partition: awsClient.Partition,
+		Service:   "s3",
+		Resource:  bucket,
+	}.String()
+
+	var rules []types.ReplicationRule
+	for _, rule := range config.Rules {
+		if rule.Destination == nil {
+			continue
+		}
+
+		if v := aws.ToString(rule.Destination.Account); v != "" && v != awsClient.AccountID {
+			return nil, fmt.Errorf("bidirectional replication is not supported for cross-account destination (%s)", v)
+		}
+
+		rules = append(rules, types.ReplicationRule{
+			DeleteMarkerReplication: rule.DeleteMarkerReplication,
+			Destination: &types.Destination{
+				Bucket:          aws.String(bucketARN),
+				Metrics:         rule.Destination.Metrics,
+				ReplicationTime: rule.Destination.ReplicationTime,
+				StorageClass:    rule.Destination.StorageClass,
+			},
+			Filter:   rule.Filter,
+			ID:       rule.ID,
+			Prefix:   rule.Prefix,
+			Priority: rule.Priority,
+			SourceSelectionCriteria: &types.SourceSelectionCriteria{
+				ReplicaModifications: &types.ReplicaModifications{
+					Status: types.ReplicaModificationsStatusEnabled,
+				},
+			},
+			Status: rule.Status,
+		})
+	}
+
+	return &s3.PutBucketReplicationInput{
+		Bucket: aws.String(pairedBucket),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Role:  aws.String(role),
+			Rules: rules,
+		},
+	}, nil
+}
+
+// putPairedReplicationConfiguration writes the replication configuration of the destination bucket.
+// It fails if the destination bucket has a replication configuration with rules that don't replicate to the source bucket,
+// as they weren't created by this resource and would be overwritten.
+func putPairedReplicationConfiguration(ctx context.Context, awsClient *conns.AWSClient, input *s3.PutBucketReplicationInput, sourceBucket string) error {
+	bucket := aws.ToString(input.Bucket)
+	optFns, err := bucketRegionOptFns(ctx, awsClient, bucket)
+
+	if err != nil {
+		return err
+	}
+
+	conn := awsClient.S3Client(ctx)
+	existing, err := findReplicationConfiguration(ctx, conn, bucket, optFns...)
+
+	switch {
+	case tfresource.NotFound(err):
+		// No existing configuration.
+	case err != nil:
+		return fmt.Errorf("reading S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	default:
+		if slices.ContainsFunc(existing.Rules, func(rule types.ReplicationRule) bool {
+			return !isPairedReplicationRule(rule, sourceBucket)
+		}) {
+			return fmt.Errorf("S3 Bucket (%s) has an existing Replication Configuration with rules that don't replicate to S3 Bucket (%s)", bucket, sourceBucket)
+		}
+	}
+
+	_, err = conn.PutBucketReplication(ctx, input, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findReplicationConfiguration(ctx, conn, bucket, optFns...)
+	})
+
+	return err
+}
+
+func findPairedReplicationConfiguration(ctx context.Context, awsClient *conns.AWSClient, bucket string) (*types.ReplicationConfiguration, error) {
+	optFns, err := bucketRegionOptFns(ctx, awsClient, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findReplicationConfiguration(ctx, awsClient.S3Client(ctx), bucket, optFns...)
+}
+
+// deletePairedReplicationConfiguration removes the rules that replicate to the source bucket from the destination bucket's
+// replication configuration. The configuration is only deleted if no other rules remain.
+func deletePairedReplicationConfiguration(ctx context.Context, awsClient *conns.AWSClient, bucket, sourceBucket string) error {
+	optFns, err := bucketRegionOptFns(ctx, awsClient, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	conn := awsClient.S3Client(ctx)
+	config, err := findReplicationConfiguration(ctx, conn, bucket, optFns...)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rules := slices.DeleteFunc(slices.Clone(config.Rules), func(rule types.ReplicationRule) bool {
+		return isPairedReplicationRule(rule, sourceBucket)
+	})
+
+	if len(rules) == len(config.Rules) {
+		return nil
+	}
+
+	if len(rules) > 0 {
+		_, err = conn.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+			Bucket: aws.String(bucket),
+			ReplicationConfiguration: &types.ReplicationConfiguration{
+				Role:  config.Role,
+				Rules: rules,
+			},
+		}, optFns...)
+
+		return err
+	}
+
+	_, err = conn.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// isPairedReplicationRule returns whether the specified destination bucket rule replicates to the source bucket.
+func isPairedReplicationRule(rule types.ReplicationRule, sourceBucket string) bool {
+	if rule.Destination == nil {
+		return false
+	}
+
+	v, err := bucketNameFromARN(aws.ToString(rule.Destination.Bucket))
+
+	return err == nil && v == sourceBucket
+}
+
 func expandReplicationRules(ctx context.Context, l []interface{}) []types.ReplicationRule {
 	var rules []types.ReplicationRule
 
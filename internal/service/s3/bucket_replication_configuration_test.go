@@ -6,9 +6,11 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -1203,6 +1205,225 @@ func TestAccS3BucketReplicationConfiguration_directoryBucket(t *testing.T) {
 
 // testAccCheckBucketReplicationConfigurationDestroy is the equivalent of the "WithProvider"
 // version, but for use with "same region" tests requiring only one provider.
+func TestAccS3BucketReplicationConfiguration_replicationTimeControlWithoutMetrics(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName),
+				ExpectError: regexache.MustCompile(`rule.0.destination.metrics must be enabled`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_bidirectional(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	iamRoleResourceName := "aws_iam_role.test"
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_bidirectional(rName, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckBucketReplicationConfigurationPairedExists(ctx, rNameDestination, rName),
+					resource.TestCheckResourceAttr(resourceName, "bidirectional.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "bidirectional.0.destination_bucket", rNameDestination),
+					resource.TestCheckResourceAttrPair(resourceName, "bidirectional.0.role", iamRoleResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bidirectional"},
+			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_schemaV2SameRegion(rName, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckBucketReplicationConfigurationPairedNotExists(ctx, rNameDestination),
+					resource.TestCheckResourceAttr(resourceName, "bidirectional.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_bidirectionalExistingConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameOther := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_replication_configuration.destination"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_bidirectionalExistingConfiguration(rName, rNameDestination, rNameOther, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+				),
+			},
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_bidirectionalExistingConfiguration(rName, rNameDestination, rNameOther, true),
+				ExpectError: regexache.MustCompile(`has an existing Replication Configuration with rules that don't replicate to S3 Bucket`),
+			},
+			{
+				// The destination bucket's existing configuration is left in place.
+				Config: testAccBucketReplicationConfigurationConfig_bidirectionalExistingConfiguration(rName, rNameDestination, rNameOther, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckBucketReplicationConfigurationPairedExists(ctx, rNameDestination, rNameOther),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_validatePrerequisites(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_prerequisitesBase(rName, rNameDestination),
+			},
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_validatePrerequisites(rName, rNameDestination),
+				ExpectError: regexache.MustCompile(`versioning must be enabled on S3 Bucket`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_validatePrerequisitesBidirectional(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_sourcePolicyDeniesReplicationBase(rName, rNameDestination),
+			},
+			{
+				// The source bucket's policy denies replication back from the destination bucket.
+				Config:      testAccBucketReplicationConfigurationConfig_validatePrerequisitesBidirectional(rName, rNameDestination),
+				ExpectError: regexache.MustCompile(fmt.Sprintf(`S3 Bucket \(%s\) policy denies replication by role`, rName)),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_prefixWithMetricsDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Disabled metrics don't require a filter.
+				Config:             testAccBucketReplicationConfigurationConfig_prefixWithMetricsDisabled(rName, rNameDestination),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestBucketPolicyDeniesReplication(t *testing.T) {
+	t.Parallel()
+
+	const role = "arn:aws:iam::123456789012:role/replication" //lintignore:AWSAT005
+
+	testCases := []struct {
+		name     string
+		policy   string
+		expected bool
+	}{
+		{
+			name:     "allow",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`,
+			expected: false,
+		},
+		{
+			name:     "deny all",
+			policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*"}}`,
+			expected: true,
+		},
+		{
+			name:     "deny role replicate",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":["` + role + `"]},"Action":["s3:ReplicateObject","s3:ReplicateDelete"],"Resource":"*"}]}`,
+			expected: true,
+		},
+		{
+			name:     "deny other principal",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:role/other"},"Action":"s3:*","Resource":"*"}]}`, //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "conditional deny",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			expected: false,
+		},
+		{
+			name:     "deny other action",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:DeleteBucket","Resource":"*"}]}`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.BucketPolicyDeniesReplication(testCase.policy, role)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccCheckBucketReplicationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
@@ -1270,6 +1491,44 @@ func testAccCheckBucketReplicationConfigurationExists(ctx context.Context, n str
 	}
 }
 
+func testAccCheckBucketReplicationConfigurationPairedExists(ctx context.Context, bucket, destinationBucket string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindReplicationConfiguration(ctx, conn, bucket)
+
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range output.Rules {
+			if !strings.HasSuffix(aws.ToString(rule.Destination.Bucket), ":"+destinationBucket) {
+				return fmt.Errorf("S3 Bucket (%s) Replication Configuration rule (%s) destination is %s", bucket, aws.ToString(rule.ID), aws.ToString(rule.Destination.Bucket))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketReplicationConfigurationPairedNotExists(ctx context.Context, bucket string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindReplicationConfiguration(ctx, conn, bucket)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket (%s) Replication Configuration still exists", bucket)
+	}
+}
+
 func testAccBucketReplicationConfigurationConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -2455,3 +2714,401 @@ resource "aws_s3_bucket_replication_configuration" "test" {
   }
 }`, storageClass))
 }
+
+func testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Enabled"
+        time {
+          minutes = 15
+        }
+      }
+    }
+  }
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_bidirectional(rName, rNameDestination string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "${data.aws_service_principal.current.name}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    source_selection_criteria {
+      replica_modifications {
+        status = "Enabled"
+      }
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+
+  bidirectional {}
+}`, rName, rNameDestination)
+}
+
+func testAccBucketReplicationConfigurationConfig_bidirectionalExistingConfiguration(rName, rNameDestination, rNameOther string, bidirectional bool) string {
+	config := fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "${data.aws_service_principal.current.name}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "other" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_bucket_versioning" "other" {
+  bucket = aws_s3_bucket.other.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "destination" {
+  depends_on = [
+    aws_s3_bucket_versioning.destination,
+    aws_s3_bucket_versioning.other
+  ]
+
+  bucket = aws_s3_bucket.destination.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "other"
+    status = "Enabled"
+
+    filter {
+      prefix = "other"
+    }
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket = aws_s3_bucket.other.arn
+    }
+  }
+}
+`, rName, rNameDestination, rNameOther)
+
+	if !bidirectional {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_replication_configuration.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+
+  bidirectional {}
+}
+`)
+}
+
+func testAccBucketReplicationConfigurationConfig_prerequisitesBase(rName, rNameDestination string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "${data.aws_service_principal.current.name}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, rName, rNameDestination)
+}
+
+func testAccBucketReplicationConfigurationConfig_validatePrerequisites(rName, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_prerequisitesBase(rName, rNameDestination), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  validate_prerequisites = true
+
+  rule {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_sourcePolicyDeniesReplicationBase(rName, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_prerequisitesBase(rName, rNameDestination), `
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_policy" "source" {
+  bucket = aws_s3_bucket.source.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Deny"
+      Principal = { AWS = aws_iam_role.test.arn }
+      Action    = "s3:ReplicateObject"
+      Resource  = "${aws_s3_bucket.source.arn}/*"
+    }]
+  })
+}
+`)
+}
+
+func testAccBucketReplicationConfigurationConfig_validatePrerequisitesBidirectional(rName, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_sourcePolicyDeniesReplicationBase(rName, rNameDestination), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  validate_prerequisites = true
+
+  rule {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+
+  bidirectional {}
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_prefixWithMetricsDisabled(rName, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_prerequisitesBase(rName, rNameDestination), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      metrics {
+        status = "Disabled"
+      }
+    }
+  }
+}`)
+}
@@ -8,5 +8,7 @@ var (
 	ResourceBucket = resourceBucket
 	ResourceObject = resourceObject
 
-	BucketListTags = bucketListTags
+	BucketListTags   = bucketListTags
+	FindBucketPolicy = findBucketPolicy
+	FindBucketRegion = findBucketRegion
 )
@@ -7,6 +7,7 @@ package sagemaker
 var (
 	ResourceApp                                    = resourceApp
 	ResourceAppImageConfig                         = resourceAppImageConfig
+	ResourceCluster                                = resourceCluster
 	ResourceCodeRepository                         = resourceCodeRepository
 	ResourceDataQualityJobDefinition               = resourceDataQualityJobDefinition
 	ResourceDevice                                 = resourceDevice
@@ -19,12 +20,14 @@ var (
 	ResourceHumanTaskUI                            = resourceHumanTaskUI
 	ResourceImage                                  = resourceImage
 	ResourceImageVersion                           = resourceImageVersion
+	ResourceInferenceComponent                     = resourceInferenceComponent
 	ResourceModel                                  = resourceModel
 	ResourceModelPackageGroup                      = resourceModelPackageGroup
 	ResourceModelPackageGroupPolicy                = resourceModelPackageGroupPolicy
 	ResourceMonitoringSchedule                     = resourceMonitoringSchedule
 	ResourceNotebookInstance                       = resourceNotebookInstance
 	ResourceNotebookInstanceLifeCycleConfiguration = resourceNotebookInstanceLifeCycleConfiguration
+	ResourcePartnerApp                             = resourcePartnerApp
 	ResourcePipeline                               = resourcePipeline
 	ResourceProject                                = resourceProject
 	ResourceSpace                                  = resourceSpace
@@ -35,6 +38,7 @@ var (
 
 	FindAppByName                             = findAppByName
 	FindAppImageConfigByName                  = findAppImageConfigByName
+	FindClusterByName                         = findClusterByName
 	FindCodeRepositoryByName                  = findCodeRepositoryByName
 	FindDataQualityJobDefinitionByName        = findDataQualityJobDefinitionByName
 	FindDeviceByName                          = findDeviceByName
@@ -47,12 +51,14 @@ var (
 	FindHumanTaskUIByName                     = findHumanTaskUIByName
 	FindImageByName                           = findImageByName
 	FindImageVersionByName                    = findImageVersionByName
+	FindInferenceComponentByName              = findInferenceComponentByName
 	FindModelByName                           = findModelByName
 	FindModelPackageGroupByName               = findModelPackageGroupByName
 	FindModelPackageGroupPolicyByName         = findModelPackageGroupPolicyByName
 	FindMonitoringScheduleByName              = findMonitoringScheduleByName
 	FindNotebookInstanceByName                = findNotebookInstanceByName
 	FindNotebookInstanceLifecycleConfigByName = findNotebookInstanceLifecycleConfigByName
+	FindPartnerAppByARN                       = findPartnerAppByARN
 	FindPipelineByName                        = findPipelineByName
 	FindProjectByName                         = findProjectByName
 	FindServicecatalogPortfolioStatus         = findServicecatalogPortfolioStatus
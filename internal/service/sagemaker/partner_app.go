@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_partner_app", name="Partner App")
+// @Tags(identifierAttribute="arn")
+func resourcePartnerApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePartnerAppCreate,
+		ReadWithoutTimeout:   resourcePartnerAppRead,
+		UpdateWithoutTimeout: resourcePartnerAppUpdate,
+		DeleteWithoutTimeout: resourcePartnerAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_users": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PartnerAppAuthType](),
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_iam_session_based_identity": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrExecutionRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"maintenance_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_window_start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PartnerAppType](),
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePartnerAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &sagemaker.CreatePartnerAppInput{
+		AuthType:         awstypes.PartnerAppAuthType(d.Get("auth_type").(string)),
+		ClientToken:      aws.String(id.UniqueId()),
+		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
+		Name:             aws.String(name),
+		Tags:             getTagsIn(ctx),
+		Tier:             aws.String(d.Get("tier").(string)),
+		Type:             awstypes.PartnerAppType(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOk("application_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ApplicationConfig = expandPartnerAppConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_iam_session_based_identity"); ok {
+		input.EnableIamSessionBasedIdentity = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MaintenanceConfig = expandPartnerAppMaintenanceConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreatePartnerApp(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Partner App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	if _, err := waitPartnerAppAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Partner App (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePartnerAppRead(ctx, d, meta)...)
+}
+
+func resourcePartnerAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	output, err := findPartnerAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Partner App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Partner App (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("application_config", flattenPartnerAppConfig(output.ApplicationConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_config: %s", err)
+	}
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("auth_type", output.AuthType)
+	d.Set("base_url", output.BaseUrl)
+	d.Set("enable_iam_session_based_identity", output.EnableIamSessionBasedIdentity)
+	d.Set(names.AttrExecutionRoleARN, output.ExecutionRoleArn)
+	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
+	if err := d.Set("maintenance_config", flattenPartnerAppMaintenanceConfig(output.MaintenanceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting maintenance_config: %s", err)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("tier", output.Tier)
+	d.Set(names.AttrType, output.Type)
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
+
+func resourcePartnerAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &sagemaker.UpdatePartnerAppInput{
+			Arn:         aws.String(d.Id()),
+			ClientToken: aws.String(id.UniqueId()),
+		}
+
+		if d.HasChange("application_config") {
+			if v, ok := d.GetOk("application_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ApplicationConfig = expandPartnerAppConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.ApplicationConfig = &awstypes.PartnerAppConfig{}
+			}
+		}
+
+		if d.HasChange("enable_iam_session_based_identity") {
+			input.EnableIamSessionBasedIdentity = aws.Bool(d.Get("enable_iam_session_based_identity").(bool))
+		}
+
+		if d.HasChange("maintenance_config") {
+			if v, ok := d.GetOk("maintenance_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MaintenanceConfig = expandPartnerAppMaintenanceConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.MaintenanceConfig = &awstypes.PartnerAppMaintenanceConfig{}
+			}
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = aws.String(d.Get("tier").(string))
+		}
+
+		_, err := conn.UpdatePartnerApp(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Partner App (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPartnerAppAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Partner App (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePartnerAppRead(ctx, d, meta)...)
+}
+
+func resourcePartnerAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker Partner App: %s", d.Id())
+	_, err := conn.DeletePartnerApp(ctx, &sagemaker.DeletePartnerAppInput{
+		Arn:         aws.String(d.Id()),
+		ClientToken: aws.String(id.UniqueId()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Partner App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPartnerAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Partner App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPartnerAppByARN(ctx context.Context, conn *sagemaker.Client, arn string) (*sagemaker.DescribePartnerAppOutput, error) {
+	input := &sagemaker.DescribePartnerAppInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribePartnerApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.PartnerAppStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusPartnerApp(ctx context.Context, conn *sagemaker.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPartnerAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPartnerAppAvailable(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PartnerAppStatusCreating, awstypes.PartnerAppStatusUpdating),
+		Target:  enum.Slice(awstypes.PartnerAppStatusAvailable),
+		Refresh: statusPartnerApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPartnerAppDeleted(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PartnerAppStatusDeleting),
+		Target:  []string{},
+		Refresh: statusPartnerApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandPartnerAppConfig(tfMap map[string]interface{}) *awstypes.PartnerAppConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PartnerAppConfig{}
+
+	if v, ok := tfMap["admin_users"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdminUsers = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["arguments"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Arguments = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandPartnerAppMaintenanceConfig(tfMap map[string]interface{}) *awstypes.PartnerAppMaintenanceConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PartnerAppMaintenanceConfig{}
+
+	if v, ok := tfMap["maintenance_window_start"].(string); ok && v != "" {
+		apiObject.MaintenanceWindowStart = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPartnerAppConfig(apiObject *awstypes.PartnerAppConfig) []interface{} {
+	if apiObject == nil || (len(apiObject.AdminUsers) == 0 && len(apiObject.Arguments) == 0) {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"admin_users": apiObject.AdminUsers,
+		"arguments":   apiObject.Arguments,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPartnerAppMaintenanceConfig(apiObject *awstypes.PartnerAppMaintenanceConfig) []interface{} {
+	if apiObject == nil || apiObject.MaintenanceWindowStart == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"maintenance_window_start": aws.ToString(apiObject.MaintenanceWindowStart),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerPartnerApp_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "sagemaker", regexache.MustCompile(`partner-app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "IAM"),
+					resource.TestCheckResourceAttrSet(resourceName, "base_url"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Available"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tier", "startup"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "lakera-guard"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourcePartnerApp(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_maintenanceConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_maintenanceConfig(rName, "TUE:03:30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.0.maintenance_window_start", "TUE:03:30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnerAppConfig_maintenanceConfig(rName, "WED:04:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v2),
+					testAccCheckPartnerAppNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.0.maintenance_window_start", "WED:04:00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPartnerAppDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_partner_app" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker Partner App (%s) still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPartnerAppExists(ctx context.Context, n string, v *sagemaker.DescribePartnerAppOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		output, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPartnerAppNotRecreated(before, after *sagemaker.DescribePartnerAppOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreationTime), aws.ToTime(after.CreationTime); !before.Equal(after) {
+			return fmt.Errorf("SageMaker Partner App recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccPartnerAppConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole", "sts:TagSession"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+`, rName)
+}
+
+func testAccPartnerAppConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  auth_type          = "IAM"
+  tier               = "startup"
+  execution_role_arn = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccPartnerAppConfig_maintenanceConfig(rName, windowStart string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  auth_type          = "IAM"
+  tier               = "startup"
+  execution_role_arn = aws_iam_role.test.arn
+
+  maintenance_config {
+    maintenance_window_start = %[2]q
+  }
+}
+`, rName, windowStart))
+}
@@ -29,6 +29,11 @@ func RegisterSweepers() {
 		F:    sweepApps,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_cluster", &resource.Sweeper{
+		Name: "aws_sagemaker_cluster",
+		F:    sweepClusters,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
@@ -63,6 +68,7 @@ func RegisterSweepers() {
 		Dependencies: []string{
 			"aws_sagemaker_model",
 			"aws_sagemaker_endpoint_configuration",
+			"aws_sagemaker_inference_component",
 		},
 		F: sweepEndpoints,
 	})
@@ -92,6 +98,11 @@ func RegisterSweepers() {
 		F:    sweepModelPackageGroups,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_inference_component", &resource.Sweeper{
+		Name: "aws_sagemaker_inference_component",
+		F:    sweepInferenceComponents,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
@@ -156,6 +167,11 @@ func RegisterSweepers() {
 		Name: "aws_sagemaker_pipeline",
 		F:    sweepPipelines,
 	})
+
+	resource.AddTestSweepers("aws_sagemaker_partner_app", &resource.Sweeper{
+		Name: "aws_sagemaker_partner_app",
+		F:    sweepPartnerApps,
+	})
 }
 
 func sweepAppImagesConfig(region string) error {
@@ -289,6 +305,46 @@ func sweepApps(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.SageMakerClient(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	pages := sagemaker.NewListClustersPaginator(conn, &sagemaker.ListClustersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SageMaker Cluster sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Clusters: %w", err))
+		}
+
+		for _, v := range page.ClusterSummaries {
+			r := resourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ClusterName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Clusters: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepCodeRepositories(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -533,6 +589,46 @@ func sweepFeatureGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepInferenceComponents(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.SageMakerClient(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	pages := sagemaker.NewListInferenceComponentsPaginator(conn, &sagemaker.ListInferenceComponentsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SageMaker Inference Component sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Inference Components: %w", err))
+		}
+
+		for _, v := range page.InferenceComponents {
+			r := resourceInferenceComponent()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.InferenceComponentName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Inference Components: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepFlowDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -1071,3 +1167,43 @@ func sweepPipelines(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPartnerApps(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.SageMakerClient(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	pages := sagemaker.NewListPartnerAppsPaginator(conn, &sagemaker.ListPartnerAppsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SageMaker Partner App sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Partner Apps: %w", err))
+		}
+
+		for _, v := range page.Summaries {
+			r := resourcePartnerApp()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Partner Apps: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
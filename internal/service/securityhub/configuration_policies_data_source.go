@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_securityhub_configuration_policies", name="Configuration Policies")
+func dataSourceConfigurationPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationPoliciesRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"configuration_policies": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrARN: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrDescription: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"service_enabled": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceConfigurationPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	output, err := findConfigurationPolicies(ctx, conn, &securityhub.ListConfigurationPoliciesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policies: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("configuration_policies", flattenConfigurationPolicySummaries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policies: %s", err)
+	}
+
+	return diags
+}
+
+func findConfigurationPolicies(ctx context.Context, conn *securityhub.Client, input *securityhub.ListConfigurationPoliciesInput) ([]types.ConfigurationPolicySummary, error) {
+	var output []types.ConfigurationPolicySummary
+
+	pages := securityhub.NewListConfigurationPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigurationPolicySummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenConfigurationPolicySummaries(apiObjects []types.ConfigurationPolicySummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:         aws.ToString(apiObject.Arn),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrID:          aws.ToString(apiObject.Id),
+			names.AttrName:        aws.ToString(apiObject.Name),
+			"service_enabled":     aws.ToBool(apiObject.ServiceEnabled),
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_securityhub_configuration_policies.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPoliciesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "configuration_policies.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "configuration_policies.*.id", "aws_securityhub_configuration_policy.test_1", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "configuration_policies.*.id", "aws_securityhub_configuration_policy.test_2", names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configuration_policies.*", map[string]string{
+						names.AttrName:    rName + "-1",
+						"service_enabled": acctest.CtTrue,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPoliciesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccMemberAccountDelegatedAdminConfig_base,
+		testAccCentralConfigurationEnabledConfig_base,
+		testAccConfigurationPoliciesConfig_base(rName),
+		`
+data "aws_securityhub_configuration_policies" "test" {
+  depends_on = [
+    aws_securityhub_configuration_policy.test_1,
+    aws_securityhub_configuration_policy.test_2,
+  ]
+}
+`)
+}
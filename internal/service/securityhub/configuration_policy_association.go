@@ -40,6 +40,7 @@ func resourceConfigurationPolicyAssociation() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Second),
 			Update: schema.DefaultTimeout(90 * time.Second),
+			Delete: schema.DefaultTimeout(90 * time.Second),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,6 +134,11 @@ func resourceConfigurationPolicyAssociationDelete(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy Disassociation (%s): %s", d.Id(), err)
 	}
 
+	// After disassociation the target reverts to the configuration inherited from its parent.
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Disassociation (%s) success: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_securityhub_configuration_policy_associations", name="Configuration Policy Associations")
+func dataSourceConfigurationPolicyAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationPolicyAssociationsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"association_status": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[types.ConfigurationPolicyAssociationStatus](),
+				},
+				"association_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[types.AssociationType](),
+				},
+				"associations": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"association_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"association_status_message": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"association_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"policy_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"target_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"target_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"policy_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.Any(validation.IsUUID, validation.StringInSlice([]string{"SELF_MANAGED_SECURITY_HUB"}, false)),
+				},
+			}
+		},
+	}
+}
+
+func dataSourceConfigurationPolicyAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.ListConfigurationPolicyAssociationsInput{}
+	filters := &types.AssociationFilters{}
+
+	if v, ok := d.GetOk("association_status"); ok {
+		filters.AssociationStatus = types.ConfigurationPolicyAssociationStatus(v.(string))
+		input.Filters = filters
+	}
+
+	if v, ok := d.GetOk("association_type"); ok {
+		filters.AssociationType = types.AssociationType(v.(string))
+		input.Filters = filters
+	}
+
+	if v, ok := d.GetOk("policy_id"); ok {
+		filters.ConfigurationPolicyId = aws.String(v.(string))
+		input.Filters = filters
+	}
+
+	output, err := findConfigurationPolicyAssociations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Associations: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("associations", flattenConfigurationPolicyAssociationSummaries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting associations: %s", err)
+	}
+
+	return diags
+}
+
+func findConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, input *securityhub.ListConfigurationPolicyAssociationsInput) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	var output []types.ConfigurationPolicyAssociationSummary
+
+	pages := securityhub.NewListConfigurationPolicyAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigurationPolicyAssociationSummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenConfigurationPolicyAssociationSummaries(apiObjects []types.ConfigurationPolicyAssociationSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"association_status":         string(apiObject.AssociationStatus),
+			"association_status_message": aws.ToString(apiObject.AssociationStatusMessage),
+			"association_type":           string(apiObject.AssociationType),
+			"policy_id":                  aws.ToString(apiObject.ConfigurationPolicyId),
+			"target_id":                  aws.ToString(apiObject.TargetId),
+			"target_type":                string(apiObject.TargetType),
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicyAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_securityhub_configuration_policy_associations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPolicyAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "associations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.0.association_status", string(types.ConfigurationPolicyAssociationStatusSuccess)),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.0.association_type", string(types.AssociationTypeApplied)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.policy_id", "aws_securityhub_configuration_policy.test_1", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.target_id", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.0.target_type", string(types.TargetTypeOrganizationalUnit)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "associations.0.updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicyAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccConfigurationPolicyAssociationConfig_basic(rName, "aws_organizations_organizational_unit.test.id", "aws_securityhub_configuration_policy.test_1.id"),
+		`
+data "aws_securityhub_configuration_policy_associations" "test" {
+  association_type = "APPLIED"
+  policy_id        = aws_securityhub_configuration_policy_association.test.policy_id
+}
+`)
+}
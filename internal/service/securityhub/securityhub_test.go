@@ -47,6 +47,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationPolicyAssociation_basic,
 			acctest.CtDisappears: testAccConfigurationPolicyAssociation_disappears,
 		},
+		"ConfigurationPolicyAssociationsDataSource": {
+			acctest.CtBasic: testAccConfigurationPolicyAssociationsDataSource_basic,
+		},
+		"ConfigurationPoliciesDataSource": {
+			acctest.CtBasic: testAccConfigurationPoliciesDataSource_basic,
+		},
 		"FindingAggregator": {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
@@ -31,7 +31,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceConfigurationPolicies,
+			TypeName: "aws_securityhub_configuration_policies",
+			Name:     "Configuration Policies",
+		},
+		{
+			Factory:  dataSourceConfigurationPolicyAssociations,
+			TypeName: "aws_securityhub_configuration_policy_associations",
+			Name:     "Configuration Policy Associations",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -27,6 +27,7 @@ var (
 	ProvisioningArtifactParseID                  = provisioningArtifactParseID
 	PrincipalPortfolioAssociationParseResourceID = principalPortfolioAssociationParseResourceID
 	TagOptionResourceAssociationParseID          = tagOptionResourceAssociationParseID
+	ValidateProvisioningParameters               = validateProvisioningParameters
 
 	AcceptLanguageEnglish = acceptLanguageEnglish
 	StatusCreated         = statusCreated
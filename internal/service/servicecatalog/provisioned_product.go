@@ -8,7 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"slices"
+	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
@@ -265,6 +269,7 @@ func resourceProvisionedProduct() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			refreshOutputsDiff,
+			provisioningParametersDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -280,6 +285,188 @@ func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// provisioningParametersDiff validates the configured provisioning parameters against the
+// parameters declared by the provisioning artifact so that mistakes are reported during plan
+// rather than after a failed (and slow) provisioning attempt.
+func provisioningParametersDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("provisioning_parameters", "provisioning_artifact_id", "provisioning_artifact_name", "path_id", "path_name") {
+		return nil
+	}
+
+	for _, key := range []string{"accept_language", "path_name", "product_name", "provisioning_artifact_name", "provisioning_parameters"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	input := &servicecatalog.DescribeProvisioningParametersInput{
+		AcceptLanguage: aws.String(diff.Get("accept_language").(string)),
+	}
+
+	if v := diff.Get("product_name").(string); v != "" {
+		input.ProductName = aws.String(v)
+	} else if v := diff.Get("product_id").(string); v != "" && diff.NewValueKnown("product_id") {
+		input.ProductId = aws.String(v)
+	} else {
+		return nil
+	}
+
+	if v := diff.Get("provisioning_artifact_name").(string); v != "" {
+		input.ProvisioningArtifactName = aws.String(v)
+	} else if v := diff.Get("provisioning_artifact_id").(string); v != "" && diff.NewValueKnown("provisioning_artifact_id") {
+		input.ProvisioningArtifactId = aws.String(v)
+	} else {
+		return nil
+	}
+
+	if v := diff.Get("path_name").(string); v != "" {
+		input.PathName = aws.String(v)
+	} else if !diff.NewValueKnown("path_id") {
+		return nil
+	} else if v := diff.Get("path_id").(string); v != "" {
+		input.PathId = aws.String(v)
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	output, err := conn.DescribeProvisioningParameters(ctx, input)
+
+	// The check is best effort. The product or provisioning artifact may be created in the same apply,
+	// or the caller may not be allowed to describe it. Service Catalog still validates the parameters
+	// when provisioning.
+	if err != nil {
+		log.Printf("[WARN] Unable to validate Service Catalog Provisioned Product provisioning parameters: %s", err)
+		return nil
+	}
+
+	// Individual keys and values may be unknown even when the length of the list is known.
+	known := func(i int, key string) bool {
+		return diff.NewValueKnown(fmt.Sprintf("provisioning_parameters.%d.%s", i, key))
+	}
+
+	return validateProvisioningParameters(output.ProvisioningArtifactParameters, diff.Get("provisioning_parameters").([]interface{}), known, diff.Id() == "")
+}
+
+func validateProvisioningParameters(apiObjects []awstypes.ProvisioningArtifactParameter, tfList []interface{}, known func(int, string) bool, isCreate bool) error {
+	var validationErrs []error
+
+	declared := make(map[string]awstypes.ProvisioningArtifactParameter, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		declared[aws.ToString(apiObject.ParameterKey)] = apiObject
+	}
+
+	supplied := make(map[string]bool, len(tfList))
+	allKeysKnown := true
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if !known(i, names.AttrKey) {
+			allKeysKnown = false
+			continue
+		}
+
+		key := tfMap[names.AttrKey].(string)
+		supplied[key] = true
+
+		apiObject, ok := declared[key]
+
+		if !ok {
+			validationErrs = append(validationErrs, fmt.Errorf("provisioning parameter %q is not declared by the provisioning artifact", key))
+			continue
+		}
+
+		if v, ok := tfMap["use_previous_value"].(bool); ok && v {
+			continue
+		}
+
+		if !known(i, names.AttrValue) {
+			continue
+		}
+
+		if err := validateProvisioningParameterValue(apiObject, tfMap[names.AttrValue].(string)); err != nil {
+			validationErrs = append(validationErrs, fmt.Errorf("provisioning parameter %q: %w", key, err))
+		}
+	}
+
+	// On update, omitted parameters keep their previous values.
+	if isCreate && allKeysKnown {
+		for key, apiObject := range declared {
+			if apiObject.DefaultValue == nil && !supplied[key] {
+				validationErrs = append(validationErrs, fmt.Errorf("provisioning parameter %q is required by the provisioning artifact", key))
+			}
+		}
+	}
+
+	return errors.Join(validationErrs...)
+}
+
+func validateProvisioningParameterValue(apiObject awstypes.ProvisioningArtifactParameter, value string) error {
+	constraints := apiObject.ParameterConstraints
+
+	if constraints == nil {
+		return nil
+	}
+
+	err := func() error {
+		if v := constraints.AllowedValues; len(v) > 0 && !slices.Contains(v, value) {
+			return fmt.Errorf("value %q must be one of %q", value, v)
+		}
+
+		switch aws.ToString(apiObject.ParameterType) {
+		case "String":
+			// CloudFormation patterns are Java regular expressions. Patterns that use syntax Go doesn't
+			// support, such as lookarounds, are left to CloudFormation.
+			if v := aws.ToString(constraints.AllowedPattern); v != "" {
+				if re, err := regexp.Compile(`^(?:` + v + `)$`); err != nil {
+					log.Printf("[WARN] Unable to validate Service Catalog provisioning parameter %q against pattern %q: %s", aws.ToString(apiObject.ParameterKey), v, err)
+				} else if !re.MatchString(value) {
+					return fmt.Errorf("value %q must match pattern %q", value, v)
+				}
+			}
+
+			n := utf8.RuneCountInString(value)
+
+			if v, err := strconv.Atoi(aws.ToString(constraints.MinLength)); err == nil && n < v {
+				return fmt.Errorf("value %q must be at least %d characters", value, v)
+			}
+
+			if v, err := strconv.Atoi(aws.ToString(constraints.MaxLength)); err == nil && n > v {
+				return fmt.Errorf("value %q must be at most %d characters", value, v)
+			}
+		case "Number":
+			n, err := strconv.ParseFloat(value, 64)
+
+			if err != nil {
+				return fmt.Errorf("value %q must be a number", value)
+			}
+
+			if v, err := strconv.ParseFloat(aws.ToString(constraints.MinValue), 64); err == nil && n < v {
+				return fmt.Errorf("value %q must be at least %s", value, aws.ToString(constraints.MinValue))
+			}
+
+			if v, err := strconv.ParseFloat(aws.ToString(constraints.MaxValue), 64); err == nil && n > v {
+				return fmt.Errorf("value %q must be at most %s", value, aws.ToString(constraints.MaxValue))
+			}
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		if v := aws.ToString(constraints.ConstraintDescription); v != "" {
+			return fmt.Errorf("%w (%s)", err, v)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -418,6 +418,147 @@ func TestAccServiceCatalogProvisionedProduct_errorOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_provisioningParametersInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_basic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+				),
+			},
+			{
+				Config:      testAccProvisionedProductConfig_provisioningParametersInvalid(rName, "10.1.0.0/16"),
+				ExpectError: regexache.MustCompile(`provisioning parameter "NotDeclared" is not declared by the\s+provisioning artifact`),
+			},
+		},
+	})
+}
+
+func TestValidateProvisioningParameters(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []awstypes.ProvisioningArtifactParameter{
+		{
+			ParameterKey:  aws.String("VPCPrimaryCIDR"),
+			ParameterType: aws.String("String"),
+			ParameterConstraints: &awstypes.ParameterConstraints{
+				AllowedPattern: aws.String(`\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}/\d{1,2}`),
+			},
+		},
+		{
+			ParameterKey:  aws.String("Environment"),
+			ParameterType: aws.String("String"),
+			DefaultValue:  aws.String("dev"),
+			ParameterConstraints: &awstypes.ParameterConstraints{
+				AllowedValues: []string{"dev", "prod"},
+			},
+		},
+	}
+	allKnown := func(int, string) bool { return true }
+	// The SDK reports unknown values as this placeholder.
+	const unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	testCases := map[string]struct {
+		tfList      []interface{}
+		known       func(int, string) bool
+		isCreate    bool
+		expectError *regexp.Regexp
+	}{
+		"valid": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "VPCPrimaryCIDR", names.AttrValue: "10.1.0.0/16"},
+				map[string]interface{}{names.AttrKey: "Environment", names.AttrValue: "prod"},
+			},
+			known:    allKnown,
+			isCreate: true,
+		},
+		"not declared": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "VPCPrimaryCIDR", names.AttrValue: "10.1.0.0/16"},
+				map[string]interface{}{names.AttrKey: "NotDeclared", names.AttrValue: "x"},
+			},
+			known:       allKnown,
+			isCreate:    true,
+			expectError: regexache.MustCompile(`provisioning parameter "NotDeclared" is not declared`),
+		},
+		"allowed values": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "VPCPrimaryCIDR", names.AttrValue: "10.1.0.0/16"},
+				map[string]interface{}{names.AttrKey: "Environment", names.AttrValue: "test"},
+			},
+			known:       allKnown,
+			isCreate:    true,
+			expectError: regexache.MustCompile(`provisioning parameter "Environment": value "test" must be one of`),
+		},
+		"required on create": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "Environment", names.AttrValue: "prod"},
+			},
+			known:       allKnown,
+			isCreate:    true,
+			expectError: regexache.MustCompile(`provisioning parameter "VPCPrimaryCIDR" is required`),
+		},
+		"omitted on update": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "Environment", names.AttrValue: "prod"},
+			},
+			known: allKnown,
+		},
+		"unknown value": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: "VPCPrimaryCIDR", names.AttrValue: unknown},
+				map[string]interface{}{names.AttrKey: "Environment", names.AttrValue: unknown},
+			},
+			known: func(_ int, key string) bool {
+				return key != names.AttrValue
+			},
+			isCreate: true,
+		},
+		"unknown key": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrKey: unknown, names.AttrValue: "10.1.0.0/16"},
+			},
+			known: func(i int, key string) bool {
+				return !(i == 0 && key == names.AttrKey)
+			},
+			isCreate: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfservicecatalog.ValidateProvisioningParameters(apiObjects, testCase.tfList, testCase.known, testCase.isCreate)
+
+			if testCase.expectError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccServiceCatalogProvisionedProduct_productTagUpdateAfterError(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -943,6 +1084,37 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr))
 }
 
+func testAccProvisionedProductConfig_provisioningParametersInvalid(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+
+  provisioning_parameters {
+    key   = "NotDeclared"
+    value = "value"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, vpcCidr))
+}
+
 func testAccProvisionedProductConfig_productTagUpdateAfterError_valid(rName, bucketName, tagValue string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLSimpleBaseConfig(rName),
 		fmt.Sprintf(`
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sesv2_deliverability_dashboard_options", name="Deliverability Dashboard Options")
+func dataSourceDeliverabilityDashboardOptions() *schema.Resource {
+	subscribedDomainSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrDomain: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"inbox_placement_tracking_option": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"global": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"tracked_isps": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"subscription_start_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeliverabilityDashboardOptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"active_subscribed_domains": subscribedDomainSchema,
+			"dashboard_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pending_expiration_subscribed_domains": subscribedDomainSchema,
+			"subscription_expiry_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	dsNameDeliverabilityDashboardOptions = "Deliverability Dashboard Options Data Source"
+)
+
+func dataSourceDeliverabilityDashboardOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	out, err := findDeliverabilityDashboardOptions(ctx, conn)
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, dsNameDeliverabilityDashboardOptions, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("account_status", out.AccountStatus)
+	if err := d.Set("active_subscribed_domains", flattenDomainDeliverabilityTrackingOptions(out.ActiveSubscribedDomains)); err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionSetting, dsNameDeliverabilityDashboardOptions, d.Id(), err)
+	}
+	d.Set("dashboard_enabled", out.DashboardEnabled)
+	if err := d.Set("pending_expiration_subscribed_domains", flattenDomainDeliverabilityTrackingOptions(out.PendingExpirationSubscribedDomains)); err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionSetting, dsNameDeliverabilityDashboardOptions, d.Id(), err)
+	}
+	if v := out.SubscriptionExpiryDate; v != nil {
+		d.Set("subscription_expiry_date", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("subscription_expiry_date", nil)
+	}
+
+	return diags
+}
+
+func findDeliverabilityDashboardOptions(ctx context.Context, conn *sesv2.Client) (*sesv2.GetDeliverabilityDashboardOptionsOutput, error) {
+	input := &sesv2.GetDeliverabilityDashboardOptionsInput{}
+	output, err := conn.GetDeliverabilityDashboardOptions(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenDomainDeliverabilityTrackingOptions(apiObjects []types.DomainDeliverabilityTrackingOption) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrDomain: aws.ToString(apiObject.Domain),
+		}
+
+		if v := apiObject.InboxPlacementTrackingOption; v != nil {
+			tfMap["inbox_placement_tracking_option"] = []interface{}{
+				map[string]interface{}{
+					"global":       v.Global,
+					"tracked_isps": v.TrackedIsps,
+				},
+			}
+		}
+
+		if v := apiObject.SubscriptionStartDate; v != nil {
+			tfMap["subscription_start_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
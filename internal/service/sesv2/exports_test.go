@@ -15,6 +15,7 @@ var (
 	ResourceEmailIdentityFeedbackAttributes  = resourceEmailIdentityFeedbackAttributes
 	ResourceEmailIdentityMailFromAttributes  = resourceEmailIdentityMailFromAttributes
 	ResourceEmailIdentityPolicy              = resourceEmailIdentityPolicy
+	ResourceSuppressionListImportJob         = resourceSuppressionListImportJob
 
 	FindAccountVDMAttributes                         = findAccountVDMAttributes
 	FindConfigurationSetByID                         = findConfigurationSetByID
@@ -24,4 +25,5 @@ var (
 	FindDedicatedIPPoolByName                        = findDedicatedIPPoolByName
 	FindEmailIdentityByID                            = findEmailIdentityByID
 	FindEmailIdentityPolicyByTwoPartKey              = findEmailIdentityPolicyByTwoPartKey
+	FindImportJobByID                                = findImportJobByID
 )
@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_sesv2_dedicated_ip_pool",
 			Name:     "Dedicated IP Pool",
 		},
+		{
+			Factory:  dataSourceDeliverabilityDashboardOptions,
+			TypeName: "aws_sesv2_deliverability_dashboard_options",
+			Name:     "Deliverability Dashboard Options",
+		},
 		{
 			Factory:  dataSourceEmailIdentity,
 			TypeName: "aws_sesv2_email_identity",
@@ -114,6 +119,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sesv2_email_identity_policy",
 			Name:     "Email Identity Policy",
 		},
+		{
+			Factory:  resourceSuppressionListImportJob,
+			TypeName: "aws_sesv2_suppression_list_import_job",
+			Name:     "Suppression List Import Job",
+		},
 	}
 }
 
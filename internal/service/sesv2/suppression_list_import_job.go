@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sesv2_suppression_list_import_job", name="Suppression List Import Job")
+func resourceSuppressionListImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSuppressionListImportJobCreate,
+		ReadWithoutTimeout:   resourceSuppressionListImportJobRead,
+		DeleteWithoutTimeout: resourceSuppressionListImportJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"completed_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.DataFormat](),
+			},
+			"failed_records_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"processed_records_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://`), "must be an S3 URL"),
+			},
+			"suppression_list_import_action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.SuppressionListImportAction](),
+			},
+		},
+	}
+}
+
+const (
+	resNameSuppressionListImportJob = "Suppression List Import Job"
+)
+
+func resourceSuppressionListImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	in := &sesv2.CreateImportJobInput{
+		ImportDataSource: &types.ImportDataSource{
+			DataFormat: types.DataFormat(d.Get("data_format").(string)),
+			S3Url:      aws.String(d.Get("s3_url").(string)),
+		},
+		ImportDestination: &types.ImportDestination{
+			SuppressionListDestination: &types.SuppressionListDestination{
+				SuppressionListImportAction: types.SuppressionListImportAction(d.Get("suppression_list_import_action").(string)),
+			},
+		},
+	}
+
+	out, err := conn.CreateImportJob(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionCreating, resNameSuppressionListImportJob, d.Get("s3_url").(string), err)
+	}
+	if out == nil || out.JobId == nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionCreating, resNameSuppressionListImportJob, d.Get("s3_url").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.JobId))
+
+	if _, err := waitImportJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionWaitingForCreation, resNameSuppressionListImportJob, d.Id(), err)
+	}
+
+	return append(diags, resourceSuppressionListImportJobRead(ctx, d, meta)...)
+}
+
+func resourceSuppressionListImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	out, err := findImportJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESV2 SuppressionListImportJob (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameSuppressionListImportJob, d.Id(), err)
+	}
+
+	if out.ImportDestination == nil || out.ImportDestination.SuppressionListDestination == nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameSuppressionListImportJob, d.Id(), errors.New("not a suppression list import job"))
+	}
+
+	if v := out.CompletedTimestamp; v != nil {
+		d.Set("completed_timestamp", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("completed_timestamp", nil)
+	}
+	d.Set("created_timestamp", aws.ToTime(out.CreatedTimestamp).Format(time.RFC3339))
+	if v := out.ImportDataSource; v != nil {
+		d.Set("data_format", v.DataFormat)
+		d.Set("s3_url", v.S3Url)
+	}
+	d.Set("failed_records_count", out.FailedRecordsCount)
+	d.Set("job_status", out.JobStatus)
+	d.Set("processed_records_count", out.ProcessedRecordsCount)
+	d.Set("suppression_list_import_action", out.ImportDestination.SuppressionListDestination.SuppressionListImportAction)
+
+	return diags
+}
+
+func resourceSuppressionListImportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Import jobs can't be deleted and the imported addresses are left in the suppression list.
+	log.Printf("[INFO] Removing SESV2 SuppressionListImportJob %s from state", d.Id())
+
+	return nil
+}
+
+func findImportJobByID(ctx context.Context, conn *sesv2.Client, id string) (*sesv2.GetImportJobOutput, error) {
+	input := &sesv2.GetImportJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.GetImportJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusImportJob(ctx context.Context, conn *sesv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitImportJobCompleted(ctx context.Context, conn *sesv2.Client, id string, timeout time.Duration) (*sesv2.GetImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusCreated, types.JobStatusProcessing),
+		Target:  enum.Slice(types.JobStatusCompleted),
+		Refresh: statusImportJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sesv2.GetImportJobOutput); ok {
+		if v := output.FailureInfo; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
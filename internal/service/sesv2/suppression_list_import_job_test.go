@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSESV2SuppressionListImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_suppression_list_import_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuppressionListImportJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSuppressionListImportJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "data_format", string(types.DataFormatCsv)),
+					resource.TestCheckResourceAttr(resourceName, "failed_records_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(types.JobStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "processed_records_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "suppression_list_import_action", string(types.SuppressionListImportActionPut)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSuppressionListImportJobExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Client(ctx)
+
+		_, err := tfsesv2.FindImportJobByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSuppressionListImportJobConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ses.amazonaws.com"
+      }
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "suppressions.csv"
+  content = "%[1]s-1@example.com,BOUNCE\n%[1]s-2@example.com,COMPLAINT\n"
+}
+
+resource "aws_sesv2_suppression_list_import_job" "test" {
+  data_format                    = "CSV"
+  s3_url                         = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  suppression_list_import_action = "PUT"
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName)
+}
@@ -5,10 +5,14 @@ package shield
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -29,12 +34,19 @@ import (
 
 // @FrameworkResource(name="Proactive Engagement")
 func newProactiveEngagementResource(context.Context) (resource.ResourceWithConfigure, error) {
-	return &proactiveEngagementResource{}, nil
+	r := &proactiveEngagementResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 type proactiveEngagementResource struct {
 	framework.ResourceWithConfigure
 	framework.WithImportByID
+	framework.WithTimeouts
 }
 
 func (r *proactiveEngagementResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -80,6 +92,11 @@ func (r *proactiveEngagementResource) Schema(ctx context.Context, request resour
 					},
 				},
 			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -111,12 +128,13 @@ func (r *proactiveEngagementResource) Create(ctx context.Context, request resour
 	// Set values for unknowns.
 	data.ID = types.StringValue(r.Meta().AccountID)
 
-	response.Diagnostics.Append(updateEmergencyContactSettings(ctx, conn, &data)...)
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	response.Diagnostics.Append(updateEmergencyContactSettings(ctx, conn, &data, createTimeout)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	response.Diagnostics.Append(putProactiveEngagementStatus(ctx, conn, data.Enabled.ValueBool())...)
+	response.Diagnostics.Append(putProactiveEngagementStatus(ctx, conn, data.Enabled.ValueBool(), createTimeout)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -183,15 +201,17 @@ func (r *proactiveEngagementResource) Update(ctx context.Context, request resour
 
 	conn := r.Meta().ShieldClient(ctx)
 
+	updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+
 	if !new.EmergencyContactList.Equal(old.EmergencyContactList) {
-		response.Diagnostics.Append(updateEmergencyContactSettings(ctx, conn, &new)...)
+		response.Diagnostics.Append(updateEmergencyContactSettings(ctx, conn, &new, updateTimeout)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
 	}
 
 	if !new.Enabled.Equal(old.Enabled) {
-		response.Diagnostics.Append(putProactiveEngagementStatus(ctx, conn, new.Enabled.ValueBool())...)
+		response.Diagnostics.Append(putProactiveEngagementStatus(ctx, conn, new.Enabled.ValueBool(), updateTimeout)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
@@ -201,6 +221,12 @@ func (r *proactiveEngagementResource) Update(ctx context.Context, request resour
 }
 
 func (r *proactiveEngagementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	conn := r.Meta().ShieldClient(ctx)
 
 	inputD := &shield.DisableProactiveEngagementInput{}
@@ -217,6 +243,12 @@ func (r *proactiveEngagementResource) Delete(ctx context.Context, request resour
 		return
 	}
 
+	if _, err := waitProactiveEngagementStatus(ctx, conn, awstypes.ProactiveEngagementStatusDisabled, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError("waiting for Shield proactive engagement disable", err.Error())
+
+		return
+	}
+
 	inputU := &shield.UpdateEmergencyContactSettingsInput{
 		EmergencyContactList: []awstypes.EmergencyContact{},
 	}
@@ -264,19 +296,31 @@ func enableProactiveEngagement(ctx context.Context, conn *shield.Client) diag.Di
 	return diags
 }
 
-func putProactiveEngagementStatus(ctx context.Context, conn *shield.Client, enabled bool) diag.Diagnostics {
+func putProactiveEngagementStatus(ctx context.Context, conn *shield.Client, enabled bool, timeout time.Duration) diag.Diagnostics {
 	var diags diag.Diagnostics
+	target := awstypes.ProactiveEngagementStatusDisabled
 
 	if enabled {
 		diags.Append(enableProactiveEngagement(ctx, conn)...)
+		target = awstypes.ProactiveEngagementStatusEnabled
 	} else {
 		diags.Append(disableProactiveEngagement(ctx, conn)...)
 	}
 
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := waitProactiveEngagementStatus(ctx, conn, target, timeout); err != nil {
+		diags.AddError("waiting for Shield proactive engagement status", err.Error())
+
+		return diags
+	}
+
 	return diags
 }
 
-func updateEmergencyContactSettings(ctx context.Context, conn *shield.Client, data *proactiveEngagementResourceModel) diag.Diagnostics {
+func updateEmergencyContactSettings(ctx context.Context, conn *shield.Client, data *proactiveEngagementResourceModel, timeout time.Duration) diag.Diagnostics {
 	var diags diag.Diagnostics
 	input := &shield.UpdateEmergencyContactSettingsInput{}
 
@@ -293,6 +337,16 @@ func updateEmergencyContactSettings(ctx context.Context, conn *shield.Client, da
 		return diags
 	}
 
+	emailAddresses := tfslices.ApplyToAll(input.EmergencyContactList, func(v awstypes.EmergencyContact) string {
+		return aws.ToString(v.EmailAddress)
+	})
+
+	if _, err := waitEmergencyContactSettingsUpdated(ctx, conn, emailAddresses, timeout); err != nil {
+		diags.AddError("waiting for Shield emergency contact settings update", err.Error())
+
+		return diags
+	}
+
 	return diags
 }
 
@@ -342,10 +396,95 @@ func findSubscription(ctx context.Context, conn *shield.Client) (*awstypes.Subsc
 	return output.Subscription, nil
 }
 
+func statusProactiveEngagement(ctx context.Context, conn *shield.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscription(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ProactiveEngagementStatus), nil
+	}
+}
+
+func waitProactiveEngagementStatus(ctx context.Context, conn *shield.Client, target awstypes.ProactiveEngagementStatus, timeout time.Duration) (*awstypes.Subscription, error) {
+	pending := awstypes.ProactiveEngagementStatusEnabled
+	if target == awstypes.ProactiveEngagementStatusEnabled {
+		pending = awstypes.ProactiveEngagementStatusDisabled
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ProactiveEngagementStatusPending, pending),
+		Target:                    enum.Slice(target),
+		Refresh:                   statusProactiveEngagement(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Subscription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitEmergencyContactSettingsUpdated waits until the emergency contact list reflects exactly the specified email addresses.
+func waitEmergencyContactSettingsUpdated(ctx context.Context, conn *shield.Client, emailAddresses []string, timeout time.Duration) ([]awstypes.EmergencyContact, error) {
+	const (
+		statusPending = "PENDING"
+		statusUpdated = "UPDATED"
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{statusPending},
+		Target:  []string{statusUpdated},
+		Refresh: func() (interface{}, string, error) {
+			output, err := findEmergencyContactSettings(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				return output, statusPending, nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			got := tfslices.ApplyToAll(output, func(v awstypes.EmergencyContact) string {
+				return aws.ToString(v.EmailAddress)
+			})
+			want := slices.Clone(emailAddresses)
+			slices.Sort(got)
+			slices.Sort(want)
+
+			if !slices.Equal(got, want) {
+				return output, statusPending, nil
+			}
+
+			return output, statusUpdated, nil
+		},
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.EmergencyContact); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 type proactiveEngagementResourceModel struct {
 	EmergencyContactList fwtypes.ListNestedObjectValueOf[emergencyContactModel] `tfsdk:"emergency_contact"`
 	Enabled              types.Bool                                             `tfsdk:"enabled"`
 	ID                   types.String                                           `tfsdk:"id"`
+	Timeouts             timeouts.Value                                         `tfsdk:"timeouts"`
 }
 
 type emergencyContactModel struct {
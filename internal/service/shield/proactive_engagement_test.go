@@ -83,6 +83,53 @@ func testAccProactiveEngagement_disabled(t *testing.T) {
 	})
 }
 
+func testAccProactiveEngagement_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+	address2 := acctest.RandomEmailAddress(domain)
+	address3 := acctest.RandomEmailAddress(domain)
+	var proactiveengagementassociation []types.EmergencyContact
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckProactiveEngagement(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, address1, address2, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementAssociationExists(ctx, resourceName, &proactiveengagementassociation),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", address2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, address1, address3, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementAssociationExists(ctx, resourceName, &proactiveengagementassociation),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", address3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, address1, address3, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementAssociationExists(ctx, resourceName, &proactiveengagementassociation),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccProactiveEngagement_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	domain := acctest.RandomDomainName()
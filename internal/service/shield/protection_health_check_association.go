@@ -6,7 +6,9 @@ package shield
 import (
 	"context"
 	"log"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKResource("aws_shield_protection_health_check_association")
@@ -28,6 +31,11 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"shield_protection_id": {
 				Type:     schema.TypeString,
@@ -61,6 +69,15 @@ func ResourceProtectionHealthCheckAssociationCreate(ctx context.Context, d *sche
 		return sdkdiag.AppendErrorf(diags, "associating Route53 Health Check (%s) with Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
 	}
 	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return findProtectionHealthCheckAssociation(ctx, conn, protectionId, healthCheckArn)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Shield Protection Health Check Association (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, ResourceProtectionHealthCheckAssociationRead(ctx, d, meta)...)
 }
 
@@ -74,14 +91,10 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "parsing Shield Protection and Route53 Health Check Association ID: %s", err)
 	}
 
-	input := &shield.DescribeProtectionInput{
-		ProtectionId: aws.String(protectionId),
-	}
-
-	resp, err := conn.DescribeProtection(ctx, input)
+	protection, err := findProtectionHealthCheckAssociation(ctx, conn, protectionId, healthCheckArn)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		log.Printf("[WARN] Shield Protection itself (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
@@ -90,14 +103,8 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "reading Shield Protection Health Check Association (%s): %s", d.Id(), err)
 	}
 
-	isHealthCheck := stringInSlice(strings.Split(healthCheckArn, "/")[1], resp.Protection.HealthCheckIds)
-	if !isHealthCheck {
-		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-	}
-
 	d.Set("health_check_arn", healthCheckArn)
-	d.Set("shield_protection_id", resp.Protection.Id)
+	d.Set("shield_protection_id", protection.Id)
 
 	return diags
 }
@@ -106,7 +113,7 @@ func ResourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *sche
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
 
-	protectionId, healthCheckId, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
+	protectionId, healthCheckArn, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "parsing Shield Protection and Route53 Health Check Association ID: %s", err)
@@ -114,22 +121,46 @@ func ResourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *sche
 
 	input := &shield.DisassociateHealthCheckInput{
 		ProtectionId:   aws.String(protectionId),
-		HealthCheckArn: aws.String(healthCheckId),
+		HealthCheckArn: aws.String(healthCheckArn),
 	}
 
 	_, err = conn.DisassociateHealthCheck(ctx, input)
 
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
 	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return findProtectionHealthCheckAssociation(ctx, conn, protectionId, healthCheckArn)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Shield Protection Health Check Association (%s) delete: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
-func stringInSlice(expected string, list []string) bool {
-	for _, item := range list {
-		if item == expected {
-			return true
-		}
+func findProtectionHealthCheckAssociation(ctx context.Context, conn *shield.Client, protectionID, healthCheckARN string) (*awstypes.Protection, error) {
+	output, err := findProtectionByID(ctx, conn, protectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Health check ARNs are of the form arn:aws:route53:::healthcheck/<id>.
+	healthCheckID := healthCheckARN
+	if _, v, ok := strings.Cut(healthCheckARN, "/"); ok {
+		healthCheckID = v
+	}
+
+	if !slices.Contains(output.HealthCheckIds, healthCheckID) {
+		return nil, tfresource.NewEmptyResultError(nil)
 	}
-	return false
+
+	return output, nil
 }
@@ -49,7 +49,11 @@ func resourceDocument() *schema.Resource {
 		DeleteWithoutTimeout: resourceDocumentDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("promote_new_versions", true)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -180,6 +184,11 @@ func resourceDocument() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"promote_new_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -245,6 +254,13 @@ func resourceDocument() *schema.Resource {
 					if err := d.SetNewComputed(names.AttrParameter); err != nil {
 						return err
 					}
+				} else if d.Id() != "" && d.Get("promote_new_versions").(bool) {
+					// Detect the default version having been changed outside of Terraform.
+					if defaultVersion, latestVersion := d.Get("default_version").(string), d.Get("latest_version").(string); defaultVersion != latestVersion {
+						if err := d.SetNew("default_version", latestVersion); err != nil {
+							return err
+						}
+					}
 				}
 
 				return nil
@@ -455,15 +471,18 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrPermissions, "promote_new_versions", names.AttrTags, names.AttrTagsAll) {
 		// Update for schema version 1.x is not allowed.
 		isSchemaVersion1, _ := regexp.MatchString(`^1[.][0-9]$`, d.Get("schema_version").(string))
+		promote := d.Get("promote_new_versions").(bool)
+		var newVersion string
 
-		if d.HasChange(names.AttrContent) || !isSchemaVersion1 {
+		if d.HasChange(names.AttrContent) || (!isSchemaVersion1 && d.HasChangesExcept(names.AttrPermissions, "default_version", "promote_new_versions", names.AttrTags, names.AttrTagsAll)) {
+			// Only the latest version of a document can be updated.
 			input := &ssm.UpdateDocumentInput{
 				Content:         aws.String(d.Get(names.AttrContent).(string)),
 				DocumentFormat:  awstypes.DocumentFormat(d.Get("document_format").(string)),
-				DocumentVersion: aws.String(d.Get("default_version").(string)),
+				DocumentVersion: aws.String("$LATEST"),
 				Name:            aws.String(d.Id()),
 			}
 
@@ -479,27 +498,37 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				input.VersionName = aws.String(v.(string))
 			}
 
-			var defaultVersion string
-
 			output, err := conn.UpdateDocument(ctx, input)
 
 			if errs.IsA[*awstypes.DuplicateDocumentContent](err) {
-				defaultVersion = d.Get("latest_version").(string)
+				doc, err := findDocumentByName(ctx, conn, d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s): %s", d.Id(), err)
+				}
+
+				newVersion = aws.ToString(doc.LatestVersion)
 			} else if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
 			} else {
-				defaultVersion = aws.ToString(output.DocumentDescription.DocumentVersion)
+				newVersion = aws.ToString(output.DocumentDescription.DocumentVersion)
 			}
+		} else if d.HasChange("default_version") {
+			newVersion = d.Get("latest_version").(string)
+		}
 
-			_, err = conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
-				DocumentVersion: aws.String(defaultVersion),
+		if promote && newVersion != "" {
+			_, err := conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+				DocumentVersion: aws.String(newVersion),
 				Name:            aws.String(d.Id()),
 			})
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
 			}
+		}
 
+		if newVersion != "" {
 			if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) update: %s", d.Id(), err)
 			}
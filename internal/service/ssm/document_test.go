@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -208,6 +210,91 @@ func TestAccSSMDocument_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_promoteNewVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "promote_new_versions", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"promote_new_versions"},
+			},
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "promote_new_versions", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_defaultVersionDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					testAccCheckDocumentUpdateDefaultVersion(ctx, resourceName, acctest.Ct1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDocumentConfig_promoteNewVersions(rName, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_Permission_public(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -591,6 +678,24 @@ func testAccCheckDocumentExists(ctx context.Context, n string) resource.TestChec
 	}
 }
 
+func testAccCheckDocumentUpdateDefaultVersion(ctx context.Context, n, version string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+			DocumentVersion: aws.String(version),
+			Name:            aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckDocumentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
@@ -710,6 +815,35 @@ DOC
 `, rName, version)
 }
 
+func testAccDocumentConfig_promoteNewVersions(rName, description string, promote bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name                 = %[1]q
+  document_type        = "Command"
+  promote_new_versions = %[3]t
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "description": %[2]q,
+  "parameters": {},
+  "mainSteps": [
+    {
+      "action": "aws:runShellScript",
+      "name": "runShellScript",
+      "inputs": {
+        "runCommand": [
+          "ps"
+        ]
+      }
+    }
+  ]
+}
+DOC
+}
+`, rName, description, promote)
+}
+
 func testAccDocumentConfig_20(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
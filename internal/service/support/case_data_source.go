@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/support"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/support/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_support_case", name="Case")
+func newCaseDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &caseDataSource{}, nil
+}
+
+type caseDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*caseDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_support_case"
+}
+
+func (d *caseDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"case_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"category_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"cc_email_addresses": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"display_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"language": schema.StringAttribute{
+				Computed: true,
+			},
+			"service_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"severity_code": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"subject": schema.StringAttribute{
+				Computed: true,
+			},
+			"submitted_by": schema.StringAttribute{
+				Computed: true,
+			},
+			"time_created": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *caseDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("case_id"),
+			path.MatchRoot("display_id"),
+		),
+	}
+}
+
+func (d *caseDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data caseDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SupportClient(ctx)
+
+	input := &support.DescribeCasesInput{
+		IncludeCommunications: aws.Bool(false),
+		IncludeResolvedCases:  true,
+	}
+	var id string
+	if !data.CaseID.IsNull() {
+		id = data.CaseID.ValueString()
+		input.CaseIdList = []string{id}
+	} else {
+		id = data.DisplayID.ValueString()
+		input.DisplayId = aws.String(id)
+	}
+
+	output, err := findCase(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Support Case (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.CaseId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findCase(ctx context.Context, conn *support.Client, input *support.DescribeCasesInput) (*awstypes.CaseDetails, error) {
+	output, err := findCases(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCases(ctx context.Context, conn *support.Client, input *support.DescribeCasesInput) ([]awstypes.CaseDetails, error) {
+	var output []awstypes.CaseDetails
+
+	pages := support.NewDescribeCasesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.CaseIdNotFound](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Cases...)
+	}
+
+	return output, nil
+}
+
+type caseDataSourceModel struct {
+	CaseID           types.String                      `tfsdk:"case_id"`
+	CategoryCode     types.String                      `tfsdk:"category_code"`
+	CcEmailAddresses fwtypes.ListValueOf[types.String] `tfsdk:"cc_email_addresses"`
+	DisplayID        types.String                      `tfsdk:"display_id"`
+	ID               types.String                      `tfsdk:"id"`
+	Language         types.String                      `tfsdk:"language"`
+	ServiceCode      types.String                      `tfsdk:"service_code"`
+	SeverityCode     types.String                      `tfsdk:"severity_code"`
+	Status           types.String                      `tfsdk:"status"`
+	Subject          types.String                      `tfsdk:"subject"`
+	SubmittedBy      types.String                      `tfsdk:"submitted_by"`
+	TimeCreated      types.String                      `tfsdk:"time_created"`
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envSupportCaseID = "SUPPORT_CASE_ID"
+)
+
+func TestAccSupportCaseDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_support_case.test"
+
+	// Support cases can't be deleted, so an existing case is used.
+	caseID := acctest.SkipIfEnvVarNotSet(t, envSupportCaseID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseDataSourceConfig_caseID(caseID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "case_id", caseID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, caseID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "severity_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subject"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "time_created"),
+				),
+			},
+			{
+				Config: testAccCaseDataSourceConfig_displayID(caseID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "case_id", caseID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "display_id", "data.aws_support_case.by_case_id", "display_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCaseDataSourceConfig_caseID(caseID string) string {
+	return fmt.Sprintf(`
+data "aws_support_case" "test" {
+  case_id = %[1]q
+}
+`, caseID)
+}
+
+func testAccCaseDataSourceConfig_displayID(caseID string) string {
+	return fmt.Sprintf(`
+data "aws_support_case" "by_case_id" {
+  case_id = %[1]q
+}
+
+data "aws_support_case" "test" {
+  display_id = data.aws_support_case.by_case_id.display_id
+}
+`, caseID)
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp
+
+// Exports for use in tests only.
+var (
+	ResourceSlackChannelConfiguration   = newSlackChannelConfigurationResource
+	ResourceSlackWorkspaceConfiguration = newSlackWorkspaceConfigurationResource
+
+	FindSlackChannelConfigurationByTwoPartKey = findSlackChannelConfigurationByTwoPartKey
+	FindSlackWorkspaceConfigurationByTeamID   = findSlackWorkspaceConfigurationByTeamID
+)
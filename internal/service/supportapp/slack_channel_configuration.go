@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/supportapp"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_supportapp_slack_channel_configuration", name="Slack Channel Configuration")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/supportapp/types;types.SlackChannelConfiguration")
+// @Testing(serialize=true)
+func newSlackChannelConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &slackChannelConfigurationResource{}
+
+	return r, nil
+}
+
+type slackChannelConfigurationResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*slackChannelConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_supportapp_slack_channel_configuration"
+}
+
+func (r *slackChannelConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"channel_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"notify_on_add_correspondence_to_case": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"notify_on_case_severity": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.NotificationSeverityLevel](),
+				Required:   true,
+			},
+			"notify_on_create_or_reopen_case": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"notify_on_resolve_case": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func (r *slackChannelConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	input := &supportapp.CreateSlackChannelConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateSlackChannelConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Support App Slack Channel Configuration (%s/%s)", data.TeamID.ValueString(), data.ChannelID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findSlackChannelConfigurationByTwoPartKey(ctx, conn, data.TeamID.ValueString(), data.ChannelID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Support App Slack Channel Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ChannelName = fwflex.StringToFramework(ctx, output.ChannelName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *slackChannelConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	output, err := findSlackChannelConfigurationByTwoPartKey(ctx, conn, data.TeamID.ValueString(), data.ChannelID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Support App Slack Channel Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *slackChannelConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	input := &supportapp.UpdateSlackChannelConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateSlackChannelConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Support App Slack Channel Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.ChannelName = fwflex.StringToFramework(ctx, output.ChannelName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *slackChannelConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	_, err := conn.DeleteSlackChannelConfiguration(ctx, &supportapp.DeleteSlackChannelConfigurationInput{
+		ChannelId: fwflex.StringFromFramework(ctx, data.ChannelID),
+		TeamId:    fwflex.StringFromFramework(ctx, data.TeamID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Support App Slack Channel Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *slackChannelConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func findSlackChannelConfiguration(ctx context.Context, conn *supportapp.Client, input *supportapp.ListSlackChannelConfigurationsInput, filter tfslices.Predicate[*awstypes.SlackChannelConfiguration]) (*awstypes.SlackChannelConfiguration, error) {
+	output, err := findSlackChannelConfigurations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSlackChannelConfigurations(ctx context.Context, conn *supportapp.Client, input *supportapp.ListSlackChannelConfigurationsInput, filter tfslices.Predicate[*awstypes.SlackChannelConfiguration]) ([]awstypes.SlackChannelConfiguration, error) {
+	var output []awstypes.SlackChannelConfiguration
+
+	pages := supportapp.NewListSlackChannelConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findSlackChannelConfigurationByTwoPartKey(ctx context.Context, conn *supportapp.Client, teamID, channelID string) (*awstypes.SlackChannelConfiguration, error) {
+	input := &supportapp.ListSlackChannelConfigurationsInput{}
+
+	return findSlackChannelConfiguration(ctx, conn, input, func(v *awstypes.SlackChannelConfiguration) bool {
+		return aws.ToString(v.TeamId) == teamID && aws.ToString(v.ChannelId) == channelID
+	})
+}
+
+type slackChannelConfigurationResourceModel struct {
+	ChannelID                       types.String                                           `tfsdk:"channel_id"`
+	ChannelName                     types.String                                           `tfsdk:"channel_name"`
+	ChannelRoleARN                  fwtypes.ARN                                            `tfsdk:"channel_role_arn"`
+	ID                              types.String                                           `tfsdk:"id"`
+	NotifyOnAddCorrespondenceToCase types.Bool                                             `tfsdk:"notify_on_add_correspondence_to_case"`
+	NotifyOnCaseSeverity            fwtypes.StringEnum[awstypes.NotificationSeverityLevel] `tfsdk:"notify_on_case_severity"`
+	NotifyOnCreateOrReopenCase      types.Bool                                             `tfsdk:"notify_on_create_or_reopen_case"`
+	NotifyOnResolveCase             types.Bool                                             `tfsdk:"notify_on_resolve_case"`
+	TeamID                          types.String                                           `tfsdk:"team_id"`
+}
+
+const (
+	slackChannelConfigurationResourceIDPartCount = 2
+)
+
+func (m *slackChannelConfigurationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), slackChannelConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.TeamID = types.StringValue(parts[0])
+	m.ChannelID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *slackChannelConfigurationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.TeamID.ValueString(), m.ChannelID.ValueString()}, slackChannelConfigurationResourceIDPartCount, false)))
+}
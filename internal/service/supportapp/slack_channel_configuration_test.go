@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupportapp "github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSlackChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	// The Slack workspace must be authorized via the AWS Support Center Console.
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_add_correspondence_to_case", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "high"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_create_or_reopen_case", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_resolve_case", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsupportapp.ResourceSlackChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "high"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_resolve_case", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_notifications(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_add_correspondence_to_case", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "all"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_create_or_reopen_case", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_resolve_case", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_supportapp_slack_channel_configuration" {
+				continue
+			}
+
+			_, err := tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["team_id"], rs.Primary.Attributes["channel_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Support App Slack Channel Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationExists(ctx context.Context, n string, v *awstypes.SlackChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		output, err := tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["team_id"], rs.Primary.Attributes["channel_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSlackChannelConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "supportapp.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSSupportAppFullAccess"
+}
+`, rName)
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_supportapp_slack_channel_configuration" "test" {
+  team_id                 = %[1]q
+  channel_id              = %[2]q
+  channel_role_arn        = aws_iam_role.test.arn
+  notify_on_case_severity = "high"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_notifications(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_supportapp_slack_channel_configuration" "test" {
+  team_id                 = %[1]q
+  channel_id              = %[2]q
+  channel_role_arn        = aws_iam_role.test.arn
+  notify_on_case_severity = "all"
+
+  notify_on_add_correspondence_to_case = true
+  notify_on_create_or_reopen_case      = true
+  notify_on_resolve_case               = true
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, teamID, channelID))
+}
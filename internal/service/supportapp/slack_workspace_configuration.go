@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/supportapp"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_supportapp_slack_workspace_configuration", name="Slack Workspace Configuration")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/supportapp/types;types.SlackWorkspaceConfiguration")
+// @Testing(serialize=true)
+func newSlackWorkspaceConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &slackWorkspaceConfigurationResource{}
+
+	return r, nil
+}
+
+type slackWorkspaceConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*slackWorkspaceConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_supportapp_slack_workspace_configuration"
+}
+
+func (r *slackWorkspaceConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccountType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_organization_member_account": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"team_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *slackWorkspaceConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data slackWorkspaceConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	teamID := data.TeamID.ValueString()
+	input := &supportapp.RegisterSlackWorkspaceForOrganizationInput{
+		TeamId: aws.String(teamID),
+	}
+
+	output, err := conn.RegisterSlackWorkspaceForOrganization(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("registering Support App Slack Workspace (%s)", teamID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AccountType = fwtypes.StringEnumValue(output.AccountType)
+	data.ID = types.StringValue(teamID)
+	data.TeamName = fwflex.StringToFramework(ctx, output.TeamName)
+
+	workspace, err := findSlackWorkspaceConfigurationByTeamID(ctx, conn, teamID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Support App Slack Workspace Configuration (%s)", teamID), err.Error())
+
+		return
+	}
+
+	data.AllowOrganizationMemberAccount = fwflex.BoolToFramework(ctx, workspace.AllowOrganizationMemberAccount)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *slackWorkspaceConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data slackWorkspaceConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	output, err := findSlackWorkspaceConfigurationByTeamID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Support App Slack Workspace Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The account type is only returned from the registration call.
+	accountType := data.AccountType
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.AccountType = accountType
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *slackWorkspaceConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data slackWorkspaceConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SupportAppClient(ctx)
+
+	_, err := conn.DeleteSlackWorkspaceConfiguration(ctx, &supportapp.DeleteSlackWorkspaceConfigurationInput{
+		TeamId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Support App Slack Workspace Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findSlackWorkspaceConfiguration(ctx context.Context, conn *supportapp.Client, input *supportapp.ListSlackWorkspaceConfigurationsInput, filter tfslices.Predicate[*awstypes.SlackWorkspaceConfiguration]) (*awstypes.SlackWorkspaceConfiguration, error) {
+	output, err := findSlackWorkspaceConfigurations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSlackWorkspaceConfigurations(ctx context.Context, conn *supportapp.Client, input *supportapp.ListSlackWorkspaceConfigurationsInput, filter tfslices.Predicate[*awstypes.SlackWorkspaceConfiguration]) ([]awstypes.SlackWorkspaceConfiguration, error) {
+	var output []awstypes.SlackWorkspaceConfiguration
+
+	pages := supportapp.NewListSlackWorkspaceConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SlackWorkspaceConfigurations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findSlackWorkspaceConfigurationByTeamID(ctx context.Context, conn *supportapp.Client, teamID string) (*awstypes.SlackWorkspaceConfiguration, error) {
+	input := &supportapp.ListSlackWorkspaceConfigurationsInput{}
+
+	return findSlackWorkspaceConfiguration(ctx, conn, input, func(v *awstypes.SlackWorkspaceConfiguration) bool {
+		return aws.ToString(v.TeamId) == teamID
+	})
+}
+
+type slackWorkspaceConfigurationResourceModel struct {
+	AccountType                    fwtypes.StringEnum[awstypes.AccountType] `tfsdk:"account_type"`
+	AllowOrganizationMemberAccount types.Bool                               `tfsdk:"allow_organization_member_account"`
+	ID                             types.String                             `tfsdk:"id"`
+	TeamID                         types.String                             `tfsdk:"team_id"`
+	TeamName                       types.String                             `tfsdk:"team_name"`
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupportapp "github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSlackWorkspaceConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackWorkspaceConfiguration
+	resourceName := "aws_supportapp_slack_workspace_configuration.test"
+
+	// The Slack workspace must first be authorized via the AWS Support Center Console.
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackWorkspaceConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackWorkspaceConfigurationConfig_basic(teamID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackWorkspaceConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "account_type", "management"),
+					resource.TestCheckResourceAttrSet(resourceName, "allow_organization_member_account"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttrSet(resourceName, "team_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_type"},
+			},
+		},
+	})
+}
+
+func testAccSlackWorkspaceConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackWorkspaceConfiguration
+	resourceName := "aws_supportapp_slack_workspace_configuration.test"
+
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackWorkspaceConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackWorkspaceConfigurationConfig_basic(teamID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackWorkspaceConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsupportapp.ResourceSlackWorkspaceConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlackWorkspaceConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_supportapp_slack_workspace_configuration" {
+				continue
+			}
+
+			_, err := tfsupportapp.FindSlackWorkspaceConfigurationByTeamID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Support App Slack Workspace Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackWorkspaceConfigurationExists(ctx context.Context, n string, v *awstypes.SlackWorkspaceConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		output, err := tfsupportapp.FindSlackWorkspaceConfigurationByTeamID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSlackWorkspaceConfigurationConfig_basic(teamID string) string {
+	return fmt.Sprintf(`
+resource "aws_supportapp_slack_workspace_configuration" "test" {
+  team_id = %[1]q
+}
+`, teamID)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	envSlackTeamID    = "SUPPORTAPP_SLACK_TEAM_ID"
+	envSlackChannelID = "SUPPORTAPP_SLACK_CHANNEL_ID"
+)
+
+func TestAccSupportApp_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"SlackChannelConfiguration": {
+			acctest.CtBasic:      testAccSlackChannelConfiguration_basic,
+			acctest.CtDisappears: testAccSlackChannelConfiguration_disappears,
+			"update":             testAccSlackChannelConfiguration_update,
+		},
+		"SlackWorkspaceConfiguration": {
+			acctest.CtBasic:      testAccSlackWorkspaceConfiguration_basic,
+			acctest.CtDisappears: testAccSlackWorkspaceConfiguration_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+	_, err := conn.ListSlackWorkspaceConfigurations(ctx, &supportapp.ListSlackWorkspaceConfigurationsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfswf "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSWFActivityType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "default_task_heartbeat_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", rName),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_start_timeout", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "3300"),
+					resource.TestCheckResourceAttr(resourceName, "deprecated", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_date", ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomain, "aws_swf_domain.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfswf.ResourceActivityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_deprecated(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_deprecated(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deprecated", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_date"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccActivityTypeConfig_deprecated(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deprecated", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+				),
+			},
+			{
+				Config: testAccActivityTypeConfig_deprecated(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deprecated", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckActivityTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_swf_activity_type" {
+				continue
+			}
+
+			_, err := tfswf.FindActivityTypeByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrVersion])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SWF Activity Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckActivityTypeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		_, err = tfswf.FindActivityTypeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		return err
+	}
+}
+
+func testAccActivityTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_swf_activity_type" "test" {
+  domain  = aws_swf_domain.test.name
+  name    = %[1]q
+  version = "1.0"
+
+  default_task_heartbeat_timeout         = "300"
+  default_task_list                      = %[1]q
+  default_task_priority                  = "1"
+  default_task_schedule_to_close_timeout = "3600"
+  default_task_schedule_to_start_timeout = "NONE"
+  default_task_start_to_close_timeout    = "3300"
+}
+`, rName))
+}
+
+func testAccActivityTypeConfig_deprecated(rName string, deprecated bool) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_swf_activity_type" "test" {
+  domain     = aws_swf_domain.test.name
+  name       = %[1]q
+  version    = "1.0"
+  deprecated = %[2]t
+}
+`, rName, deprecated))
+}
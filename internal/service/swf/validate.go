@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	timeoutNone = "NONE"
+)
+
+// validTimeout validates a duration in seconds, or "NONE" for an unlimited duration.
+func validTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == timeoutNone {
+		return
+	}
+
+	if i, err := strconv.ParseInt(value, 10, 64); err != nil || i < 0 {
+		errors = append(errors, fmt.Errorf("%q must be %q or a non-negative number of seconds, got: %s", k, timeoutNone, value))
+	}
+
+	return
+}
+
+func validTaskPriority(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if i, err := strconv.ParseInt(value, 10, 64); err != nil || i < math.MinInt32 || i > math.MaxInt32 {
+		errors = append(errors, fmt.Errorf("%q must be an integer between %d and %d, got: %s", k, math.MinInt32, math.MaxInt32, value))
+	}
+
+	return
+}
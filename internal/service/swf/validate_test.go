@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"testing"
+)
+
+func TestValidTimeout(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "3600",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "none",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1h",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validTimeout(tc.Value, "default_task_start_to_close_timeout")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidTaskPriority(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-2147483648",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2147483647",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2147483648",
+			ErrCount: 1,
+		},
+		{
+			Value:    "high",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validTaskPriority(tc.Value, "default_task_priority")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
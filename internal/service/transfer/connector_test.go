@@ -84,6 +84,7 @@ func TestAccTransferConnector_sftpConfig(t *testing.T) {
 					testAccCheckConnectorExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(resourceName, "service_managed_egress_ip_addresses.#", 1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrURL, "sftp://s-fakeserver.server.transfer.test.amazonaws.com"),
 				),
 			},
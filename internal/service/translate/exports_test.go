@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+// Exports for use in tests only.
+var (
+	ResourceParallelData = newParallelDataResource
+
+	FindParallelDataByName = findParallelDataByName
+)
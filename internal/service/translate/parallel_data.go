@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_translate_parallel_data", name="Parallel Data")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/translate/types;awstypes;awstypes.ParallelDataProperties")
+// @Testing(tagsTest=false)
+func newParallelDataResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &parallelDataResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type parallelDataResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*parallelDataResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_translate_parallel_data"
+}
+
+func (r *parallelDataResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([A-Za-z0-9-]_?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			"source_language_code": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParallelDataStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_language_codes": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionKeyModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncryptionKeyType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"parallel_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parallelDataConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParallelDataFormat](),
+							Required:   true,
+						},
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`), "must be an S3 URI"),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *parallelDataResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &translate.CreateParallelDataInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateParallelData(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Translate Parallel Data (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	output, err := waitParallelDataCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) create", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *parallelDataResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	output, err := findParallelDataByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Translate Parallel Data (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parallelDataResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new parallelDataResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	// The data location is updated in place. Translate imports the new data in the
+	// background and only swaps it in once the import has succeeded.
+	if !new.Description.Equal(old.Description) || !new.ParallelDataConfig.Equal(old.ParallelDataConfig) {
+		name := new.ID.ValueString()
+		input := &translate.UpdateParallelDataInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(id.UniqueId())
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, r.UpdateTimeout(ctx, new.Timeouts), func() (interface{}, error) {
+			return conn.UpdateParallelData(ctx, input)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Translate Parallel Data (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := waitParallelDataUpdated(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) update", name), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.SourceLanguageCode = old.SourceLanguageCode
+		new.Status = old.Status
+		new.TargetLanguageCodes = old.TargetLanguageCodes
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *parallelDataResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.DeleteParallelData(ctx, &translate.DeleteParallelDataInput{
+			Name: aws.String(data.ID.ValueString()),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Translate Parallel Data (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *parallelDataResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findParallelDataByName(ctx context.Context, conn *translate.Client, name string) (*awstypes.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelData(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// statusParallelDataLatestUpdateAttempt tracks the most recent update rather than the
+// overall status, which remains ACTIVE while the previous data is still being served.
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusCreating),
+		Target:  enum.Slice(awstypes.ParallelDataStatusActive),
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusUpdating),
+		Target:  enum.Slice(awstypes.ParallelDataStatusActive),
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusActive, awstypes.ParallelDataStatusDeleting, awstypes.ParallelDataStatusFailed),
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type parallelDataResourceModel struct {
+	ARN                 types.String                                             `tfsdk:"arn"`
+	Description         types.String                                             `tfsdk:"description"`
+	EncryptionKey       fwtypes.ListNestedObjectValueOf[encryptionKeyModel]      `tfsdk:"encryption_key"`
+	ID                  types.String                                             `tfsdk:"id"`
+	Name                types.String                                             `tfsdk:"name"`
+	ParallelDataConfig  fwtypes.ListNestedObjectValueOf[parallelDataConfigModel] `tfsdk:"parallel_data_config"`
+	SourceLanguageCode  types.String                                             `tfsdk:"source_language_code"`
+	Status              fwtypes.StringEnum[awstypes.ParallelDataStatus]          `tfsdk:"status"`
+	Tags                tftags.Map                                               `tfsdk:"tags"`
+	TagsAll             tftags.Map                                               `tfsdk:"tags_all"`
+	TargetLanguageCodes fwtypes.ListValueOf[types.String]                        `tfsdk:"target_language_codes"`
+	Timeouts            timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type encryptionKeyModel struct {
+	ID   types.String                                   `tfsdk:"id"`
+	Type fwtypes.StringEnum[awstypes.EncryptionKeyType] `tfsdk:"type"`
+}
+
+type parallelDataConfigModel struct {
+	Format fwtypes.StringEnum[awstypes.ParallelDataFormat] `tfsdk:"format"`
+	S3URI  types.String                                    `tfsdk:"s3_uri"`
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "first.tsv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "translate", fmt.Sprintf("parallel-data/%s", rName)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", string(awstypes.ParallelDataFormatTsv)),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ParallelDataStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "es"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "first.tsv"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceParallelData, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "first.tsv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/first.tsv", rName)),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_basic(rName, "second.tsv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v2),
+					testAccCheckParallelDataNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/second.tsv", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ParallelDataStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_encryptionKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_encryptionKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key.0.id", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.0.type", string(awstypes.EncryptionKeyTypeKms)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_parallel_data" {
+				continue
+			}
+
+			_, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataExists(ctx context.Context, n string, v *awstypes.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		output, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataNotRecreated(before, after *awstypes.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreatedAt), aws.ToTime(after.CreatedAt); !before.Equal(after) {
+			return fmt.Errorf("Translate Parallel Data recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_base(rName, key string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = %[2]q
+  content = "en\tes\nhello\thola\ngoodbye\tadiós\n"
+}
+`, rName, key)
+}
+
+func testAccParallelDataConfig_basic(rName, key string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, key), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_encryptionKey(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, "first.tsv"), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  encryption_key {
+    id   = aws_kms_key.test.arn
+    type = "KMS"
+  }
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
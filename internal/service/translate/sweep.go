@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_translate_parallel_data", sweepParallelData)
+}
+
+func sweepParallelData(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.TranslateClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := translate.NewListParallelDataPaginator(conn, &translate.ListParallelDataInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ParallelDataPropertiesList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newParallelDataResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_trustedadvisor_recommendation_resource_exclusion", name="Recommendation Resource Exclusion")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types;types.RecommendationResourceSummary")
+func newRecommendationResourceExclusionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recommendationResourceExclusionResource{}
+
+	return r, nil
+}
+
+type recommendationResourceExclusionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (*recommendationResourceExclusionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_trustedadvisor_recommendation_resource_exclusion"
+}
+
+func (r *recommendationResourceExclusionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_resource_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"recommendation_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region_code": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *recommendationResourceExclusionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommendationResourceExclusionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TrustedAdvisorClient(ctx)
+
+	if err := updateRecommendationResourceExclusion(ctx, conn, data.ResourceARN.ValueString(), true); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Trusted Advisor Recommendation Resource Exclusion (%s)", data.ResourceARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findExcludedRecommendationResourceByTwoPartKey(ctx, conn, data.RecommendationIdentifier.ValueString(), data.ResourceARN.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Trusted Advisor Recommendation Resource Exclusion (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AWSResourceID = fwflex.StringToFramework(ctx, output.AwsResourceId)
+	data.RegionCode = fwflex.StringToFramework(ctx, output.RegionCode)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recommendationResourceExclusionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommendationResourceExclusionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().TrustedAdvisorClient(ctx)
+
+	output, err := findExcludedRecommendationResourceByTwoPartKey(ctx, conn, data.RecommendationIdentifier.ValueString(), data.ResourceARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Trusted Advisor Recommendation Resource Exclusion (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AWSResourceID = fwflex.StringToFramework(ctx, output.AwsResourceId)
+	data.RegionCode = fwflex.StringToFramework(ctx, output.RegionCode)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationResourceExclusionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recommendationResourceExclusionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TrustedAdvisorClient(ctx)
+
+	err := updateRecommendationResourceExclusion(ctx, conn, data.ResourceARN.ValueString(), false)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Trusted Advisor Recommendation Resource Exclusion (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *recommendationResourceExclusionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func updateRecommendationResourceExclusion(ctx context.Context, conn *trustedadvisor.Client, arn string, excluded bool) error {
+	input := &trustedadvisor.BatchUpdateRecommendationResourceExclusionInput{
+		RecommendationResourceExclusions: []awstypes.RecommendationResourceExclusion{{
+			Arn:        aws.String(arn),
+			IsExcluded: aws.Bool(excluded),
+		}},
+	}
+
+	output, err := conn.BatchUpdateRecommendationResourceExclusion(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(tfslices.ApplyToAll(output.BatchUpdateRecommendationResourceExclusionErrors, func(v awstypes.UpdateRecommendationResourceExclusionError) error {
+		return fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+	})...)
+}
+
+func findRecommendationResource(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListRecommendationResourcesInput, filter tfslices.Predicate[*awstypes.RecommendationResourceSummary]) (*awstypes.RecommendationResourceSummary, error) {
+	output, err := findRecommendationResources(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRecommendationResources(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListRecommendationResourcesInput, filter tfslices.Predicate[*awstypes.RecommendationResourceSummary]) ([]awstypes.RecommendationResourceSummary, error) {
+	var output []awstypes.RecommendationResourceSummary
+
+	pages := trustedadvisor.NewListRecommendationResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationResourceSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findExcludedRecommendationResourceByTwoPartKey(ctx context.Context, conn *trustedadvisor.Client, recommendationIdentifier, arn string) (*awstypes.RecommendationResourceSummary, error) {
+	input := &trustedadvisor.ListRecommendationResourcesInput{
+		ExclusionStatus:          awstypes.ExclusionStatusExcluded,
+		RecommendationIdentifier: aws.String(recommendationIdentifier),
+	}
+
+	return findRecommendationResource(ctx, conn, input, func(v *awstypes.RecommendationResourceSummary) bool {
+		return aws.ToString(v.Arn) == arn
+	})
+}
+
+type recommendationResourceExclusionResourceModel struct {
+	AWSResourceID            types.String `tfsdk:"aws_resource_id"`
+	ID                       types.String `tfsdk:"id"`
+	RecommendationIdentifier types.String `tfsdk:"recommendation_identifier"`
+	RegionCode               types.String `tfsdk:"region_code"`
+	ResourceARN              fwtypes.ARN  `tfsdk:"resource_arn"`
+}
+
+const (
+	recommendationResourceExclusionResourceIDPartCount = 2
+)
+
+func (m *recommendationResourceExclusionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), recommendationResourceExclusionResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.RecommendationIdentifier = types.StringValue(parts[0])
+	m.ResourceARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (m *recommendationResourceExclusionResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.RecommendationIdentifier.ValueString(), m.ResourceARN.ValueString()}, recommendationResourceExclusionResourceIDPartCount, false)))
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftrustedadvisor "github.com/hashicorp/terraform-provider-aws/internal/service/trustedadvisor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envRecommendationID          = "TRUSTEDADVISOR_RECOMMENDATION_ID"
+	envRecommendationResourceARN = "TRUSTEDADVISOR_RECOMMENDATION_RESOURCE_ARN"
+)
+
+func TestAccTrustedAdvisorRecommendationResourceExclusion_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccRecommendationResourceExclusion_basic,
+		acctest.CtDisappears: testAccRecommendationResourceExclusion_disappears,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccRecommendationResourceExclusion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationResourceSummary
+	resourceName := "aws_trustedadvisor_recommendation_resource_exclusion.test"
+
+	// Recommendation resources are generated by Trusted Advisor checks and can't be created directly.
+	recommendationID := acctest.SkipIfEnvVarNotSet(t, envRecommendationID)
+	resourceARN := acctest.SkipIfEnvVarNotSet(t, envRecommendationResourceARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TrustedAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationResourceExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationResourceExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "aws_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "recommendation_identifier", recommendationID),
+					resource.TestCheckResourceAttrSet(resourceName, "region_code"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceARN, resourceARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationResourceExclusion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationResourceSummary
+	resourceName := "aws_trustedadvisor_recommendation_resource_exclusion.test"
+
+	recommendationID := acctest.SkipIfEnvVarNotSet(t, envRecommendationID)
+	resourceARN := acctest.SkipIfEnvVarNotSet(t, envRecommendationResourceARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TrustedAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationResourceExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationResourceExclusionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftrustedadvisor.ResourceRecommendationResourceExclusion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationResourceExclusionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TrustedAdvisorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_trustedadvisor_recommendation_resource_exclusion" {
+				continue
+			}
+
+			_, err := tftrustedadvisor.FindExcludedRecommendationResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["recommendation_identifier"], rs.Primary.Attributes[names.AttrResourceARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Trusted Advisor Recommendation Resource Exclusion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationResourceExclusionExists(ctx context.Context, n string, v *awstypes.RecommendationResourceSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TrustedAdvisorClient(ctx)
+
+		output, err := tftrustedadvisor.FindExcludedRecommendationResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["recommendation_identifier"], rs.Primary.Attributes[names.AttrResourceARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecommendationResourceExclusionConfig_basic(recommendationID, resourceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_trustedadvisor_recommendation_resource_exclusion" "test" {
+  recommendation_identifier = %[1]q
+  resource_arn              = %[2]q
+}
+`, recommendationID, resourceARN)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_trustedadvisor_recommendations", name="Recommendations")
+func newRecommendationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recommendationsDataSource{}, nil
+}
+
+type recommendationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*recommendationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_trustedadvisor_recommendations"
+}
+
+func (d *recommendationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_service": schema.StringAttribute{
+				Optional: true,
+			},
+			"check_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"pillar": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecommendationPillar](),
+				Optional:   true,
+			},
+			"recommendations": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recommendationSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[recommendationSummaryModel](ctx),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecommendationSource](),
+				Optional:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecommendationStatus](),
+				Optional:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecommendationType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *recommendationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recommendationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TrustedAdvisorClient(ctx)
+
+	input := &trustedadvisor.ListRecommendationsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	recommendations, err := findRecommendations(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Trusted Advisor Recommendations", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, recommendations, &data.RecommendationSummaries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().AccountID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRecommendations(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListRecommendationsInput) ([]awstypes.RecommendationSummary, error) {
+	var output []awstypes.RecommendationSummary
+
+	pages := trustedadvisor.NewListRecommendationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RecommendationSummaries...)
+	}
+
+	return output, nil
+}
+
+type recommendationsDataSourceModel struct {
+	AWSService              types.String                                                `tfsdk:"aws_service"`
+	CheckIdentifier         types.String                                                `tfsdk:"check_identifier"`
+	ID                      types.String                                                `tfsdk:"id"`
+	Pillar                  fwtypes.StringEnum[awstypes.RecommendationPillar]           `tfsdk:"pillar"`
+	RecommendationSummaries fwtypes.ListNestedObjectValueOf[recommendationSummaryModel] `tfsdk:"recommendations"`
+	Source                  fwtypes.StringEnum[awstypes.RecommendationSource]           `tfsdk:"source"`
+	Status                  fwtypes.StringEnum[awstypes.RecommendationStatus]           `tfsdk:"status"`
+	Type                    fwtypes.StringEnum[awstypes.RecommendationType]             `tfsdk:"type"`
+}
+
+type recommendationSummaryModel struct {
+	ARN                 types.String                                                            `tfsdk:"arn"`
+	AWSServices         fwtypes.ListValueOf[types.String]                                       `tfsdk:"aws_services"`
+	CheckARN            types.String                                                            `tfsdk:"check_arn"`
+	CreatedAt           timetypes.RFC3339                                                       `tfsdk:"created_at"`
+	ID                  types.String                                                            `tfsdk:"id"`
+	LastUpdatedAt       timetypes.RFC3339                                                       `tfsdk:"last_updated_at"`
+	LifecycleStage      fwtypes.StringEnum[awstypes.RecommendationLifecycleStage]               `tfsdk:"lifecycle_stage"`
+	Name                types.String                                                            `tfsdk:"name"`
+	Pillars             fwtypes.ListValueOf[types.String]                                       `tfsdk:"pillars"`
+	ResourcesAggregates fwtypes.ListNestedObjectValueOf[recommendationResourcesAggregatesModel] `tfsdk:"resources_aggregates"`
+	Source              fwtypes.StringEnum[awstypes.RecommendationSource]                       `tfsdk:"source"`
+	Status              fwtypes.StringEnum[awstypes.RecommendationStatus]                       `tfsdk:"status"`
+	Type                fwtypes.StringEnum[awstypes.RecommendationType]                         `tfsdk:"type"`
+}
+
+type recommendationResourcesAggregatesModel struct {
+	ErrorCount   types.Int64 `tfsdk:"error_count"`
+	OkCount      types.Int64 `tfsdk:"ok_count"`
+	WarningCount types.Int64 `tfsdk:"warning_count"`
+}
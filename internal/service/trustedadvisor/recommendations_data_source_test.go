@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTrustedAdvisorRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_trustedadvisor_recommendations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TrustedAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+				),
+			},
+			{
+				Config: testAccRecommendationsDataSourceConfig_filtered,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "pillar", "security"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRecommendationsDataSourceConfig_basic = `
+data "aws_trustedadvisor_recommendations" "test" {}
+`
+
+const testAccRecommendationsDataSourceConfig_filtered = `
+data "aws_trustedadvisor_recommendations" "test" {
+  pillar = "security"
+  status = "warning"
+}
+`
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Policies")
+func newDataSourcePolicies(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePolicies{}, nil
+}
+
+const (
+	DSNamePolicies = "Policies Data Source"
+)
+
+type dataSourcePolicies struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourcePolicies) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_verifiedpermissions_policies"
+}
+
+func (d *dataSourcePolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entityBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[policiesEntityFilter](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"entity_id": schema.StringAttribute{
+						Required: true,
+					},
+					"entity_type": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policies": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyItemDataSource](ctx),
+				Computed:   true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+			},
+			"policy_template_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"principal": entityBlock(),
+			"resource":  entityBlock(),
+		},
+	}
+}
+
+func (d *dataSourcePolicies) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().VerifiedPermissionsClient(ctx)
+
+	var data dataSourcePoliciesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := data.PolicyStoreID.ValueString()
+	filter := &awstypes.PolicyFilter{
+		PolicyTemplateId: fwflex.StringFromFramework(ctx, data.PolicyTemplateID),
+		PolicyType:       data.PolicyType.ValueEnum(),
+	}
+
+	principal, diags := data.Principal.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	res, diags := data.Resource.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if principal != nil {
+		filter.Principal = principal.expand(ctx)
+	}
+
+	if res != nil {
+		filter.Resource = res.expand(ctx)
+	}
+
+	in := &verifiedpermissions.ListPoliciesInput{
+		Filter:        filter,
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	out, err := findPolicies(ctx, conn, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNamePolicies, policyStoreID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var policies []*policyItemDataSource
+	for _, v := range out {
+		policies = append(policies, flattenPolicyItem(ctx, v))
+	}
+
+	data.Policies = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policies)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findPolicies(ctx context.Context, conn *verifiedpermissions.Client, in *verifiedpermissions.ListPoliciesInput) ([]awstypes.PolicyItem, error) {
+	var out []awstypes.PolicyItem
+
+	pages := verifiedpermissions.NewListPoliciesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.Policies...)
+	}
+
+	return out, nil
+}
+
+func flattenPolicyItem(ctx context.Context, apiObject awstypes.PolicyItem) *policyItemDataSource {
+	policyID, policyStoreID := aws.ToString(apiObject.PolicyId), aws.ToString(apiObject.PolicyStoreId)
+
+	tfObject := &policyItemDataSource{
+		CreatedDate:      timetypes.NewRFC3339TimePointerValue(apiObject.CreatedDate),
+		Effect:           fwtypes.StringEnumValue(apiObject.Effect),
+		ID:               types.StringValue(errs.Must(interflex.FlattenResourceId([]string{policyID, policyStoreID}, ResourcePolicyIDPartsCount, false))),
+		LastUpdatedDate:  timetypes.NewRFC3339TimePointerValue(apiObject.LastUpdatedDate),
+		PolicyID:         types.StringValue(policyID),
+		PolicyStoreID:    types.StringValue(policyStoreID),
+		PolicyTemplateID: types.StringNull(),
+		PolicyType:       fwtypes.StringEnumValue(apiObject.PolicyType),
+	}
+
+	if v, ok := apiObject.Definition.(*awstypes.PolicyDefinitionItemMemberTemplateLinked); ok {
+		tfObject.PolicyTemplateID = fwflex.StringToFramework(ctx, v.Value.PolicyTemplateId)
+	}
+
+	if v := apiObject.Principal; v != nil {
+		tfObject.PrincipalEntityID = fwflex.StringToFramework(ctx, v.EntityId)
+		tfObject.PrincipalEntityType = fwflex.StringToFramework(ctx, v.EntityType)
+	} else {
+		tfObject.PrincipalEntityID = types.StringNull()
+		tfObject.PrincipalEntityType = types.StringNull()
+	}
+
+	if v := apiObject.Resource; v != nil {
+		tfObject.ResourceEntityID = fwflex.StringToFramework(ctx, v.EntityId)
+		tfObject.ResourceEntityType = fwflex.StringToFramework(ctx, v.EntityType)
+	} else {
+		tfObject.ResourceEntityID = types.StringNull()
+		tfObject.ResourceEntityType = types.StringNull()
+	}
+
+	return tfObject
+}
+
+type dataSourcePoliciesData struct {
+	Policies         fwtypes.ListNestedObjectValueOf[policyItemDataSource] `tfsdk:"policies"`
+	PolicyStoreID    types.String                                          `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String                                          `tfsdk:"policy_template_id"`
+	PolicyType       fwtypes.StringEnum[awstypes.PolicyType]               `tfsdk:"policy_type"`
+	Principal        fwtypes.ListNestedObjectValueOf[policiesEntityFilter] `tfsdk:"principal"`
+	Resource         fwtypes.ListNestedObjectValueOf[policiesEntityFilter] `tfsdk:"resource"`
+}
+
+type policiesEntityFilter struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+func (m *policiesEntityFilter) expand(ctx context.Context) awstypes.EntityReference {
+	return &awstypes.EntityReferenceMemberIdentifier{
+		Value: awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, m.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, m.EntityType),
+		},
+	}
+}
+
+type policyItemDataSource struct {
+	CreatedDate         timetypes.RFC3339                         `tfsdk:"created_date"`
+	Effect              fwtypes.StringEnum[awstypes.PolicyEffect] `tfsdk:"effect"`
+	ID                  types.String                              `tfsdk:"id"`
+	LastUpdatedDate     timetypes.RFC3339                         `tfsdk:"last_updated_date"`
+	PolicyID            types.String                              `tfsdk:"policy_id"`
+	PolicyStoreID       types.String                              `tfsdk:"policy_store_id"`
+	PolicyTemplateID    types.String                              `tfsdk:"policy_template_id"`
+	PolicyType          fwtypes.StringEnum[awstypes.PolicyType]   `tfsdk:"policy_type"`
+	PrincipalEntityID   types.String                              `tfsdk:"principal_entity_id"`
+	PrincipalEntityType types.String                              `tfsdk:"principal_entity_type"`
+	ResourceEntityID    types.String                              `tfsdk:"resource_entity_id"`
+	ResourceEntityType  types.String                              `tfsdk:"resource_entity_type"`
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_policies.test"
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.0.created_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.effect", "Permit"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.policy_id", resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.policy_store_id", resourceName, "policy_store_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.policy_template_id", resourceName, "definition.0.template_linked.0.policy_template_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.policy_type", "TEMPLATE_LINKED"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.principal_entity_id", "TestUsers"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.principal_entity_type", "User"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.resource_entity_id", "test_album"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.resource_entity_type", "Album"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_templateLinked(rName), `
+resource "aws_verifiedpermissions_policy" "static" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      statement = "permit (principal, action == Action::\"view\", resource in Album::\"test_album\");"
+    }
+  }
+}
+
+data "aws_verifiedpermissions_policies" "test" {
+  policy_store_id    = aws_verifiedpermissions_policy.test.policy_store_id
+  policy_template_id = aws_verifiedpermissions_policy.test.definition[0].template_linked[0].policy_template_id
+
+  principal {
+    entity_id   = "TestUsers"
+    entity_type = "User"
+  }
+
+  depends_on = [aws_verifiedpermissions_policy.static]
+}
+`)
+}
@@ -16,6 +16,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourcePolicies,
+			Name:    "Policies",
+		},
 		{
 			Factory: newDataSourcePolicyStore,
 			Name:    "Policy Store",
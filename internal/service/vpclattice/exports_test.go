@@ -5,23 +5,29 @@ package vpclattice
 
 // Exports for use in tests only.
 var (
-	FindAccessLogSubscriptionByID            = findAccessLogSubscriptionByID
-	FindListenerByTwoPartKey                 = findListenerByTwoPartKey
-	FindServiceByID                          = findServiceByID
-	FindServiceNetworkByID                   = findServiceNetworkByID
-	FindServiceNetworkServiceAssociationByID = findServiceNetworkServiceAssociationByID
-	FindServiceNetworkVPCAssociationByID     = findServiceNetworkVPCAssociationByID
-	FindTargetByThreePartKey                 = findTargetByThreePartKey
+	FindAccessLogSubscriptionByID             = findAccessLogSubscriptionByID
+	FindListenerByTwoPartKey                  = findListenerByTwoPartKey
+	FindResourceConfigurationByID             = findResourceConfigurationByID
+	FindResourceGatewayByID                   = findResourceGatewayByID
+	FindServiceByID                           = findServiceByID
+	FindServiceNetworkByID                    = findServiceNetworkByID
+	FindServiceNetworkResourceAssociationByID = findServiceNetworkResourceAssociationByID
+	FindServiceNetworkServiceAssociationByID  = findServiceNetworkServiceAssociationByID
+	FindServiceNetworkVPCAssociationByID      = findServiceNetworkVPCAssociationByID
+	FindTargetByThreePartKey                  = findTargetByThreePartKey
 
 	IDFromIDOrARN                               = idFromIDOrARN
 	SuppressEquivalentCloudWatchLogsLogGroupARN = suppressEquivalentCloudWatchLogsLogGroupARN
 	SuppressEquivalentIDOrARN                   = suppressEquivalentIDOrARN
 
-	ResourceAccessLogSubscription            = resourceAccessLogSubscription
-	ResourceListener                         = resourceListener
-	ResourceService                          = resourceService
-	ResourceServiceNetwork                   = resourceServiceNetwork
-	ResourceServiceNetworkServiceAssociation = resourceServiceNetworkServiceAssociation
-	ResourceServiceNetworkVPCAssociation     = resourceServiceNetworkVPCAssociation
-	ResourceTargetGroupAttachment            = resourceTargetGroupAttachment
+	ResourceAccessLogSubscription             = resourceAccessLogSubscription
+	ResourceListener                          = resourceListener
+	ResourceResourceConfiguration             = resourceResourceConfiguration
+	ResourceResourceGateway                   = resourceResourceGateway
+	ResourceService                           = resourceService
+	ResourceServiceNetwork                    = resourceServiceNetwork
+	ResourceServiceNetworkResourceAssociation = resourceServiceNetworkResourceAssociation
+	ResourceServiceNetworkServiceAssociation  = resourceServiceNetworkServiceAssociation
+	ResourceServiceNetworkVPCAssociation      = resourceServiceNetworkVPCAssociation
+	ResourceTargetGroupAttachment             = resourceTargetGroupAttachment
 )
@@ -82,6 +82,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceResourceConfiguration,
+			TypeName: "aws_vpclattice_resource_configuration",
+			Name:     "Resource Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceResourceGateway,
+			TypeName: "aws_vpclattice_resource_gateway",
+			Name:     "Resource Gateway",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceResourcePolicy,
 			TypeName: "aws_vpclattice_resource_policy",
@@ -103,6 +119,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceServiceNetworkResourceAssociation,
+			TypeName: "aws_vpclattice_service_network_resource_association",
+			Name:     "Service Network Resource Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceServiceNetworkServiceAssociation,
 			TypeName: "aws_vpclattice_service_network_service_association",
@@ -985,19 +985,53 @@ func expandHeaderMatchPattern(l []interface{}) *awstypes.HeaderMatchPattern {
 func expandWebACLRulesJSON(rawRules string) ([]awstypes.Rule, error) {
 	var rules []awstypes.Rule
 
-	err := json.Unmarshal([]byte(rawRules), &rules)
-	if err != nil {
+	if err := json.Unmarshal([]byte(rawRules), &rules); err != nil {
 		return nil, fmt.Errorf("decoding JSON: %s", err)
 	}
 
+	ruleNames := make(map[string]struct{}, len(rules))
+	priorities := make(map[int32]struct{}, len(rules))
+
 	for i, r := range rules {
 		if reflect.DeepEqual(r, awstypes.Rule{}) {
 			return nil, fmt.Errorf("invalid ACL Rule supplied at index (%d)", i)
 		}
+
+		name := aws.ToString(r.Name)
+		if name == "" {
+			return nil, fmt.Errorf("rule at index (%d): Name is required", i)
+		}
+		if r.Statement == nil {
+			return nil, fmt.Errorf("rule (%s): Statement is required", name)
+		}
+		if r.VisibilityConfig == nil {
+			return nil, fmt.Errorf("rule (%s): VisibilityConfig is required", name)
+		}
+
+		if _, ok := ruleNames[name]; ok {
+			return nil, fmt.Errorf("duplicate rule Name (%s)", name)
+		}
+		ruleNames[name] = struct{}{}
+
+		if _, ok := priorities[r.Priority]; ok {
+			return nil, fmt.Errorf("rule (%s): duplicate Priority (%d)", name, r.Priority)
+		}
+		priorities[r.Priority] = struct{}{}
 	}
+
 	return rules, nil
 }
 
+// rulesJSONUnknownFieldsError returns an error if the rules JSON contains fields, e.g. misspelled ones, that are silently dropped when decoded.
+func rulesJSONUnknownFieldsError(rawRules string) error {
+	var rules []awstypes.Rule
+
+	decoder := json.NewDecoder(strings.NewReader(rawRules))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(&rules)
+}
+
 func expandWebACLRules(l []interface{}) []awstypes.Rule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
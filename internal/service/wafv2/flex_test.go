@@ -65,6 +65,30 @@ func Test_expandWebACLRulesJSON(t *testing.T) {
 				},
 			},
 		},
+		"unknown field": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statemnt":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+			wantErr:  true,
+		},
+		"missing name": {
+			rawRules: `[{"Action":{"Count":{}},"Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+			wantErr:  true,
+		},
+		"missing statement": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+			wantErr:  true,
+		},
+		"missing visibility config": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}]`,
+			wantErr:  true,
+		},
+		"duplicate name": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}},{"Action":{"Count":{}},"Name":"rule-1","Priority":2,"Statement":{"GeoMatchStatement":{"CountryCodes":["NL"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+			wantErr:  true,
+		},
+		"duplicate priority": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}},{"Action":{"Count":{}},"Name":"rule-2","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["NL"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+			wantErr:  true,
+		},
 		"valid and empty object": {
 			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","EvaluationWindowSec":600,"Limit":10000,"ScopeDownStatement":{"GeoMatchStatement":{"CountryCodes":["US","NL"]}}}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}},{}]`,
 			wantErr:  true,
@@ -96,3 +120,35 @@ func Test_expandWebACLRulesJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_rulesJSONUnknownFieldsError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawRules string
+		wantErr  bool
+	}{
+		"known fields": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false}}]`,
+		},
+		"unknown field": {
+			rawRules: `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudwatchMetricsEnabled":false,"MetricName":"friendly-rule-metric-name","SampledRequestsEnabled":false},"Labels":[]}]`,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Unknown fields are ignored when expanding web ACL rules.
+			if _, err := expandWebACLRulesJSON(tc.rawRules); err != nil {
+				t.Fatalf("expandWebACLRulesJSON() error = %v", err)
+			}
+
+			if err := rulesJSONUnknownFieldsError(tc.rawRules); (err != nil) != tc.wantErr {
+				t.Errorf("rulesJSONUnknownFieldsError() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
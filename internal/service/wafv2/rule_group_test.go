@@ -2481,6 +2481,42 @@ func testAccPreCheckScopeRegional(ctx context.Context, t *testing.T) {
 	}
 }
 
+func TestAccWAFV2RuleGroup_ruleJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleGroup
+	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_ruleJSON(ruleGroupName, "Statemnt"),
+				ExpectError: regexache.MustCompile(`contains an invalid rule`),
+			},
+			{
+				Config: testAccRuleGroupConfig_ruleJSON(ruleGroupName, "Statement"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wafv2", regexache.MustCompile(`regional/rulegroup/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_json"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule_json", names.AttrRule},
+				ImportStateIdFunc:       testAccRuleGroupImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func testAccCheckRuleGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -5413,3 +5449,64 @@ resource "aws_wafv2_rule_group" "test" {
 }
 `, rName)
 }
+
+func testAccRuleGroupConfig_ruleJSON(rName, statementKey string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_rule_group" "test" {
+  capacity    = 100
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    Priority = 1
+    Action = {
+      Count = {}
+    }
+    %[2]s = {
+      OrStatement = {
+        Statements = [
+          {
+            AndStatement = {
+              Statements = [
+                {
+                  NotStatement = {
+                    Statement = {
+                      GeoMatchStatement = {
+                        CountryCodes = ["US"]
+                      }
+                    }
+                  }
+                },
+                {
+                  GeoMatchStatement = {
+                    CountryCodes = ["NL"]
+                  }
+                },
+              ]
+            }
+          },
+          {
+            GeoMatchStatement = {
+              CountryCodes = ["CA"]
+            }
+          },
+        ]
+      }
+    }
+    VisibilityConfig = {
+      CloudwatchMetricsEnabled = false
+      MetricName               = "friendly-rule-metric-name"
+      SampledRequestsEnabled   = false
+    }
+  }])
+}
+`, rName, statementKey)
+}
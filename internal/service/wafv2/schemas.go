@@ -4,11 +4,13 @@
 package wafv2
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,6 +30,79 @@ func emptySchema() *schema.Schema {
 	return listOfEmptyObjectSchema
 }
 
+func ruleJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{names.AttrRule},
+		ValidateFunc: validation.All(
+			validation.StringIsJSON,
+			validRulesJSON,
+			warnRulesJSONUnknownFields,
+		),
+		DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+// ruleGroupRuleJSONSchema returns the rule_json schema for rule groups, which rejects unknown fields.
+func ruleGroupRuleJSONSchema() *schema.Schema {
+	s := ruleJSONSchema()
+	s.ValidateFunc = validation.All(
+		validation.StringIsJSON,
+		validRulesJSON,
+		validRulesJSONKnownFields,
+	)
+
+	return s
+}
+
+func validRulesJSON(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := expandWebACLRulesJSON(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid rule: %w", k, err))
+	}
+
+	return
+}
+
+func validRulesJSONKnownFields(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := rulesJSONUnknownFieldsError(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid rule: %w", k, err))
+	}
+
+	return
+}
+
+// warnRulesJSONUnknownFields warns about, rather than rejects, unknown fields so that existing web ACL configurations continue to apply.
+func warnRulesJSONUnknownFields(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := rulesJSONUnknownFieldsError(value); err != nil {
+		ws = append(ws, fmt.Sprintf("%q contains a field that is ignored: %s", k, err))
+	}
+
+	return
+}
+
 func ruleLabelsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -44,6 +44,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_wafv2_web_acl",
 			Name:     "Web ACL",
 		},
+		{
+			Factory:  dataSourceWebACLAssociations,
+			TypeName: "aws_wafv2_web_acl_associations",
+			Name:     "Web ACL Associations",
+		},
 	}
 }
 
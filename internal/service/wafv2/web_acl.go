@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -102,17 +101,7 @@ func resourceWebACL() *schema.Resource {
 						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric hyphen and underscore characters"),
 					),
 				},
-				"rule_json": {
-					Type:             schema.TypeString,
-					Optional:         true,
-					ConflictsWith:    []string{names.AttrRule},
-					ValidateFunc:     validation.StringIsJSON,
-					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
-					StateFunc: func(v interface{}) string {
-						json, _ := structure.NormalizeJsonString(v)
-						return json
-					},
-				},
+				"rule_json": ruleJSONSchema(),
 				names.AttrRule: {
 					Type:          schema.TypeSet,
 					Optional:      true,
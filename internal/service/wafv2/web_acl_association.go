@@ -5,10 +5,13 @@ package wafv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -48,7 +51,7 @@ func resourceWebACLAssociation() *schema.Resource {
 					Type:         schema.TypeString,
 					ForceNew:     true,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNCheck(webACLAssociationResourceARNCheck),
 				},
 				"web_acl_arn": {
 					Type:         schema.TypeString,
@@ -165,3 +168,30 @@ func findWebACLByResourceARN(ctx context.Context, conn *wafv2.Client, arn string
 
 	return output.WebACL, nil
 }
+
+// webACLAssociationResourceARNCheck verifies that the ARN is of a resource type that can be associated with a regional web ACL:
+// Application Load Balancer, API Gateway REST API stage, AppSync GraphQL API, Cognito user pool, App Runner service or Verified Access instance.
+func webACLAssociationResourceARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	var ok bool
+
+	switch arn.Service {
+	case "apigateway":
+		ok = strings.HasPrefix(arn.Resource, "/restapis/") && strings.Contains(arn.Resource, "/stages/")
+	case "apprunner":
+		ok = strings.HasPrefix(arn.Resource, "service/")
+	case "appsync":
+		ok = strings.HasPrefix(arn.Resource, "apis/")
+	case "cognito-idp":
+		ok = strings.HasPrefix(arn.Resource, "userpool/")
+	case "ec2":
+		ok = strings.HasPrefix(arn.Resource, "verified-access-instance/")
+	case "elasticloadbalancing":
+		ok = strings.HasPrefix(arn.Resource, "loadbalancer/app/")
+	}
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q (%s) is not the ARN of a resource type that can be associated with a web ACL", k, v))
+	}
+
+	return
+}
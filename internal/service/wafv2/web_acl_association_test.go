@@ -78,6 +78,42 @@ func TestAccWAFV2WebACLAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACLAssociation_cognitoUserPool(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_association.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckScopeRegional(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebACLAssociationConfig_unsupportedResource(rName),
+				ExpectError: regexache.MustCompile(`is not the ARN of a resource type that can be associated with a web ACL`),
+			},
+			{
+				Config: testAccWebACLAssociationConfig_cognitoUserPool(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, userPoolResourceName, names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "web_acl_arn", "wafv2", regexache.MustCompile(fmt.Sprintf("regional/webacl/%s/.*", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckWebACLAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -177,3 +213,45 @@ resource "aws_wafv2_web_acl_association" "test" {
 }
 `, name)
 }
+
+func testAccWebACLAssociationConfig_webACLBase(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name)
+}
+
+func testAccWebACLAssociationConfig_cognitoUserPool(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationConfig_webACLBase(name), fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_cognito_user_pool.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, name))
+}
+
+// lintignore:AWSAT003,AWSAT005
+func testAccWebACLAssociationConfig_unsupportedResource(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationConfig_webACLBase(name), `
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = "arn:aws:sns:us-west-2:123456789012:example"
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_wafv2_web_acl_associations", name="Web ACL Associations")
+func dataSourceWebACLAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWebACLAssociationsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"resource_arns": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrResourceType: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.ResourceType](),
+				},
+				"web_acl_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceWebACLAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN := d.Get("web_acl_arn").(string)
+
+	// If no resource type is specified, list associations of every type.
+	resourceTypes := enum.EnumValues[awstypes.ResourceType]()
+	if v, ok := d.GetOk(names.AttrResourceType); ok {
+		resourceTypes = []awstypes.ResourceType{awstypes.ResourceType(v.(string))}
+	}
+
+	var resourceARNs []string
+
+	for _, resourceType := range resourceTypes {
+		output, err := findResourcesForWebACL(ctx, conn, webACLARN, resourceType)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s) %s associations: %s", webACLARN, resourceType, err)
+		}
+
+		resourceARNs = append(resourceARNs, output...)
+	}
+
+	d.SetId(webACLARN)
+	d.Set("resource_arns", resourceARNs)
+
+	return diags
+}
+
+func findResourcesForWebACL(ctx context.Context, conn *wafv2.Client, webACLARN string, resourceType awstypes.ResourceType) ([]string, error) {
+	input := &wafv2.ListResourcesForWebACLInput{
+		ResourceType: resourceType,
+		WebACLArn:    aws.String(webACLARN),
+	}
+
+	output, err := conn.ListResourcesForWebACL(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ResourceArns, nil
+}
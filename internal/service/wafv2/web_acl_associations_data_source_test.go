@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2WebACLAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userPoolResourceName := "aws_cognito_user_pool.test"
+	allDataSourceName := "data.aws_wafv2_web_acl_associations.all"
+	cognitoDataSourceName := "data.aws_wafv2_web_acl_associations.cognito"
+	albDataSourceName := "data.aws_wafv2_web_acl_associations.alb"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allDataSourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(allDataSourceName, "resource_arns.*", userPoolResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(cognitoDataSourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(cognitoDataSourceName, "resource_arns.*", userPoolResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(albDataSourceName, "resource_arns.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationConfig_cognitoUserPool(rName), `
+data "aws_wafv2_web_acl_associations" "all" {
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+
+  depends_on = [aws_wafv2_web_acl_association.test]
+}
+
+data "aws_wafv2_web_acl_associations" "cognito" {
+  web_acl_arn   = aws_wafv2_web_acl.test.arn
+  resource_type = "COGNITO_USER_POOL"
+
+  depends_on = [aws_wafv2_web_acl_association.test]
+}
+
+data "aws_wafv2_web_acl_associations" "alb" {
+  web_acl_arn   = aws_wafv2_web_acl.test.arn
+  resource_type = "APPLICATION_LOAD_BALANCER"
+
+  depends_on = [aws_wafv2_web_acl_association.test]
+}
+`)
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLensShare      = resourceLensShare
+	ResourceMilestone      = resourceMilestone
+	ResourceReviewTemplate = resourceReviewTemplate
+	ResourceWorkload       = resourceWorkload
+
+	FindAnswerByThreePartKey  = findAnswerByThreePartKey
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindMilestoneByTwoPartKey = findMilestoneByTwoPartKey
+	FindReviewTemplateByARN   = findReviewTemplateByARN
+	FindWorkloadByID          = findWorkloadByID
+)
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_lens_share", name="Lens Share")
+func resourceLensShare() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLensShareCreate,
+		ReadWithoutTimeout:   resourceLensShareRead,
+		DeleteWithoutTimeout: resourceLensShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lens_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_with": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+func resourceLensShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	lensAlias := d.Get("lens_alias").(string)
+	sharedWith := d.Get("shared_with").(string)
+	input := &wellarchitected.CreateLensShareInput{
+		LensAlias:  aws.String(lensAlias),
+		SharedWith: aws.String(sharedWith),
+	}
+
+	output, err := conn.CreateLensShare(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Lens Share (%s/%s): %s", lensAlias, sharedWith, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{lensAlias, aws.ToString(output.ShareId)}, lensShareResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceLensShareRead(ctx, d, meta)...)
+}
+
+func resourceLensShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), lensShareResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	lensAlias, shareID := parts[0], parts[1]
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensAlias, shareID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Lens Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Lens Share (%s): %s", d.Id(), err)
+	}
+
+	d.Set("lens_alias", lensAlias)
+	d.Set("share_id", share.ShareId)
+	d.Set("shared_with", share.SharedWith)
+	d.Set(names.AttrStatus, share.Status)
+	d.Set(names.AttrStatusMessage, share.StatusMessage)
+
+	return diags
+}
+
+func resourceLensShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), lensShareResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Well-Architected Lens Share: %s", d.Id())
+	_, err = conn.DeleteLensShare(ctx, &wellarchitected.DeleteLensShareInput{
+		LensAlias: aws.String(parts[0]),
+		ShareId:   aws.String(parts[1]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Lens Share (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensAlias, shareID string) (*awstypes.LensShareSummary, error) {
+	input := &wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensAlias),
+	}
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if aws.ToString(v.ShareId) == shareID {
+				// Revoked shares remain visible for a while after deletion.
+				if v.Status == awstypes.ShareStatusRevoked {
+					return nil, &retry.NotFoundError{
+						Message:     string(v.Status),
+						LastRequest: input,
+					}
+				}
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_wellarchitected_milestone", name="Milestone")
+func resourceMilestone() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMilestoneCreate,
+		ReadWithoutTimeout:   resourceMilestoneRead,
+		DeleteWithoutTimeout: resourceMilestoneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"milestone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+			"milestone_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"recorded_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	milestoneResourceIDPartCount = 2
+)
+
+func resourceMilestoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID := d.Get("workload_id").(string)
+	name := d.Get("milestone_name").(string)
+	input := &wellarchitected.CreateMilestoneInput{
+		MilestoneName: aws.String(name),
+		WorkloadId:    aws.String(workloadID),
+	}
+
+	output, err := conn.CreateMilestone(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Milestone (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{workloadID, strconv.Itoa(int(aws.ToInt32(output.MilestoneNumber)))}, milestoneResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceMilestoneRead(ctx, d, meta)...)
+}
+
+func resourceMilestoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), milestoneResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workloadID := parts[0]
+	milestoneNumber, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	milestone, err := findMilestoneByTwoPartKey(ctx, conn, workloadID, int32(milestoneNumber))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Milestone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Milestone (%s): %s", d.Id(), err)
+	}
+
+	d.Set("milestone_name", milestone.MilestoneName)
+	d.Set("milestone_number", milestone.MilestoneNumber)
+	if milestone.RecordedAt != nil {
+		d.Set("recorded_at", aws.ToTime(milestone.RecordedAt).Format(time.RFC3339))
+	} else {
+		d.Set("recorded_at", nil)
+	}
+	d.Set("workload_id", workloadID)
+
+	return diags
+}
+
+func resourceMilestoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Milestones are an immutable record of a workload's state and cannot be deleted.
+	// They are removed when the workload itself is deleted.
+	log.Printf("[WARN] Well-Architected Milestone (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findMilestoneByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID string, milestoneNumber int32) (*awstypes.Milestone, error) {
+	input := &wellarchitected.GetMilestoneInput{
+		MilestoneNumber: aws.Int32(milestoneNumber),
+		WorkloadId:      aws.String(workloadID),
+	}
+
+	output, err := conn.GetMilestone(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Milestone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Milestone, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_review_template", name="Review Template")
+// @Tags(identifierAttribute="arn")
+func resourceReviewTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReviewTemplateCreate,
+		ReadWithoutTimeout:   resourceReviewTemplateRead,
+		UpdateWithoutTimeout: resourceReviewTemplateUpdate,
+		DeleteWithoutTimeout: resourceReviewTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 250),
+			},
+			"lenses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2084),
+			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+			"update_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReviewTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	name := d.Get("template_name").(string)
+	input := &wellarchitected.CreateReviewTemplateInput{
+		Description:  aws.String(d.Get(names.AttrDescription).(string)),
+		Lenses:       flex.ExpandStringValueSet(d.Get("lenses").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		TemplateName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		input.Notes = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateReviewTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Review Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.TemplateArn))
+
+	return append(diags, resourceReviewTemplateRead(ctx, d, meta)...)
+}
+
+func resourceReviewTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	template, err := findReviewTemplateByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Review Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Review Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, template.TemplateArn)
+	d.Set(names.AttrDescription, template.Description)
+	d.Set("lenses", template.Lenses)
+	d.Set("notes", template.Notes)
+	d.Set(names.AttrOwner, template.Owner)
+	d.Set("template_name", template.TemplateName)
+	d.Set("update_status", template.UpdateStatus)
+
+	setTagsOut(ctx, template.Tags)
+
+	return diags
+}
+
+func resourceReviewTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &wellarchitected.UpdateReviewTemplateInput{
+			TemplateArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("lenses") {
+			o, n := d.GetChange("lenses")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.LensesToAssociate = flex.ExpandStringValueSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.LensesToDisassociate = flex.ExpandStringValueSet(del)
+			}
+		}
+
+		if d.HasChange("notes") {
+			input.Notes = aws.String(d.Get("notes").(string))
+		}
+
+		if d.HasChange("template_name") {
+			input.TemplateName = aws.String(d.Get("template_name").(string))
+		}
+
+		_, err := conn.UpdateReviewTemplate(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Well-Architected Review Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReviewTemplateRead(ctx, d, meta)...)
+}
+
+func resourceReviewTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Well-Architected Review Template: %s", d.Id())
+	_, err := conn.DeleteReviewTemplate(ctx, &wellarchitected.DeleteReviewTemplateInput{
+		TemplateArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Review Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findReviewTemplateByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.ReviewTemplate, error) {
+	input := &wellarchitected.GetReviewTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetReviewTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReviewTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReviewTemplate, nil
+}
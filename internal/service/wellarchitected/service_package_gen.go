@@ -27,7 +27,34 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceLensShare,
+			TypeName: "aws_wellarchitected_lens_share",
+			Name:     "Lens Share",
+		},
+		{
+			Factory:  resourceMilestone,
+			TypeName: "aws_wellarchitected_milestone",
+			Name:     "Milestone",
+		},
+		{
+			Factory:  resourceReviewTemplate,
+			TypeName: "aws_wellarchitected_review_template",
+			Name:     "Review Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceWorkload,
+			TypeName: "aws_wellarchitected_workload",
+			Name:     "Workload",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,561 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+func resourceWorkload() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkloadCreate,
+		ReadWithoutTimeout:   resourceWorkloadRead,
+		UpdateWithoutTimeout: resourceWorkloadUpdate,
+		DeleteWithoutTimeout: resourceWorkloadDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"answer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_applicable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"lens_alias": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2084),
+						},
+						"question_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"reason": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AnswerReason](),
+						},
+						"selected_choices": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"architectural_design": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_regions": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"aws_regions", "non_aws_regions"},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 250),
+			},
+			names.AttrEnvironment: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.WorkloadEnvironment](),
+			},
+			"improvement_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"industry": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"industry_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"lenses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"non_aws_regions": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MaxItems:     5,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"aws_regions", "non_aws_regions"},
+			},
+			"notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2084),
+			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pillar_priorities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"review_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"review_template_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"risk_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workload_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+		},
+	}
+}
+
+func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	name := d.Get("workload_name").(string)
+	input := &wellarchitected.CreateWorkloadInput{
+		Description:  aws.String(d.Get(names.AttrDescription).(string)),
+		Environment:  awstypes.WorkloadEnvironment(d.Get(names.AttrEnvironment).(string)),
+		Lenses:       flex.ExpandStringValueSet(d.Get("lenses").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		WorkloadName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("architectural_design"); ok {
+		input.ArchitecturalDesign = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("aws_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.AwsRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("industry"); ok {
+		input.Industry = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("industry_type"); ok {
+		input.IndustryType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("non_aws_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.NonAwsRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		input.Notes = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pillar_priorities"); ok && len(v.([]interface{})) > 0 {
+		input.PillarPriorities = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("review_owner"); ok {
+		input.ReviewOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("review_template_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ReviewTemplateArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateWorkload(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Workload (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.WorkloadId))
+
+	if v, ok := d.GetOk("answer"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateAnswers(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Well-Architected Workload (%s) answers: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workload, err := findWorkloadByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Workload (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Workload (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_ids", workload.AccountIds)
+	d.Set("architectural_design", workload.ArchitecturalDesign)
+	d.Set(names.AttrARN, workload.WorkloadArn)
+	d.Set("aws_regions", workload.AwsRegions)
+	d.Set(names.AttrDescription, workload.Description)
+	d.Set(names.AttrEnvironment, workload.Environment)
+	d.Set("improvement_status", workload.ImprovementStatus)
+	d.Set("industry", workload.Industry)
+	d.Set("industry_type", workload.IndustryType)
+	d.Set("lenses", workload.Lenses)
+	d.Set("non_aws_regions", workload.NonAwsRegions)
+	d.Set("notes", workload.Notes)
+	d.Set(names.AttrOwner, workload.Owner)
+	d.Set("pillar_priorities", workload.PillarPriorities)
+	d.Set("review_owner", workload.ReviewOwner)
+	d.Set("risk_counts", flattenRiskCounts(workload.RiskCounts))
+	d.Set("workload_id", workload.WorkloadId)
+	d.Set("workload_name", workload.WorkloadName)
+
+	// Only the answers managed by this resource are read back.
+	var answers []interface{}
+	for _, tfMapRaw := range d.Get("answer").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		lensAlias, questionID := tfMap["lens_alias"].(string), tfMap["question_id"].(string)
+		answer, err := findAnswerByThreePartKey(ctx, conn, d.Id(), lensAlias, questionID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Well-Architected Workload (%s) answer (%s/%s): %s", d.Id(), lensAlias, questionID, err)
+		}
+
+		answers = append(answers, flattenAnswer(lensAlias, answer))
+	}
+	if err := d.Set("answer", answers); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting answer: %s", err)
+	}
+
+	setTagsOut(ctx, workload.Tags)
+
+	return diags
+}
+
+func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "answer", "lenses") {
+		input := &wellarchitected.UpdateWorkloadInput{
+			WorkloadId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("account_ids") {
+			input.AccountIds = flex.ExpandStringValueEmptySet(d.Get("account_ids").(*schema.Set))
+		}
+
+		if d.HasChange("architectural_design") {
+			input.ArchitecturalDesign = aws.String(d.Get("architectural_design").(string))
+		}
+
+		if d.HasChange("aws_regions") {
+			input.AwsRegions = flex.ExpandStringValueEmptySet(d.Get("aws_regions").(*schema.Set))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrEnvironment) {
+			input.Environment = awstypes.WorkloadEnvironment(d.Get(names.AttrEnvironment).(string))
+		}
+
+		if d.HasChange("industry") {
+			input.Industry = aws.String(d.Get("industry").(string))
+		}
+
+		if d.HasChange("industry_type") {
+			input.IndustryType = aws.String(d.Get("industry_type").(string))
+		}
+
+		if d.HasChange("non_aws_regions") {
+			input.NonAwsRegions = flex.ExpandStringValueEmptySet(d.Get("non_aws_regions").(*schema.Set))
+		}
+
+		if d.HasChange("notes") {
+			input.Notes = aws.String(d.Get("notes").(string))
+		}
+
+		if d.HasChange("pillar_priorities") {
+			input.PillarPriorities = flex.ExpandStringValueListEmpty(d.Get("pillar_priorities").([]interface{}))
+		}
+
+		if d.HasChange("review_owner") {
+			input.IsReviewOwnerUpdateAcknowledged = aws.Bool(true)
+			input.ReviewOwner = aws.String(d.Get("review_owner").(string))
+		}
+
+		if d.HasChange("workload_name") {
+			input.WorkloadName = aws.String(d.Get("workload_name").(string))
+		}
+
+		_, err := conn.UpdateWorkload(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Well-Architected Workload (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("lenses") {
+		o, n := d.GetChange("lenses")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &wellarchitected.AssociateLensesInput{
+				LensAliases: flex.ExpandStringValueSet(add),
+				WorkloadId:  aws.String(d.Id()),
+			}
+
+			_, err := conn.AssociateLenses(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Well-Architected Workload (%s) lenses: %s", d.Id(), err)
+			}
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &wellarchitected.DisassociateLensesInput{
+				LensAliases: flex.ExpandStringValueSet(del),
+				WorkloadId:  aws.String(d.Id()),
+			}
+
+			_, err := conn.DisassociateLenses(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Well-Architected Workload (%s) lenses: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("answer") {
+		o, n := d.GetChange("answer")
+
+		if add := n.(*schema.Set).Difference(o.(*schema.Set)); add.Len() > 0 {
+			if err := updateAnswers(ctx, conn, d.Id(), add.List()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Well-Architected Workload (%s) answers: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Well-Architected Workload: %s", d.Id())
+	_, err := conn.DeleteWorkload(ctx, &wellarchitected.DeleteWorkloadInput{
+		WorkloadId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Workload (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := &wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+func findAnswerByThreePartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, lensAlias, questionID string) (*awstypes.Answer, error) {
+	input := &wellarchitected.GetAnswerInput{
+		LensAlias:  aws.String(lensAlias),
+		QuestionId: aws.String(questionID),
+		WorkloadId: aws.String(workloadID),
+	}
+
+	output, err := conn.GetAnswer(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Answer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Answer, nil
+}
+
+func updateAnswers(ctx context.Context, conn *wellarchitected.Client, workloadID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		input := &wellarchitected.UpdateAnswerInput{
+			IsApplicable:    aws.Bool(tfMap["is_applicable"].(bool)),
+			LensAlias:       aws.String(tfMap["lens_alias"].(string)),
+			Notes:           aws.String(tfMap["notes"].(string)),
+			QuestionId:      aws.String(tfMap["question_id"].(string)),
+			SelectedChoices: flex.ExpandStringValueEmptySet(tfMap["selected_choices"].(*schema.Set)),
+			WorkloadId:      aws.String(workloadID),
+		}
+
+		if v, ok := tfMap["reason"].(string); ok && v != "" {
+			input.Reason = awstypes.AnswerReason(v)
+		}
+
+		_, err := conn.UpdateAnswer(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("answer (%s/%s): %w", aws.ToString(input.LensAlias), aws.ToString(input.QuestionId), err)
+		}
+	}
+
+	return nil
+}
+
+func flattenAnswer(lensAlias string, apiObject *awstypes.Answer) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"is_applicable":    aws.ToBool(apiObject.IsApplicable),
+		"lens_alias":       lensAlias,
+		"notes":            aws.ToString(apiObject.Notes),
+		"question_id":      aws.ToString(apiObject.QuestionId),
+		"selected_choices": flex.FlattenStringValueSet(apiObject.SelectedChoices),
+	}
+
+	if v := apiObject.Reason; v != awstypes.AnswerReasonNone {
+		tfMap["reason"] = string(v)
+	}
+
+	return tfMap
+}
+
+func flattenRiskCounts(apiObject map[string]int32) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObject))
+
+	for k, v := range apiObject {
+		tfMap[k] = int(v)
+	}
+
+	return tfMap
+}
@@ -99,6 +99,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -106,6 +107,7 @@ const (
 	FSx                          = "fsx"
 	FinSpace                     = "finspace"
 	Firehose                     = "firehose"
+	FraudDetector                = "frauddetector"
 	GameLift                     = "gamelift"
 	Glacier                      = "glacier"
 	GlobalAccelerator            = "globalaccelerator"
@@ -114,6 +116,7 @@ const (
 	Greengrass                   = "greengrass"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
+	Health                       = "health"
 	HealthLake                   = "healthlake"
 	IAM                          = "iam"
 	IVS                          = "ivs"
@@ -228,11 +231,15 @@ const (
 	Signer                       = "signer"
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
+	Support                      = "support"
+	SupportApp                   = "supportapp"
 	Synthetics                   = "synthetics"
 	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	Translate                    = "translate"
+	TrustedAdvisor               = "trustedadvisor"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"
@@ -345,6 +352,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -352,6 +360,7 @@ const (
 	FSxServiceID                          = "FSx"
 	FinSpaceServiceID                     = "finspace"
 	FirehoseServiceID                     = "Firehose"
+	FraudDetectorServiceID                = "FraudDetector"
 	GameLiftServiceID                     = "GameLift"
 	GlacierServiceID                      = "Glacier"
 	GlobalAcceleratorServiceID            = "Global Accelerator"
@@ -360,6 +369,7 @@ const (
 	GreengrassServiceID                   = "Greengrass"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
+	HealthServiceID                       = "Health"
 	HealthLakeServiceID                   = "HealthLake"
 	IAMServiceID                          = "IAM"
 	IVSServiceID                          = "ivs"
@@ -474,11 +484,15 @@ const (
 	SignerServiceID                       = "signer"
 	SimpleDBServiceID                     = "SimpleDB"
 	StorageGatewayServiceID               = "Storage Gateway"
+	SupportServiceID                      = "Support"
+	SupportAppServiceID                   = "Support App"
 	SyntheticsServiceID                   = "synthetics"
 	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"
+	TranslateServiceID                    = "Translate"
+	TrustedAdvisorServiceID               = "TrustedAdvisor"
 	VPCLatticeServiceID                   = "VPC Lattice"
 	VerifiedPermissionsServiceID          = "VerifiedPermissions"
 	WAFServiceID                          = "WAF"
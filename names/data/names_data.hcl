@@ -3718,6 +3718,30 @@ service "emrserverless" {
   brand                    = "AWS"
 }
 
+service "entityresolution" {
+  sdk {
+    id             = "EntityResolution"
+    client_version = [2]
+  }
+
+  names {
+    provider_name_upper = "EntityResolution"
+    human_friendly      = "Entity Resolution"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListMatchingWorkflows"
+  }
+
+  resource_prefix {
+    correct = "aws_entityresolution_"
+  }
+
+  provider_package_correct = "entityresolution"
+  doc_prefix               = ["entityresolution_"]
+  brand                    = "AWS"
+}
+
 service "events" {
   go_packages {
     v1_package = "eventbridge"
@@ -3949,7 +3973,7 @@ service "forecastquery" {
 service "frauddetector" {
   sdk {
     id             = "FraudDetector"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -3957,8 +3981,8 @@ service "frauddetector" {
     human_friendly      = "Fraud Detector"
   }
 
-  client {
-    go_v1_client_typename = "FraudDetector"
+  endpoint_info {
+    endpoint_api_call = "GetDetectors"
   }
 
   resource_prefix {
@@ -3968,7 +3992,6 @@ service "frauddetector" {
   provider_package_correct = "frauddetector"
   doc_prefix               = ["frauddetector_"]
   brand                    = "Amazon"
-  not_implemented          = true
 }
 
 service "fsx" {
@@ -4164,7 +4187,7 @@ service "guardduty" {
 service "health" {
   sdk {
     id             = "Health"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -4173,7 +4196,12 @@ service "health" {
   }
 
   client {
-    go_v1_client_typename = "Health"
+    skip_client_generate = true
+  }
+
+  endpoint_info {
+    endpoint_api_call        = "DescribeEventTypes"
+    endpoint_region_override = "us-east-1"
   }
 
   resource_prefix {
@@ -4183,7 +4211,6 @@ service "health" {
   provider_package_correct = "health"
   doc_prefix               = ["health_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "healthlake" {
@@ -8765,7 +8792,7 @@ service "sts" {
 service "support" {
   sdk {
     id             = "Support"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -8774,7 +8801,12 @@ service "support" {
   }
 
   client {
-    go_v1_client_typename = "Support"
+    skip_client_generate = true
+  }
+
+  endpoint_info {
+    endpoint_api_call        = "DescribeServices"
+    endpoint_region_override = "us-east-1"
   }
 
   resource_prefix {
@@ -8784,7 +8816,35 @@ service "support" {
   provider_package_correct = "support"
   doc_prefix               = ["support_"]
   brand                    = "AWS"
-  not_implemented          = true
+}
+
+service "supportapp" {
+  sdk {
+    id             = "Support App"
+    client_version = [2]
+  }
+
+  names {
+    provider_name_upper = "SupportApp"
+    human_friendly      = "Support App"
+  }
+
+  client {
+    skip_client_generate = true
+  }
+
+  endpoint_info {
+    endpoint_api_call        = "ListSlackWorkspaceConfigurations"
+    endpoint_region_override = "us-east-1"
+  }
+
+  resource_prefix {
+    correct = "aws_supportapp_"
+  }
+
+  provider_package_correct = "supportapp"
+  doc_prefix               = ["supportapp_"]
+  brand                    = "AWS"
 }
 
 service "swf" {
@@ -9014,7 +9074,7 @@ service "transfer" {
 service "translate" {
   sdk {
     id             = "Translate"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -9022,8 +9082,8 @@ service "translate" {
     human_friendly      = "Translate"
   }
 
-  client {
-    go_v1_client_typename = "Translate"
+  endpoint_info {
+    endpoint_api_call = "ListTerminologies"
   }
 
   resource_prefix {
@@ -9033,7 +9093,30 @@ service "translate" {
   provider_package_correct = "translate"
   doc_prefix               = ["translate_"]
   brand                    = "Amazon"
-  not_implemented          = true
+}
+
+service "trustedadvisor" {
+  sdk {
+    id             = "TrustedAdvisor"
+    client_version = [2]
+  }
+
+  names {
+    provider_name_upper = "TrustedAdvisor"
+    human_friendly      = "Trusted Advisor"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListChecks"
+  }
+
+  resource_prefix {
+    correct = "aws_trustedadvisor_"
+  }
+
+  provider_package_correct = "trustedadvisor"
+  doc_prefix               = ["trustedadvisor_"]
+  brand                    = "AWS"
 }
 
 service "vpclattice" {